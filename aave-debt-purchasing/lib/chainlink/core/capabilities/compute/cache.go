@@ -1,7 +1,12 @@
 package compute
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -9,6 +14,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm/host"
 )
@@ -28,6 +34,10 @@ var (
 	})
 )
 
+// moduleCache keeps instantiated modules in memory, keyed by the hash of their binary.
+//
+// Compiled code is persisted across restarts by wasmtime's own cache rather than here, as
+// host.NewModule only accepts a WASM binary. See configureModuleCache.
 type moduleCache struct {
 	m  map[string]*module
 	mu sync.RWMutex
@@ -138,3 +148,78 @@ type module struct {
 	module        *host.Module
 	lastFetchedAt time.Time
 }
+
+// wasmtimeConfigHeader marks the wasmtime config files written by configureModuleCache.
+const wasmtimeConfigHeader = "# Written by the Chainlink compute capability from its ModuleCacheDir and MaxModuleCacheSize config.\n"
+
+// wasmtimeConfigPath returns the path of the file loaded by wasmtime's
+// Config.CacheConfigLoadDefault on Linux.
+func wasmtimeConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "wasmtime", "config.toml"), nil
+}
+
+// configureModuleCache points wasmtime's cache of compiled modules at dir and limits its size
+// to maxSizeBytes.
+//
+// host.NewModule enables that cache with Config.CacheConfigLoadDefault, so the cache is
+// configured by writing wasmtime's default config file. Entries are keyed by the hash of the
+// binary, the wasmtime version and the compiler settings, and wasmtime validates them before
+// loading, so restarting a node or upgrading wasmtime never loads stale machine code. Once
+// the cache exceeds maxSizeBytes, wasmtime evicts the least recently used entries in the
+// background.
+//
+// A config file not written by configureModuleCache is left as is, as it is managed by the
+// operator.
+func configureModuleCache(lggr logger.Logger, dir string, maxSizeBytes uint64) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("invalid module cache directory %q: %w", dir, err)
+	}
+	if err = os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create module cache directory %q: %w", dir, err)
+	}
+
+	path, err := wasmtimeConfigPath()
+	if err != nil {
+		return fmt.Errorf("failed to locate the wasmtime config file: %w", err)
+	}
+
+	existing, err := os.ReadFile(path)
+	switch {
+	case err == nil && !bytes.HasPrefix(existing, []byte(wasmtimeConfigHeader)):
+		lggr.Warnw("Not configuring the module cache, the wasmtime config file is managed by the operator", "path", path)
+		return nil
+	case err != nil && !errors.Is(err, fs.ErrNotExist):
+		return fmt.Errorf("failed to read the wasmtime config file: %w", err)
+	}
+
+	config := fmt.Sprintf("%s[cache]\nenabled = true\ndirectory = %q\nfiles-total-size-soft-limit = \"%d\"\n",
+		wasmtimeConfigHeader, dir, maxSizeBytes)
+	if bytes.Equal(existing, []byte(config)) {
+		return nil
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create the wasmtime config directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "config-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write the wasmtime config file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.WriteString(config)
+	if err = errors.Join(err, tmp.Close()); err != nil {
+		return fmt.Errorf("failed to write the wasmtime config file: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write the wasmtime config file: %w", err)
+	}
+
+	lggr.Infow("Configured the module cache", "dir", dir, "maxSizeBytes", maxSizeBytes, "path", path)
+	return nil
+}
@@ -2,6 +2,8 @@ package compute
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -154,3 +156,32 @@ func TestCache_AddDuplicatedModule(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, got, smod)
 }
+
+func TestConfigureModuleCache(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	configPath := filepath.Join(configHome, "wasmtime", "config.toml")
+	lggr := logger.TestLogger(t)
+
+	dir := t.TempDir()
+	require.NoError(t, configureModuleCache(lggr, dir, 1024))
+	config, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, wasmtimeConfigHeader+fmt.Sprintf("[cache]\nenabled = true\ndirectory = %q\nfiles-total-size-soft-limit = \"1024\"\n", dir), string(config))
+
+	// a config file written by the capability is updated
+	dir = t.TempDir()
+	require.NoError(t, configureModuleCache(lggr, dir, 2048))
+	config, err = os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(config), fmt.Sprintf("directory = %q", dir))
+	assert.Contains(t, string(config), `files-total-size-soft-limit = "2048"`)
+
+	// a config file managed by the operator is left as is
+	operatorConfig := "[cache]\nenabled = false\n"
+	require.NoError(t, os.WriteFile(configPath, []byte(operatorConfig), 0o600))
+	require.NoError(t, configureModuleCache(lggr, t.TempDir(), 1024))
+	config, err = os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, operatorConfig, string(config))
+}
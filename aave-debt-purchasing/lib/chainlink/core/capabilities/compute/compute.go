@@ -391,9 +391,10 @@ const (
 	defaultMaxMemoryMBs              = 128
 	defaultMaxTickInterval           = 100 * time.Millisecond
 	defaultMaxTimeout                = 10 * time.Second
-	defaultMaxCompressedBinarySize   = 20 * 1024 * 1024  // 20 MB
-	defaultMaxDecompressedBinarySize = 100 * 1024 * 1024 // 100 MB
-	defaultMaxResponseSizeBytes      = 5 * 1024 * 1024   // 5 MB
+	defaultMaxCompressedBinarySize   = 20 * 1024 * 1024   // 20 MB
+	defaultMaxDecompressedBinarySize = 100 * 1024 * 1024  // 100 MB
+	defaultMaxResponseSizeBytes      = 5 * 1024 * 1024    // 5 MB
+	defaultMaxModuleCacheSize        = 1024 * 1024 * 1024 // 1 GB
 )
 
 type Config struct {
@@ -405,6 +406,13 @@ type Config struct {
 	MaxCompressedBinarySize   uint64
 	MaxDecompressedBinarySize uint64
 	MaxResponseSizeBytes      uint64
+
+	// ModuleCacheDir is the directory in which wasmtime caches compiled modules across
+	// restarts. wasmtime's own config file is used if it is empty.
+	ModuleCacheDir string
+	// MaxModuleCacheSize is the size in bytes of ModuleCacheDir above which wasmtime evicts
+	// the least recently used modules.
+	MaxModuleCacheSize uint64
 }
 
 func (c *Config) ApplyDefaults() {
@@ -429,6 +437,9 @@ func (c *Config) ApplyDefaults() {
 	if c.MaxResponseSizeBytes == 0 {
 		c.MaxResponseSizeBytes = uint64(defaultMaxResponseSizeBytes)
 	}
+	if c.MaxModuleCacheSize == 0 {
+		c.MaxModuleCacheSize = uint64(defaultMaxModuleCacheSize)
+	}
 }
 
 func NewAction(
@@ -457,6 +468,12 @@ func NewAction(
 		}
 	)
 
+	if config.ModuleCacheDir != "" {
+		if err := configureModuleCache(lggr, config.ModuleCacheDir, config.MaxModuleCacheSize); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, opt := range opts {
 		opt(compute)
 	}
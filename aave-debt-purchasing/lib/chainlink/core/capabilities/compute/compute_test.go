@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io/fs"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
@@ -18,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/utils/matches"
 
 	cappkg "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 
 	corecapabilities "github.com/smartcontractkit/chainlink/v2/core/capabilities"
@@ -190,6 +194,50 @@ func TestComputeExecute(t *testing.T) {
 	assert.False(t, resp.Value.Underlying["Value"].(*values.Bool).Underlying)
 }
 
+func TestComputeExecuteModuleCacheDir(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := defaultConfig
+	cfg.ModuleCacheDir = t.TempDir()
+
+	th := setup(t, cfg)
+	require.NoError(t, th.compute.Start(t.Context()))
+
+	binary := wasmtest.CreateTestBinary(simpleBinaryCmd, simpleBinaryLocation, true, t)
+	config, err := values.WrapMap(map[string]any{
+		"config": []byte(""),
+		"binary": binary,
+	})
+	require.NoError(t, err)
+	inputs, err := values.WrapMap(map[string]any{
+		"arg0": map[string]any{
+			"cool_output": "foo",
+		},
+	})
+	require.NoError(t, err)
+	resp, err := th.compute.Execute(t.Context(), cappkg.CapabilityRequest{
+		Inputs: inputs,
+		Config: config,
+		Metadata: cappkg.RequestMetadata{
+			WorkflowID:  "workflowID",
+			ReferenceID: "compute",
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Value.Underlying["Value"].(*values.Bool).Underlying)
+
+	// wasmtime stores the compiled module in the configured directory
+	require.Eventually(t, func() bool {
+		var files int
+		_ = filepath.WalkDir(cfg.ModuleCacheDir, func(_ string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() {
+				files++
+			}
+			return nil
+		})
+		return files > 0
+	}, tests.WaitTimeout(t), 100*time.Millisecond)
+}
+
 func TestComputeFetch(t *testing.T) {
 	t.Parallel()
 	workflowID := "15c631d295ef5e32deb99a10ee6804bc4af13855687559d7ff6552ac6dbb2ce0"
@@ -0,0 +1,168 @@
+package balance
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// topUpWindow is the window of the daily top-up limit of a Policy.
+const topUpWindow = 24 * time.Hour
+
+// Policy sets the balance thresholds of a key on a chain, below which the key is reported as unhealthy. Keys with a
+// funding key are topped up by it when their balance falls below LowBalance.
+type Policy struct {
+	EVMChainID      ubig.Big       `db:"evm_chain_id"`
+	Address         common.Address `db:"address"`
+	LowBalance      ubig.Big       `db:"low_balance"`
+	CriticalBalance ubig.Big       `db:"critical_balance"`
+	// FundingAddress is the key sending the top-ups. FundingAddress, TopUpAmount and DailyTopUpLimit are either all
+	// set or all nil.
+	FundingAddress *common.Address `db:"funding_address"`
+	// TopUpAmount is the native value of a single top-up, in wei.
+	TopUpAmount *ubig.Big `db:"top_up_amount"`
+	// DailyTopUpLimit is the maximum native value sent to the key by the funding key in the last 24 hours, in wei.
+	DailyTopUpLimit *ubig.Big `db:"daily_top_up_limit"`
+	CreatedAt       time.Time `db:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at"`
+}
+
+// TopUpEnabled returns true if the key is topped up by a funding key.
+func (p Policy) TopUpEnabled() bool {
+	return p.FundingAddress != nil
+}
+
+func (p Policy) validate() error {
+	zero := ubig.NewI(0)
+	if p.CriticalBalance.Cmp(zero) < 0 {
+		return errors.New("critical balance must not be negative")
+	}
+	if p.LowBalance.Cmp(&p.CriticalBalance) < 0 {
+		return errors.New("low balance must not be below the critical balance")
+	}
+	if (p.FundingAddress == nil) != (p.TopUpAmount == nil) || (p.FundingAddress == nil) != (p.DailyTopUpLimit == nil) {
+		return errors.New("funding address, top-up amount and daily top-up limit must be set together")
+	}
+	if !p.TopUpEnabled() {
+		return nil
+	}
+	if *p.FundingAddress == p.Address {
+		return errors.New("funding address must not be the address of the key")
+	}
+	if p.TopUpAmount.Cmp(zero) <= 0 {
+		return errors.New("top-up amount must be positive")
+	}
+	if p.DailyTopUpLimit.Cmp(p.TopUpAmount) < 0 {
+		return errors.New("daily top-up limit must not be below the top-up amount")
+	}
+	return nil
+}
+
+type ORM interface {
+	// UpsertPolicy creates or replaces the policy of the key of the policy on its chain.
+	UpsertPolicy(ctx context.Context, policy Policy) (Policy, error)
+	// GetPolicies returns the policies on chainID, or on every chain if chainID is nil.
+	GetPolicies(ctx context.Context, chainID *big.Int) ([]Policy, error)
+	DeletePolicy(ctx context.Context, chainID *big.Int, address common.Address) error
+	// DailyTopUps returns the native value sent by from to to on chainID in the last 24 hours.
+	DailyTopUps(ctx context.Context, chainID *big.Int, from, to common.Address) (*big.Int, error)
+	// HasPendingTopUp returns true if a transaction from from to to on chainID is not confirmed yet.
+	HasPendingTopUp(ctx context.Context, chainID *big.Int, from, to common.Address) (bool, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) UpsertPolicy(ctx context.Context, policy Policy) (p Policy, err error) {
+	if err = policy.validate(); err != nil {
+		return Policy{}, err
+	}
+	err = o.ds.GetContext(ctx, &p,
+		`INSERT INTO evm.balance_policies (evm_chain_id, address, low_balance, critical_balance, funding_address, top_up_amount, daily_top_up_limit, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		ON CONFLICT (evm_chain_id, address) DO UPDATE SET
+			low_balance = EXCLUDED.low_balance,
+			critical_balance = EXCLUDED.critical_balance,
+			funding_address = EXCLUDED.funding_address,
+			top_up_amount = EXCLUDED.top_up_amount,
+			daily_top_up_limit = EXCLUDED.daily_top_up_limit,
+			updated_at = EXCLUDED.updated_at
+		RETURNING *`,
+		policy.EVMChainID, policy.Address, policy.LowBalance, policy.CriticalBalance, policy.FundingAddress, policy.TopUpAmount, policy.DailyTopUpLimit,
+	)
+	if err != nil {
+		return Policy{}, fmt.Errorf("failed to upsert balance policy: %w", err)
+	}
+	return p, nil
+}
+
+func (o *orm) GetPolicies(ctx context.Context, chainID *big.Int) (ps []Policy, err error) {
+	err = o.ds.SelectContext(ctx, &ps,
+		`SELECT * FROM evm.balance_policies WHERE $1::numeric IS NULL OR evm_chain_id = $1 ORDER BY evm_chain_id, address`,
+		(*ubig.Big)(chainID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance policies: %w", err)
+	}
+	return ps, nil
+}
+
+func (o *orm) DeletePolicy(ctx context.Context, chainID *big.Int, address common.Address) error {
+	res, err := o.ds.ExecContext(ctx,
+		`DELETE FROM evm.balance_policies WHERE evm_chain_id = $1 AND address = $2`,
+		ubig.New(chainID), address,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete balance policy: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (o *orm) DailyTopUps(ctx context.Context, chainID *big.Int, from, to common.Address) (*big.Int, error) {
+	var sent ubig.Big
+	err := o.ds.GetContext(ctx, &sent,
+		`SELECT COALESCE(SUM(value), 0) FROM evm.txes
+		WHERE evm_chain_id = $1 AND from_address = $2 AND to_address = $3 AND state <> $4 AND created_at > $5`,
+		ubig.New(chainID), from, to, txmgr.TxFatalError, time.Now().Add(-topUpWindow),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily top-ups: %w", err)
+	}
+	return sent.ToInt(), nil
+}
+
+func (o *orm) HasPendingTopUp(ctx context.Context, chainID *big.Int, from, to common.Address) (pending bool, err error) {
+	err = o.ds.GetContext(ctx, &pending,
+		`SELECT EXISTS (
+			SELECT 1 FROM evm.txes
+			WHERE evm_chain_id = $1 AND from_address = $2 AND to_address = $3 AND state IN ($4, $5, $6)
+		)`,
+		ubig.New(chainID), from, to, txmgr.TxUnstarted, txmgr.TxInProgress, txmgr.TxUnconfirmed,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to find pending top-ups: %w", err)
+	}
+	return pending, nil
+}
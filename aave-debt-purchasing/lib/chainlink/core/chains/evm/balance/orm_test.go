@@ -0,0 +1,129 @@
+package balance_test
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/balance"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+)
+
+func TestORM(t *testing.T) {
+	t.Parallel()
+
+	db := testutils.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+	orm := balance.NewORM(db)
+	txStore := txmgr.NewTxStore(db, logger.Test(t))
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, address := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, funding := cltest.MustInsertRandomKey(t, ethKeyStore)
+	chainID := testutils.FixtureChainID
+
+	for _, tc := range []struct {
+		name   string
+		policy balance.Policy
+	}{
+		{"low balance below the critical balance", balance.Policy{LowBalance: *ubig.NewI(1), CriticalBalance: *ubig.NewI(2)}},
+		{"top-up without limit", balance.Policy{FundingAddress: &funding, TopUpAmount: ubig.NewI(1)}},
+		{"top-up from the key itself", balance.Policy{FundingAddress: &address, TopUpAmount: ubig.NewI(1), DailyTopUpLimit: ubig.NewI(1)}},
+		{"limit below the top-up amount", balance.Policy{FundingAddress: &funding, TopUpAmount: ubig.NewI(2), DailyTopUpLimit: ubig.NewI(1)}},
+	} {
+		t.Run("rejects a "+tc.name, func(t *testing.T) {
+			tc.policy.EVMChainID = *ubig.New(chainID)
+			tc.policy.Address = address
+			_, err := orm.UpsertPolicy(ctx, tc.policy)
+			require.Error(t, err)
+		})
+	}
+
+	policy, err := orm.UpsertPolicy(ctx, balance.Policy{
+		EVMChainID:      *ubig.New(chainID),
+		Address:         address,
+		LowBalance:      *ubig.NewI(100),
+		CriticalBalance: *ubig.NewI(10),
+		FundingAddress:  &funding,
+		TopUpAmount:     ubig.NewI(50),
+		DailyTopUpLimit: ubig.NewI(100),
+	})
+	require.NoError(t, err)
+	assert.True(t, policy.TopUpEnabled())
+	assert.Equal(t, funding, *policy.FundingAddress)
+
+	t.Run("replaces the policy", func(t *testing.T) {
+		_, err := orm.UpsertPolicy(ctx, balance.Policy{EVMChainID: *ubig.New(chainID), Address: address, LowBalance: *ubig.NewI(200)})
+		require.NoError(t, err)
+
+		policies, err := orm.GetPolicies(ctx, nil)
+		require.NoError(t, err)
+		require.Len(t, policies, 1)
+		assert.Equal(t, ubig.NewI(200), &policies[0].LowBalance)
+		assert.False(t, policies[0].TopUpEnabled())
+
+		policies, err = orm.GetPolicies(ctx, big.NewInt(1234))
+		require.NoError(t, err)
+		assert.Empty(t, policies)
+	})
+
+	t.Run("finds the top-ups", func(t *testing.T) {
+		sent, err := orm.DailyTopUps(ctx, chainID, funding, address)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), sent.Int64())
+		pending, err := orm.HasPendingTopUp(ctx, chainID, funding, address)
+		require.NoError(t, err)
+		assert.False(t, pending)
+
+		insertTx := func(to common.Address, state txmgrtypes.TxState) {
+			etx := cltest.NewEthTx(funding)
+			etx.ChainID = chainID
+			etx.ToAddress = to
+			etx.Value = *big.NewInt(50)
+			etx.State = state
+			if state != txmgrcommon.TxUnstarted {
+				now := time.Now()
+				etx.BroadcastAt = &now
+				etx.InitialBroadcastAt = &now
+				nonce := evmtypes.Nonce(0)
+				etx.Sequence = &nonce
+			}
+			require.NoError(t, txStore.InsertTx(ctx, &etx))
+		}
+		insertTx(address, txmgrcommon.TxConfirmed)
+		insertTx(testutils.NewAddress(), txmgrcommon.TxUnstarted)
+
+		sent, err = orm.DailyTopUps(ctx, chainID, funding, address)
+		require.NoError(t, err)
+		assert.Equal(t, int64(50), sent.Int64())
+		pending, err = orm.HasPendingTopUp(ctx, chainID, funding, address)
+		require.NoError(t, err)
+		assert.False(t, pending)
+
+		insertTx(address, txmgrcommon.TxUnstarted)
+
+		sent, err = orm.DailyTopUps(ctx, chainID, funding, address)
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), sent.Int64())
+		pending, err = orm.HasPendingTopUp(ctx, chainID, funding, address)
+		require.NoError(t, err)
+		assert.True(t, pending)
+	})
+
+	t.Run("deletes the policy", func(t *testing.T) {
+		require.NoError(t, orm.DeletePolicy(ctx, chainID, address))
+		require.ErrorIs(t, orm.DeletePolicy(ctx, chainID, address), sql.ErrNoRows)
+	})
+}
@@ -0,0 +1,210 @@
+package balance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+var (
+	// ErrLowBalance is reported by the PolicyMonitor for keys below the low balance of their policy.
+	ErrLowBalance = errors.New("low balance")
+	// ErrCriticalBalance is reported by the PolicyMonitor for keys below the critical balance of their policy.
+	ErrCriticalBalance = errors.New("critical balance")
+)
+
+const (
+	// policyCheckPeriod is the period between two checks of the balances of the keys with a policy.
+	policyCheckPeriod = time.Minute
+	// Approximately ETH block time
+	balanceFetchTimeout = 15 * time.Second
+)
+
+type client interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+type keyStore interface {
+	CheckEnabled(ctx context.Context, address common.Address) error
+}
+
+type txManager interface {
+	SendNativeToken(ctx context.Context, chainID *big.Int, from, to common.Address, value big.Int, gasLimit uint64) (txmgr.Tx, error)
+}
+
+// level is the balance of a key relative to the thresholds of its policy.
+type level int
+
+const (
+	levelOK level = iota
+	levelLow
+	levelCritical
+)
+
+// PolicyMonitor checks the balances of the keys with a Policy on a chain. Keys below the thresholds of their policy are
+// reported as unhealthy, and topped up by their funding key under its daily limit.
+type PolicyMonitor struct {
+	services.Service
+	eng *services.Engine
+
+	chainID  *big.Int
+	orm      ORM
+	client   client
+	keyStore keyStore
+	txm      txManager
+	gasLimit uint64
+	period   time.Duration
+
+	// levels is only accessed by the run loop
+	levels map[common.Address]level
+}
+
+// NewPolicyMonitor returns a PolicyMonitor sending the top-ups with gasLimit.
+func NewPolicyMonitor(lggr logger.Logger, chainID *big.Int, orm ORM, client client, keyStore keyStore, txm txManager, gasLimit uint64) *PolicyMonitor {
+	m := &PolicyMonitor{
+		chainID:  chainID,
+		orm:      orm,
+		client:   client,
+		keyStore: keyStore,
+		txm:      txm,
+		gasLimit: gasLimit,
+		period:   policyCheckPeriod,
+		levels:   make(map[common.Address]level),
+	}
+	m.Service, m.eng = services.Config{
+		Name:  "BalancePolicyMonitor",
+		Start: m.start,
+	}.NewServiceEngine(lggr)
+	return m
+}
+
+func (m *PolicyMonitor) start(context.Context) error {
+	m.eng.Go(m.run)
+	return nil
+}
+
+func (m *PolicyMonitor) run(ctx context.Context) {
+	ticker := services.NewTicker(m.period)
+	defer ticker.Stop()
+	for {
+		m.checkPolicies(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *PolicyMonitor) checkPolicies(ctx context.Context) {
+	policies, err := m.orm.GetPolicies(ctx, m.chainID)
+	if err != nil {
+		m.eng.Errorw("Failed to load balance policies", "err", err)
+		return
+	}
+
+	checked := make(map[common.Address]bool, len(policies))
+	for _, p := range policies {
+		checked[p.Address] = true
+		m.checkPolicy(ctx, p)
+	}
+	// The keys of deleted policies are not reported anymore
+	for address := range m.levels {
+		if !checked[address] {
+			m.setLevel(address, levelOK, nil)
+			delete(m.levels, address)
+		}
+	}
+}
+
+func (m *PolicyMonitor) checkPolicy(ctx context.Context, p Policy) {
+	fetchCtx, cancel := context.WithTimeout(ctx, balanceFetchTimeout)
+	bal, err := m.client.BalanceAt(fetchCtx, p.Address, nil)
+	cancel()
+	if err != nil {
+		m.eng.Errorw("Failed to get balance of key "+p.Address.Hex(), "address", p.Address, "err", err)
+		return
+	}
+
+	balance := (*assets.Eth)(bal)
+	switch {
+	case bal.Cmp(p.CriticalBalance.ToInt()) < 0:
+		m.setLevel(p.Address, levelCritical, fmt.Errorf("%w: %s is below %s", ErrCriticalBalance, balance, (*assets.Eth)(p.CriticalBalance.ToInt())))
+	case bal.Cmp(p.LowBalance.ToInt()) < 0:
+		m.setLevel(p.Address, levelLow, fmt.Errorf("%w: %s is below %s", ErrLowBalance, balance, (*assets.Eth)(p.LowBalance.ToInt())))
+	default:
+		m.setLevel(p.Address, levelOK, nil)
+		return
+	}
+
+	if p.TopUpEnabled() {
+		m.topUp(ctx, p)
+	}
+}
+
+// setLevel reports the key as unhealthy with err if l is not levelOK, and logs the changes of level.
+func (m *PolicyMonitor) setLevel(address common.Address, l level, err error) {
+	condition := address.Hex()
+	if l == levelOK {
+		m.eng.ClearHealthCond(condition)
+	} else {
+		m.eng.SetHealthCond(condition, err)
+	}
+
+	if prev, ok := m.levels[address]; ok && prev == l {
+		return
+	}
+	m.levels[address] = l
+	switch l {
+	case levelCritical:
+		m.eng.Criticalw("Balance of key is below its critical balance", "address", address, "err", err)
+	case levelLow:
+		m.eng.Warnw("Balance of key is below its low balance", "address", address, "err", err)
+	case levelOK:
+		m.eng.Infow("Balance of key is above its low balance", "address", address)
+	}
+}
+
+func (m *PolicyMonitor) topUp(ctx context.Context, p Policy) {
+	lggr := logger.With(m.eng, "address", p.Address, "fundingAddress", *p.FundingAddress)
+
+	if err := m.keyStore.CheckEnabled(ctx, *p.FundingAddress); err != nil {
+		lggr.Errorw("Funding key is not enabled, skipping top-up", "err", err)
+		return
+	}
+	pending, err := m.orm.HasPendingTopUp(ctx, m.chainID, *p.FundingAddress, p.Address)
+	if err != nil {
+		lggr.Errorw("Failed to find pending top-ups, skipping top-up", "err", err)
+		return
+	}
+	if pending {
+		lggr.Debugw("Top-up is pending, skipping top-up")
+		return
+	}
+	sent, err := m.orm.DailyTopUps(ctx, m.chainID, *p.FundingAddress, p.Address)
+	if err != nil {
+		lggr.Errorw("Failed to get daily top-ups, skipping top-up", "err", err)
+		return
+	}
+	if total := new(big.Int).Add(sent, p.TopUpAmount.ToInt()); total.Cmp(p.DailyTopUpLimit.ToInt()) > 0 {
+		lggr.Warnw("Daily top-up limit reached, skipping top-up", "sent", sent, "dailyTopUpLimit", p.DailyTopUpLimit)
+		return
+	}
+
+	etx, err := m.txm.SendNativeToken(ctx, m.chainID, *p.FundingAddress, p.Address, *p.TopUpAmount.ToInt(), m.gasLimit)
+	if err != nil {
+		lggr.Errorw("Failed to send top-up", "err", err)
+		return
+	}
+	lggr.Infow("Sent top-up", "amount", p.TopUpAmount, "txID", etx.ID)
+}
@@ -0,0 +1,207 @@
+package balance
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+func TestPolicyMonitor(t *testing.T) {
+	t.Parallel()
+
+	address := testutils.NewAddress()
+	funding := testutils.NewAddress()
+	policy := Policy{
+		EVMChainID:      *ubig.New(testutils.FixtureChainID),
+		Address:         address,
+		LowBalance:      *ubig.NewI(100),
+		CriticalBalance: *ubig.NewI(10),
+	}
+	topUpPolicy := policy
+	topUpPolicy.FundingAddress = &funding
+	topUpPolicy.TopUpAmount = ubig.NewI(50)
+	topUpPolicy.DailyTopUpLimit = ubig.NewI(120)
+
+	t.Run("reports keys above their low balance as healthy", func(t *testing.T) {
+		m := newTestPolicyMonitor(t, []Policy{topUpPolicy}, 100)
+		servicetest.Run(t, m)
+
+		m.waitForChecks(t, 3)
+		require.NoError(t, m.healthy())
+		assert.Empty(t, m.txm.sent())
+	})
+
+	t.Run("reports keys below their critical balance", func(t *testing.T) {
+		m := newTestPolicyMonitor(t, []Policy{policy}, 9)
+		servicetest.Run(t, m)
+
+		require.Eventually(t, func() bool { return errors.Is(m.healthy(), ErrCriticalBalance) }, tests.WaitTimeout(t), 10*time.Millisecond)
+		assert.ErrorContains(t, m.healthy(), address.Hex())
+	})
+
+	t.Run("tops up keys below their low balance once until it is confirmed", func(t *testing.T) {
+		m := newTestPolicyMonitor(t, []Policy{topUpPolicy}, 99)
+		servicetest.Run(t, m)
+
+		require.Eventually(t, func() bool { return errors.Is(m.healthy(), ErrLowBalance) }, tests.WaitTimeout(t), 10*time.Millisecond)
+		m.waitForChecks(t, 3)
+		assert.Equal(t, []topUp{{from: funding, to: address, value: big.NewInt(50)}}, m.txm.sent())
+	})
+
+	t.Run("does not exceed the daily top-up limit", func(t *testing.T) {
+		m := newTestPolicyMonitor(t, []Policy{topUpPolicy}, 99)
+		m.orm.dailyTopUps = big.NewInt(71)
+		servicetest.Run(t, m)
+
+		m.waitForChecks(t, 3)
+		assert.Empty(t, m.txm.sent())
+	})
+
+	t.Run("does not top up from a disabled funding key", func(t *testing.T) {
+		m := newTestPolicyMonitor(t, []Policy{topUpPolicy}, 99)
+		m.keyStore.err = errors.New("key disabled")
+		servicetest.Run(t, m)
+
+		m.waitForChecks(t, 3)
+		assert.Empty(t, m.txm.sent())
+	})
+
+	t.Run("stops reporting keys whose policy is deleted", func(t *testing.T) {
+		m := newTestPolicyMonitor(t, []Policy{policy}, 9)
+		servicetest.Run(t, m)
+
+		require.Eventually(t, func() bool { return m.healthy() != nil }, tests.WaitTimeout(t), 10*time.Millisecond)
+		m.orm.setPolicies(nil)
+		require.Eventually(t, func() bool { return m.healthy() == nil }, tests.WaitTimeout(t), 10*time.Millisecond)
+	})
+}
+
+type testPolicyMonitor struct {
+	*PolicyMonitor
+	orm      *testORM
+	client   *testClient
+	keyStore *testKeyStore
+	txm      *testTxManager
+}
+
+func newTestPolicyMonitor(t *testing.T, policies []Policy, balance int64) testPolicyMonitor {
+	orm := &testORM{policies: policies, dailyTopUps: big.NewInt(0)}
+	client := &testClient{balance: big.NewInt(balance)}
+	keyStore := &testKeyStore{}
+	txm := &testTxManager{orm: orm}
+	m := NewPolicyMonitor(logger.Test(t), testutils.FixtureChainID, orm, client, keyStore, txm, 21000)
+	m.period = 10 * time.Millisecond
+	return testPolicyMonitor{PolicyMonitor: m, orm: orm, client: client, keyStore: keyStore, txm: txm}
+}
+
+func (m testPolicyMonitor) healthy() error {
+	return m.HealthReport()[m.Name()]
+}
+
+// waitForChecks waits for the balances to be checked n more times.
+func (m testPolicyMonitor) waitForChecks(t *testing.T, n int) {
+	target := m.client.checks() + n
+	require.Eventually(t, func() bool { return m.client.checks() >= target }, tests.WaitTimeout(t), 10*time.Millisecond)
+}
+
+type testORM struct {
+	ORM
+	mu          sync.Mutex
+	policies    []Policy
+	dailyTopUps *big.Int
+	pending     bool
+}
+
+func (o *testORM) setPolicies(ps []Policy) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.policies = ps
+}
+
+func (o *testORM) GetPolicies(context.Context, *big.Int) ([]Policy, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.policies, nil
+}
+
+func (o *testORM) DailyTopUps(context.Context, *big.Int, common.Address, common.Address) (*big.Int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.dailyTopUps, nil
+}
+
+func (o *testORM) HasPendingTopUp(context.Context, *big.Int, common.Address, common.Address) (bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.pending, nil
+}
+
+type testClient struct {
+	mu      sync.Mutex
+	balance *big.Int
+	calls   int
+}
+
+func (c *testClient) BalanceAt(context.Context, common.Address, *big.Int) (*big.Int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	return c.balance, nil
+}
+
+func (c *testClient) checks() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls
+}
+
+type testKeyStore struct {
+	err error
+}
+
+func (k *testKeyStore) CheckEnabled(context.Context, common.Address) error { return k.err }
+
+type topUp struct {
+	from, to common.Address
+	value    *big.Int
+}
+
+type testTxManager struct {
+	orm   *testORM
+	mu    sync.Mutex
+	txs   []topUp
+	txIDs int64
+}
+
+func (m *testTxManager) SendNativeToken(_ context.Context, _ *big.Int, from, to common.Address, value big.Int, _ uint64) (txmgr.Tx, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.txs = append(m.txs, topUp{from: from, to: to, value: &value})
+	m.txIDs++
+	// The top-up is pending until it is confirmed
+	m.orm.mu.Lock()
+	m.orm.pending = true
+	m.orm.mu.Unlock()
+	return txmgr.Tx{ID: m.txIDs}, nil
+}
+
+func (m *testTxManager) sent() []topUp {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]topUp(nil), m.txs...)
+}
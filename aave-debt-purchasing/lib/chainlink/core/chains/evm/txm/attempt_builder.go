@@ -19,18 +19,20 @@ type attemptBuilder struct {
 	gas.EvmFeeEstimator
 	priceMaxKey func(common.Address) *assets.Wei
 	keystore    keys.TxSigner
+	feePolicies map[types.Priority]FeePolicy
 }
 
-func NewAttemptBuilder(priceMaxKey func(common.Address) *assets.Wei, estimator gas.EvmFeeEstimator, keystore keys.TxSigner) *attemptBuilder {
+func NewAttemptBuilder(priceMaxKey func(common.Address) *assets.Wei, estimator gas.EvmFeeEstimator, keystore keys.TxSigner, feePolicies map[types.Priority]FeePolicy) *attemptBuilder {
 	return &attemptBuilder{
 		priceMaxKey:     priceMaxKey,
 		EvmFeeEstimator: estimator,
 		keystore:        keystore,
+		feePolicies:     feePolicies,
 	}
 }
 
 func (a *attemptBuilder) NewAttempt(ctx context.Context, lggr logger.Logger, tx *types.Transaction, dynamic bool) (*types.Attempt, error) {
-	fee, estimatedGasLimit, err := a.EvmFeeEstimator.GetFee(ctx, tx.Data, tx.SpecifiedGasLimit, a.priceMaxKey(tx.FromAddress), &tx.FromAddress, &tx.ToAddress)
+	fee, estimatedGasLimit, err := a.EvmFeeEstimator.GetFee(ctx, tx.Data, tx.SpecifiedGasLimit, a.maxPrice(tx), &tx.FromAddress, &tx.ToAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -42,13 +44,50 @@ func (a *attemptBuilder) NewAttempt(ctx context.Context, lggr logger.Logger, tx
 }
 
 func (a *attemptBuilder) NewBumpAttempt(ctx context.Context, lggr logger.Logger, tx *types.Transaction, previousAttempt types.Attempt) (*types.Attempt, error) {
-	bumpedFee, bumpedFeeLimit, err := a.EvmFeeEstimator.BumpFee(ctx, previousAttempt.Fee, tx.SpecifiedGasLimit, a.priceMaxKey(tx.FromAddress), nil)
+	maxPrice := a.maxPrice(tx)
+	bumpedFee, bumpedFeeLimit, err := a.EvmFeeEstimator.BumpFee(ctx, previousAttempt.Fee, tx.SpecifiedGasLimit, maxPrice, nil)
 	if err != nil {
 		return nil, err
 	}
+	if bumpPercent := a.feePolicies[tx.Priority].BumpPercent; bumpPercent > 0 {
+		bumpedFee = minBumpedFee(bumpedFee, previousAttempt.Fee, bumpPercent, maxPrice)
+	}
 	return a.newCustomAttempt(ctx, tx, bumpedFee, bumpedFeeLimit, previousAttempt.Type, lggr)
 }
 
+// maxPrice returns the max gas price of the key of tx, capped by the MaxFeeCap of the FeePolicy of its priority.
+func (a *attemptBuilder) maxPrice(tx *types.Transaction) *assets.Wei {
+	maxPrice := a.priceMaxKey(tx.FromAddress)
+	if feeCap := a.feePolicies[tx.Priority].MaxFeeCap; feeCap != nil && (maxPrice == nil || feeCap.Cmp(maxPrice) < 0) {
+		return feeCap
+	}
+	return maxPrice
+}
+
+// minBumpedFee raises the fields of fee that are lower than the previous fee increased by bumpPercent, up to maxPrice.
+func minBumpedFee(fee gas.EvmFee, previousFee gas.EvmFee, bumpPercent uint16, maxPrice *assets.Wei) gas.EvmFee {
+	bump := func(bumped, previous *assets.Wei) *assets.Wei {
+		if bumped == nil || previous == nil {
+			return bumped
+		}
+		minBumped := previous.AddPercentage(bumpPercent)
+		if maxPrice != nil && minBumped.Cmp(maxPrice) > 0 {
+			minBumped = maxPrice
+		}
+		if bumped.Cmp(minBumped) < 0 {
+			return minBumped
+		}
+		return bumped
+	}
+	return gas.EvmFee{
+		GasPrice: bump(fee.GasPrice, previousFee.GasPrice),
+		DynamicFee: gas.DynamicFee{
+			GasTipCap: bump(fee.GasTipCap, previousFee.GasTipCap),
+			GasFeeCap: bump(fee.GasFeeCap, previousFee.GasFeeCap),
+		},
+	}
+}
+
 func (a *attemptBuilder) newCustomAttempt(
 	ctx context.Context,
 	tx *types.Transaction,
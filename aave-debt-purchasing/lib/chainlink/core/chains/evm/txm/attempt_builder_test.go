@@ -3,6 +3,7 @@ package txm
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,7 +17,7 @@ import (
 )
 
 func TestAttemptBuilder_newLegacyAttempt(t *testing.T) {
-	ab := NewAttemptBuilder(nil, nil, keystest.TxSigner(nil))
+	ab := NewAttemptBuilder(nil, nil, keystest.TxSigner(nil), nil)
 	address := testutils.NewAddress()
 	lggr := logger.Test(t)
 	var gasLimit uint64 = 100
@@ -51,7 +52,7 @@ func TestAttemptBuilder_newLegacyAttempt(t *testing.T) {
 }
 
 func TestAttemptBuilder_newDynamicFeeAttempt(t *testing.T) {
-	ab := NewAttemptBuilder(nil, nil, keystest.TxSigner(nil))
+	ab := NewAttemptBuilder(nil, nil, keystest.TxSigner(nil), nil)
 	address := testutils.NewAddress()
 
 	lggr := logger.Test(t)
@@ -85,3 +86,33 @@ func TestAttemptBuilder_newDynamicFeeAttempt(t *testing.T) {
 		assert.Equal(t, gasLimit, a.GasLimit)
 	})
 }
+
+func TestAttemptBuilder_FeePolicy(t *testing.T) {
+	address := testutils.NewAddress()
+	priceMaxKey := func(common.Address) *assets.Wei { return assets.NewWeiI(100) }
+	ab := NewAttemptBuilder(priceMaxKey, nil, keystest.TxSigner(nil), map[types.Priority]FeePolicy{
+		types.PriorityUrgent: {BumpPercent: 50},
+		types.PriorityNormal: {MaxFeeCap: assets.NewWeiI(30)},
+	})
+
+	t.Run("caps the max price of the key", func(t *testing.T) {
+		assert.Equal(t, assets.NewWeiI(30), ab.maxPrice(&types.Transaction{FromAddress: address}))
+		assert.Equal(t, assets.NewWeiI(100), ab.maxPrice(&types.Transaction{FromAddress: address, Priority: types.PriorityUrgent}))
+	})
+
+	t.Run("bumps fees by at least the bump percentage", func(t *testing.T) {
+		fee := minBumpedFee(gas.EvmFee{GasPrice: assets.NewWeiI(22)}, gas.EvmFee{GasPrice: assets.NewWeiI(20)}, 50, assets.NewWeiI(100))
+		assert.Equal(t, assets.NewWeiI(30), fee.GasPrice)
+
+		fee = minBumpedFee(gas.EvmFee{GasPrice: assets.NewWeiI(40)}, gas.EvmFee{GasPrice: assets.NewWeiI(20)}, 50, assets.NewWeiI(100))
+		assert.Equal(t, assets.NewWeiI(40), fee.GasPrice)
+
+		fee = minBumpedFee(
+			gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.NewWeiI(11), GasFeeCap: assets.NewWeiI(80)}},
+			gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.NewWeiI(10), GasFeeCap: assets.NewWeiI(70)}},
+			50, assets.NewWeiI(100))
+		assert.Equal(t, assets.NewWeiI(15), fee.GasTipCap)
+		assert.Equal(t, assets.NewWeiI(100), fee.GasFeeCap)
+		assert.Nil(t, fee.GasPrice)
+	})
+}
@@ -290,6 +290,63 @@ func (_c *mockTxStore_DeleteAttemptForUnconfirmedTx_Call) RunAndReturn(run func(
 	return _c
 }
 
+// FetchNextNonce provides a mock function with given fields: _a0, _a1
+func (_m *mockTxStore) FetchNextNonce(_a0 context.Context, _a1 common.Address) (uint64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchNextNonce")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address) (uint64, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address) uint64); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTxStore_FetchNextNonce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchNextNonce'
+type mockTxStore_FetchNextNonce_Call struct {
+	*mock.Call
+}
+
+// FetchNextNonce is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 common.Address
+func (_e *mockTxStore_Expecter) FetchNextNonce(_a0 interface{}, _a1 interface{}) *mockTxStore_FetchNextNonce_Call {
+	return &mockTxStore_FetchNextNonce_Call{Call: _e.mock.On("FetchNextNonce", _a0, _a1)}
+}
+
+func (_c *mockTxStore_FetchNextNonce_Call) Run(run func(_a0 context.Context, _a1 common.Address)) *mockTxStore_FetchNextNonce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address))
+	})
+	return _c
+}
+
+func (_c *mockTxStore_FetchNextNonce_Call) Return(_a0 uint64, _a1 error) *mockTxStore_FetchNextNonce_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTxStore_FetchNextNonce_Call) RunAndReturn(run func(context.Context, common.Address) (uint64, error)) *mockTxStore_FetchNextNonce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FetchUnconfirmedTransactionAtNonceWithCount provides a mock function with given fields: _a0, _a1, _a2
 func (_m *mockTxStore) FetchUnconfirmedTransactionAtNonceWithCount(_a0 context.Context, _a1 uint64, _a2 common.Address) (*types.Transaction, int, error) {
 	ret := _m.Called(_a0, _a1, _a2)
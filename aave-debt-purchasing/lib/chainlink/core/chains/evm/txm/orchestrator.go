@@ -227,6 +227,7 @@ func (o *Orchestrator[BLOCK_HASH, HEAD]) CreateTransaction(ctx context.Context,
 			Value:             &request.Value,
 			Data:              request.EncodedPayload,
 			SpecifiedGasLimit: request.FeeLimit,
+			Priority:          requestPriority(ctx, request.Meta),
 			Meta:              meta,
 			ForwarderAddress:  request.ForwarderAddress,
 
@@ -267,6 +268,16 @@ func (o *Orchestrator[BLOCK_HASH, HEAD]) CreateTransaction(ctx context.Context,
 	return
 }
 
+// requestPriority returns the priority requested by the caller with txmtypes.WithPriority. Upkeep performs are at least
+// of high priority, as their metadata identifies them even when they are created by a pipeline.
+func requestPriority(ctx context.Context, meta *txmgrtypes.TxMeta[common.Address, common.Hash]) txmtypes.Priority {
+	priority := txmtypes.PriorityFromContext(ctx)
+	if meta != nil && meta.UpkeepID != nil && priority < txmtypes.PriorityHigh {
+		priority = txmtypes.PriorityHigh
+	}
+	return priority
+}
+
 // CountTransactionsByState was required for backwards compatibility and it's used only for unconfirmed transactions.
 func (o *Orchestrator[BLOCK_HASH, HEAD]) CountTransactionsByState(ctx context.Context, state txmgrtypes.TxState) (uint32, error) {
 	addresses, err := o.keystore.EnabledAddresses(ctx)
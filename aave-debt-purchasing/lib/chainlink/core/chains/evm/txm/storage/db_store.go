@@ -0,0 +1,579 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/types"
+)
+
+// DBStore is a postgres-backed TxStore. Unlike the InMemoryStoreManager, transactions, attempts and idempotency keys
+// survive a restart, and nonces are assigned in the same database transaction that starts a transaction, so the Txm
+// can resume from the highest assigned nonce. It keeps the same limits on the number of unstarted and confirmed
+// transactions per address as the InMemoryStore.
+type DBStore struct {
+	lggr    logger.SugaredLogger
+	ds      sqlutil.DataSource
+	chainID *big.Int
+
+	// attemptCounts holds the AttemptCount of unconfirmed transactions. Like in the InMemoryStore, it is strictly kept
+	// in memory, so that a restart allows a transaction that reached the max allowed attempts to be retried.
+	attemptCountsMu sync.Mutex
+	attemptCounts   map[uint64]uint16
+}
+
+func NewDBStore(lggr logger.Logger, ds sqlutil.DataSource, chainID *big.Int) *DBStore {
+	return &DBStore{
+		lggr:          logger.Sugared(logger.Named(lggr, "DBStore")),
+		ds:            ds,
+		chainID:       chainID,
+		attemptCounts: make(map[uint64]uint16),
+	}
+}
+
+type dbTx struct {
+	ID                 uint64             `db:"id"`
+	EVMChainID         ubig.Big           `db:"evm_chain_id"`
+	IdempotencyKey     *string            `db:"idempotency_key"`
+	Nonce              *int64             `db:"nonce"`
+	FromAddress        common.Address     `db:"from_address"`
+	ToAddress          common.Address     `db:"to_address"`
+	Value              ubig.Big           `db:"value"`
+	Data               []byte             `db:"data"`
+	SpecifiedGasLimit  uint64             `db:"specified_gas_limit"`
+	Priority           types.Priority     `db:"priority"`
+	State              txmgrtypes.TxState `db:"state"`
+	IsPurgeable        bool               `db:"is_purgeable"`
+	Meta               *sqlutil.JSON      `db:"meta"`
+	Subject            uuid.NullUUID      `db:"subject"`
+	PipelineTaskRunID  uuid.NullUUID      `db:"pipeline_task_run_id"`
+	MinConfirmations   clnull.Uint32      `db:"min_confirmations"`
+	SignalCallback     bool               `db:"signal_callback"`
+	CallbackCompleted  bool               `db:"callback_completed"`
+	CreatedAt          time.Time          `db:"created_at"`
+	InitialBroadcastAt *time.Time         `db:"initial_broadcast_at"`
+	LastBroadcastAt    *time.Time         `db:"last_broadcast_at"`
+}
+
+func (d *dbTx) toTransaction() *types.Transaction {
+	tx := &types.Transaction{
+		ID:                 d.ID,
+		IdempotencyKey:     d.IdempotencyKey,
+		ChainID:            d.EVMChainID.ToInt(),
+		FromAddress:        d.FromAddress,
+		ToAddress:          d.ToAddress,
+		Value:              d.Value.ToInt(),
+		Data:               d.Data,
+		SpecifiedGasLimit:  d.SpecifiedGasLimit,
+		Priority:           d.Priority,
+		CreatedAt:          d.CreatedAt,
+		InitialBroadcastAt: d.InitialBroadcastAt,
+		LastBroadcastAt:    d.LastBroadcastAt,
+		State:              d.State,
+		IsPurgeable:        d.IsPurgeable,
+		Meta:               d.Meta,
+		Subject:            d.Subject,
+		PipelineTaskRunID:  d.PipelineTaskRunID,
+		MinConfirmations:   d.MinConfirmations,
+		SignalCallback:     d.SignalCallback,
+		CallbackCompleted:  d.CallbackCompleted,
+	}
+	if d.Nonce != nil {
+		nonce := uint64(*d.Nonce) //nolint:gosec // nonces are stored from uint64
+		tx.Nonce = &nonce
+	}
+	return tx
+}
+
+type dbAttempt struct {
+	ID          uint64      `db:"id"`
+	TxID        uint64      `db:"tx_id"`
+	Hash        common.Hash `db:"hash"`
+	GasPrice    *assets.Wei `db:"gas_price"`
+	GasTipCap   *assets.Wei `db:"gas_tip_cap"`
+	GasFeeCap   *assets.Wei `db:"gas_fee_cap"`
+	GasLimit    uint64      `db:"gas_limit"`
+	TxType      int         `db:"tx_type"`
+	SignedRawTx []byte      `db:"signed_raw_tx"`
+	CreatedAt   time.Time   `db:"created_at"`
+	BroadcastAt *time.Time  `db:"broadcast_at"`
+}
+
+func (d *dbAttempt) toAttempt() (*types.Attempt, error) {
+	attempt := &types.Attempt{
+		ID:   d.ID,
+		TxID: d.TxID,
+		Hash: d.Hash,
+		Fee: gas.EvmFee{
+			GasPrice:   d.GasPrice,
+			DynamicFee: gas.DynamicFee{GasTipCap: d.GasTipCap, GasFeeCap: d.GasFeeCap},
+		},
+		GasLimit:    d.GasLimit,
+		Type:        byte(d.TxType), //nolint:gosec // tx types are stored from a byte
+		CreatedAt:   d.CreatedAt,
+		BroadcastAt: d.BroadcastAt,
+	}
+	if len(d.SignedRawTx) > 0 {
+		signedTx := new(evmtypes.Transaction)
+		if err := signedTx.UnmarshalBinary(d.SignedRawTx); err != nil {
+			return nil, fmt.Errorf("failed to decode signed transaction of attempt: %v: %w", d.ID, err)
+		}
+		attempt.SignedTransaction = signedTx
+	}
+	return attempt, nil
+}
+
+const txColumns = `id, evm_chain_id, idempotency_key, nonce, from_address, to_address, value, data, specified_gas_limit, priority, state,
+	is_purgeable, meta, subject, pipeline_task_run_id, min_confirmations, signal_callback, callback_completed, created_at,
+	initial_broadcast_at, last_broadcast_at`
+
+func (s *DBStore) AbandonPendingTransactions(ctx context.Context, fromAddress common.Address) error {
+	return sqlutil.TransactDataSource(ctx, s.ds, nil, func(tx sqlutil.DataSource) error {
+		// Like the InMemoryStore, only keep the transactions abandoned last
+		if _, err := tx.ExecContext(ctx, `DELETE FROM evm.txm_v2_txes WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3`,
+			s.chainID.String(), fromAddress, txmgr.TxFatalError); err != nil {
+			return fmt.Errorf("failed to delete fatal transactions: %w", err)
+		}
+		var ids []uint64
+		if err := tx.SelectContext(ctx, &ids, `UPDATE evm.txm_v2_txes SET state = $3
+			WHERE evm_chain_id = $1 AND from_address = $2 AND state IN ($4, $5) RETURNING id`,
+			s.chainID.String(), fromAddress, txmgr.TxFatalError, txmgr.TxUnstarted, txmgr.TxUnconfirmed); err != nil {
+			return fmt.Errorf("failed to abandon pending transactions: %w", err)
+		}
+		s.deleteAttemptCounts(ids...)
+		return nil
+	})
+}
+
+// Add satisfies the OrchestratorTxStore interface. Transactions of every address are kept in the same tables, so
+// there is nothing to set up.
+func (s *DBStore) Add(...common.Address) error {
+	return nil
+}
+
+func (s *DBStore) AppendAttemptToTransaction(ctx context.Context, txNonce uint64, fromAddress common.Address, attempt *types.Attempt) error {
+	var signedRawTx []byte
+	if attempt.SignedTransaction != nil {
+		var err error
+		if signedRawTx, err = attempt.SignedTransaction.MarshalBinary(); err != nil {
+			return fmt.Errorf("failed to encode signed transaction of attempt for txID: %v: %w", attempt.TxID, err)
+		}
+	}
+
+	return sqlutil.TransactDataSource(ctx, s.ds, nil, func(tx sqlutil.DataSource) error {
+		txID, err := s.lockUnconfirmedTxID(ctx, tx, txNonce, fromAddress)
+		if err != nil {
+			return fmt.Errorf("unconfirmed tx was not found for nonce: %d - txID: %v: %w", txNonce, attempt.TxID, err)
+		}
+		if txID != attempt.TxID {
+			return fmt.Errorf("unconfirmed tx with nonce exists but attempt points to a different txID. Found TxID: %v - txID: %v", txID, attempt.TxID)
+		}
+
+		var inserted dbAttempt
+		err = tx.GetContext(ctx, &inserted, `INSERT INTO evm.txm_v2_tx_attempts
+			(tx_id, hash, gas_price, gas_tip_cap, gas_fee_cap, gas_limit, tx_type, signed_raw_tx, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW()) RETURNING id, created_at`,
+			attempt.TxID, attempt.Hash, attempt.Fee.GasPrice, attempt.Fee.GasTipCap, attempt.Fee.GasFeeCap, attempt.GasLimit,
+			int(attempt.Type), signedRawTx)
+		if err != nil {
+			return fmt.Errorf("failed to insert attempt for txID: %v: %w", attempt.TxID, err)
+		}
+		attempt.ID = inserted.ID
+		attempt.CreatedAt = inserted.CreatedAt
+
+		s.attemptCountsMu.Lock()
+		s.attemptCounts[txID]++
+		s.attemptCountsMu.Unlock()
+		return nil
+	})
+}
+
+func (s *DBStore) CountUnstartedTransactions(ctx context.Context, fromAddress common.Address) (count int, err error) {
+	err = s.ds.GetContext(ctx, &count, `SELECT COUNT(*) FROM evm.txm_v2_txes WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3`,
+		s.chainID.String(), fromAddress, txmgr.TxUnstarted)
+	return
+}
+
+func (s *DBStore) CreateEmptyUnconfirmedTransaction(ctx context.Context, fromAddress common.Address, nonce uint64, gasLimit uint64) (emptyTx *types.Transaction, err error) {
+	err = sqlutil.TransactDataSource(ctx, s.ds, nil, func(tx sqlutil.DataSource) error {
+		var existing []dbTx
+		if err := tx.SelectContext(ctx, &existing, `SELECT `+txColumns+` FROM evm.txm_v2_txes
+			WHERE evm_chain_id = $1 AND from_address = $2 AND nonce = $3 AND state IN ($4, $5)`,
+			s.chainID.String(), fromAddress, nonce, txmgr.TxUnconfirmed, txmgr.TxConfirmed); err != nil {
+			return fmt.Errorf("failed to check transactions with nonce: %d: %w", nonce, err)
+		}
+		if len(existing) > 0 {
+			return fmt.Errorf("a %s tx with the same nonce already exists: %v", existing[0].State, existing[0].toTransaction())
+		}
+
+		var inserted dbTx
+		if err := tx.GetContext(ctx, &inserted, `INSERT INTO evm.txm_v2_txes
+			(evm_chain_id, nonce, from_address, to_address, value, data, specified_gas_limit, state, created_at)
+			VALUES ($1, $2, $3, $4, 0, $5, $6, $7, NOW()) RETURNING `+txColumns,
+			s.chainID.String(), nonce, fromAddress, common.Address{}, []byte{}, gasLimit, txmgr.TxUnconfirmed); err != nil {
+			return fmt.Errorf("failed to insert empty transaction: %w", err)
+		}
+		emptyTx = inserted.toTransaction()
+		return nil
+	})
+	return
+}
+
+func (s *DBStore) CreateTransaction(ctx context.Context, txRequest *types.TxRequest) (newTx *types.Transaction, err error) {
+	value := txRequest.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	data := txRequest.Data
+	if data == nil {
+		data = []byte{}
+	}
+
+	err = sqlutil.TransactDataSource(ctx, s.ds, nil, func(tx sqlutil.DataSource) error {
+		var inserted dbTx
+		if err := tx.GetContext(ctx, &inserted, `INSERT INTO evm.txm_v2_txes
+			(evm_chain_id, idempotency_key, from_address, to_address, value, data, specified_gas_limit, priority, state, meta,
+			pipeline_task_run_id, min_confirmations, signal_callback, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NOW()) RETURNING `+txColumns,
+			s.chainID.String(), txRequest.IdempotencyKey, txRequest.FromAddress, txRequest.ToAddress, value.String(), data,
+			txRequest.SpecifiedGasLimit, txRequest.Priority, txmgr.TxUnstarted, txRequest.Meta, txRequest.PipelineTaskRunID,
+			txRequest.MinConfirmations, txRequest.SignalCallback); err != nil {
+			return fmt.Errorf("failed to insert transaction: %w", err)
+		}
+
+		var dropped []uint64
+		if err := tx.SelectContext(ctx, &dropped, `DELETE FROM evm.txm_v2_txes WHERE id IN (
+				SELECT id FROM evm.txm_v2_txes WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3
+				ORDER BY priority DESC, id DESC OFFSET $4
+			) RETURNING id`,
+			s.chainID.String(), txRequest.FromAddress, txmgr.TxUnstarted, maxQueuedTransactions); err != nil {
+			return fmt.Errorf("failed to prune unstarted transactions: %w", err)
+		}
+		if len(dropped) > 0 {
+			s.lggr.Warnw(fmt.Sprintf("Unstarted transactions queue for address: %v reached max limit of: %d. Dropping oldest transactions of the lowest priority", txRequest.FromAddress, maxQueuedTransactions),
+				"txIDs", dropped)
+		}
+
+		newTx = inserted.toTransaction()
+		return nil
+	})
+	return
+}
+
+func (s *DBStore) FetchNextNonce(ctx context.Context, fromAddress common.Address) (uint64, error) {
+	var nextNonce int64
+	err := s.ds.GetContext(ctx, &nextNonce, `SELECT COALESCE(MAX(nonce) + 1, 0) FROM evm.txm_v2_txes
+		WHERE evm_chain_id = $1 AND from_address = $2 AND state IN ($3, $4)`,
+		s.chainID.String(), fromAddress, txmgr.TxUnconfirmed, txmgr.TxConfirmed)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch next nonce: %w", err)
+	}
+	return uint64(nextNonce), nil //nolint:gosec // nonces are stored from uint64
+}
+
+func (s *DBStore) FetchUnconfirmedTransactionAtNonceWithCount(ctx context.Context, latestNonce uint64, fromAddress common.Address) (txCopy *types.Transaction, unconfirmedCount int, err error) {
+	if err = s.ds.GetContext(ctx, &unconfirmedCount, `SELECT COUNT(*) FROM evm.txm_v2_txes WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3`,
+		s.chainID.String(), fromAddress, txmgr.TxUnconfirmed); err != nil {
+		return nil, 0, fmt.Errorf("failed to count unconfirmed transactions: %w", err)
+	}
+	if unconfirmedCount == 0 {
+		return nil, 0, nil
+	}
+
+	var dbtx dbTx
+	err = s.ds.GetContext(ctx, &dbtx, `SELECT `+txColumns+` FROM evm.txm_v2_txes
+		WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3 AND nonce = $4`,
+		s.chainID.String(), fromAddress, txmgr.TxUnconfirmed, latestNonce)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, unconfirmedCount, nil
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch unconfirmed transaction with nonce: %d: %w", latestNonce, err)
+	}
+
+	txs, err := s.loadTransactions(ctx, s.ds, []dbTx{dbtx})
+	if err != nil {
+		return nil, 0, err
+	}
+	return txs[0], unconfirmedCount, nil
+}
+
+func (s *DBStore) MarkConfirmedAndReorgedTransactions(ctx context.Context, latestNonce uint64, fromAddress common.Address) (confirmedTransactions []*types.Transaction, unconfirmedTransactionIDs []uint64, err error) {
+	err = sqlutil.TransactDataSource(ctx, s.ds, nil, func(tx sqlutil.DataSource) error {
+		var confirmed []dbTx
+		if err := tx.SelectContext(ctx, &confirmed, `UPDATE evm.txm_v2_txes SET state = $4
+			WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3 AND nonce < $5 RETURNING `+txColumns,
+			s.chainID.String(), fromAddress, txmgr.TxUnconfirmed, txmgr.TxConfirmed, latestNonce); err != nil {
+			return fmt.Errorf("failed to mark transactions as confirmed: %w", err)
+		}
+
+		// An unconfirmed transaction with the nonce of a re-orged one is replaced, as in the InMemoryStore
+		var replaced []dbTx
+		if err := tx.SelectContext(ctx, &replaced, `UPDATE evm.txm_v2_txes u SET state = $4
+			FROM evm.txm_v2_txes c
+			WHERE u.evm_chain_id = $1 AND u.from_address = $2 AND u.state = $3
+			AND c.evm_chain_id = $1 AND c.from_address = $2 AND c.state = $5 AND c.nonce = u.nonce AND c.nonce >= $6
+			RETURNING u.id, u.nonce`,
+			s.chainID.String(), fromAddress, txmgr.TxUnconfirmed, txmgr.TxFatalError, txmgr.TxConfirmed, latestNonce); err != nil {
+			return fmt.Errorf("failed to replace unconfirmed transactions: %w", err)
+		}
+		for _, r := range replaced {
+			s.lggr.Errorw("Another unconfirmed transaction with the same nonce exists. Transaction will overwritten.",
+				"existingTxID", r.ID, "nonce", r.Nonce)
+		}
+
+		if err := tx.SelectContext(ctx, &unconfirmedTransactionIDs, `UPDATE evm.txm_v2_txes SET state = $4, last_broadcast_at = NULL
+			WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3 AND nonce >= $5 RETURNING id`,
+			s.chainID.String(), fromAddress, txmgr.TxConfirmed, txmgr.TxUnconfirmed, latestNonce); err != nil {
+			return fmt.Errorf("failed to mark re-orged transactions as unconfirmed: %w", err)
+		}
+
+		prunedTxIDs, err := s.pruneConfirmedTransactions(ctx, tx, fromAddress)
+		if err != nil {
+			return err
+		}
+		if len(prunedTxIDs) > 0 {
+			s.lggr.Debugf("Confirmed transactions for address: %v reached max limit of: %d. Pruned 1/%d of the oldest confirmed transactions. TxIDs: %v",
+				fromAddress, maxQueuedTransactions, pruneSubset, prunedTxIDs)
+		}
+
+		confirmedTransactions, err = s.loadTransactions(ctx, tx, confirmed)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, tx := range confirmedTransactions {
+		s.deleteAttemptCounts(tx.ID)
+	}
+	sort.Slice(confirmedTransactions, func(i, j int) bool { return confirmedTransactions[i].ID < confirmedTransactions[j].ID })
+	sort.Slice(unconfirmedTransactionIDs, func(i, j int) bool { return unconfirmedTransactionIDs[i] < unconfirmedTransactionIDs[j] })
+	return confirmedTransactions, unconfirmedTransactionIDs, nil
+}
+
+func (s *DBStore) MarkUnconfirmedTransactionPurgeable(ctx context.Context, nonce uint64, fromAddress common.Address) error {
+	res, err := s.ds.ExecContext(ctx, `UPDATE evm.txm_v2_txes SET is_purgeable = TRUE
+		WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3 AND nonce = $4`,
+		s.chainID.String(), fromAddress, txmgr.TxUnconfirmed, nonce)
+	if err != nil {
+		return fmt.Errorf("failed to mark transaction as purgeable: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("unconfirmed tx with nonce: %d was not found", nonce)
+	}
+	return nil
+}
+
+func (s *DBStore) UpdateTransactionBroadcast(ctx context.Context, txID uint64, txNonce uint64, attemptHash common.Hash, fromAddress common.Address) error {
+	return sqlutil.TransactDataSource(ctx, s.ds, nil, func(tx sqlutil.DataSource) error {
+		// Set the same time for both the tx and its attempt
+		now := time.Now()
+		var unconfirmedTxID uint64
+		err := tx.GetContext(ctx, &unconfirmedTxID, `UPDATE evm.txm_v2_txes
+			SET last_broadcast_at = $5, initial_broadcast_at = COALESCE(initial_broadcast_at, $5)
+			WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3 AND nonce = $4 RETURNING id`,
+			s.chainID.String(), fromAddress, txmgr.TxUnconfirmed, txNonce, now)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("unconfirmed tx was not found for nonce: %d - txID: %v", txNonce, txID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update transaction broadcast: %w", err)
+		}
+
+		res, err := tx.ExecContext(ctx, `UPDATE evm.txm_v2_tx_attempts SET broadcast_at = $3 WHERE tx_id = $1 AND hash = $2`,
+			unconfirmedTxID, attemptHash, now)
+		if err != nil {
+			return fmt.Errorf("failed to update attempt broadcast: %w", err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return fmt.Errorf("UpdateTransactionBroadcast failed to find attempt. attempt with hash: %v was not found", attemptHash)
+		}
+		return nil
+	})
+}
+
+func (s *DBStore) UpdateUnstartedTransactionWithNonce(ctx context.Context, fromAddress common.Address, nonce uint64) (updated *types.Transaction, err error) {
+	err = sqlutil.TransactDataSource(ctx, s.ds, nil, func(tx sqlutil.DataSource) error {
+		var unstartedID uint64
+		err := tx.GetContext(ctx, &unstartedID, `SELECT id FROM evm.txm_v2_txes
+			WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3 ORDER BY priority DESC, id LIMIT 1 FOR UPDATE`,
+			s.chainID.String(), fromAddress, txmgr.TxUnstarted)
+		if errors.Is(err, sql.ErrNoRows) {
+			s.lggr.Debugf("Unstarted transactions queue is empty for address: %v", fromAddress)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch unstarted transaction: %w", err)
+		}
+
+		if existingID, err := s.lockUnconfirmedTxID(ctx, tx, nonce, fromAddress); err == nil {
+			return fmt.Errorf("an unconfirmed tx with the same nonce already exists. TxID: %v - nonce: %d", existingID, nonce)
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		var dbtx dbTx
+		if err := tx.GetContext(ctx, &dbtx, `UPDATE evm.txm_v2_txes SET nonce = $2, state = $3 WHERE id = $1 RETURNING `+txColumns,
+			unstartedID, nonce, txmgr.TxUnconfirmed); err != nil {
+			return fmt.Errorf("failed to assign nonce to transaction: %w", err)
+		}
+		updated = dbtx.toTransaction()
+		return nil
+	})
+	return
+}
+
+// Error Handler
+func (s *DBStore) DeleteAttemptForUnconfirmedTx(ctx context.Context, transactionNonce uint64, attempt *types.Attempt, fromAddress common.Address) error {
+	return sqlutil.TransactDataSource(ctx, s.ds, nil, func(tx sqlutil.DataSource) error {
+		txID, err := s.lockUnconfirmedTxID(ctx, tx, transactionNonce, fromAddress)
+		if err != nil {
+			return fmt.Errorf("unconfirmed tx was not found for nonce: %d - txID: %v: %w", transactionNonce, attempt.TxID, err)
+		}
+		res, err := tx.ExecContext(ctx, `DELETE FROM evm.txm_v2_tx_attempts WHERE tx_id = $1 AND hash = $2`, txID, attempt.Hash)
+		if err != nil {
+			return fmt.Errorf("failed to delete attempt: %w", err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return fmt.Errorf("attempt with hash: %v for txID: %v was not found", attempt.Hash, attempt.TxID)
+		}
+		return nil
+	})
+}
+
+func (s *DBStore) MarkTxFatal(ctx context.Context, tx *types.Transaction, fromAddress common.Address) error {
+	res, err := s.ds.ExecContext(ctx, `UPDATE evm.txm_v2_txes SET state = $4 WHERE evm_chain_id = $1 AND from_address = $2 AND id = $3`,
+		s.chainID.String(), fromAddress, tx.ID, txmgr.TxFatalError)
+	if err != nil {
+		return fmt.Errorf("failed to mark tx as fatal: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("tx with txID: %v was not found", tx.ID)
+	}
+	s.deleteAttemptCounts(tx.ID)
+	return nil
+}
+
+// Orchestrator
+func (s *DBStore) FindTxWithIdempotencyKey(ctx context.Context, idempotencyKey string) (*types.Transaction, error) {
+	var dbtx dbTx
+	err := s.ds.GetContext(ctx, &dbtx, `SELECT `+txColumns+` FROM evm.txm_v2_txes WHERE evm_chain_id = $1 AND idempotency_key = $2`,
+		s.chainID.String(), idempotencyKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction with idempotency key: %w", err)
+	}
+
+	txs, err := s.loadTransactions(ctx, s.ds, []dbTx{dbtx})
+	if err != nil {
+		return nil, err
+	}
+	return txs[0], nil
+}
+
+// lockUnconfirmedTxID returns the ID of the unconfirmed transaction with nonce, and locks it until the end of the
+// database transaction. It returns sql.ErrNoRows if there is none.
+func (s *DBStore) lockUnconfirmedTxID(ctx context.Context, ds sqlutil.DataSource, nonce uint64, fromAddress common.Address) (txID uint64, err error) {
+	err = ds.GetContext(ctx, &txID, `SELECT id FROM evm.txm_v2_txes
+		WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3 AND nonce = $4 FOR UPDATE`,
+		s.chainID.String(), fromAddress, txmgr.TxUnconfirmed, nonce)
+	return
+}
+
+// loadTransactions converts dbtxs to transactions, with their attempts and attempt counts.
+func (s *DBStore) loadTransactions(ctx context.Context, ds sqlutil.DataSource, dbtxs []dbTx) ([]*types.Transaction, error) {
+	if len(dbtxs) == 0 {
+		return nil, nil
+	}
+
+	txs := make([]*types.Transaction, 0, len(dbtxs))
+	byID := make(map[uint64]*types.Transaction, len(dbtxs))
+	ids := make([]int64, 0, len(dbtxs))
+	s.attemptCountsMu.Lock()
+	for i := range dbtxs {
+		tx := dbtxs[i].toTransaction()
+		tx.AttemptCount = s.attemptCounts[tx.ID]
+		txs = append(txs, tx)
+		byID[tx.ID] = tx
+		ids = append(ids, int64(tx.ID)) //nolint:gosec // IDs are BIGSERIAL
+	}
+	s.attemptCountsMu.Unlock()
+
+	var attempts []dbAttempt
+	if err := ds.SelectContext(ctx, &attempts, `SELECT * FROM evm.txm_v2_tx_attempts WHERE tx_id = ANY($1) ORDER BY id`,
+		pq.Array(ids)); err != nil {
+		return nil, fmt.Errorf("failed to load attempts: %w", err)
+	}
+	for i := range attempts {
+		attempt, err := attempts[i].toAttempt()
+		if err != nil {
+			return nil, err
+		}
+		tx := byID[attempt.TxID]
+		tx.Attempts = append(tx.Attempts, attempt)
+	}
+	return txs, nil
+}
+
+// pruneConfirmedTransactions deletes the oldest 1/pruneSubset of the confirmed transactions of fromAddress, once there
+// are more than maxQueuedTransactions.
+func (s *DBStore) pruneConfirmedTransactions(ctx context.Context, ds sqlutil.DataSource, fromAddress common.Address) ([]uint64, error) {
+	var count int
+	if err := ds.GetContext(ctx, &count, `SELECT COUNT(*) FROM evm.txm_v2_txes WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3`,
+		s.chainID.String(), fromAddress, txmgr.TxConfirmed); err != nil {
+		return nil, fmt.Errorf("failed to count confirmed transactions: %w", err)
+	}
+	if count <= maxQueuedTransactions {
+		return nil, nil
+	}
+
+	var prunedTxIDs []uint64
+	if err := ds.SelectContext(ctx, &prunedTxIDs, `DELETE FROM evm.txm_v2_txes
+		WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3 AND nonce < (
+			SELECT nonce FROM evm.txm_v2_txes WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3
+			ORDER BY nonce OFFSET $4 LIMIT 1
+		) RETURNING id`,
+		s.chainID.String(), fromAddress, txmgr.TxConfirmed, count/pruneSubset); err != nil {
+		return nil, fmt.Errorf("failed to prune confirmed transactions: %w", err)
+	}
+	sort.Slice(prunedTxIDs, func(i, j int) bool { return prunedTxIDs[i] < prunedTxIDs[j] })
+	return prunedTxIDs, nil
+}
+
+func (s *DBStore) deleteAttemptCounts(txIDs ...uint64) {
+	s.attemptCountsMu.Lock()
+	defer s.attemptCountsMu.Unlock()
+	for _, id := range txIDs {
+		delete(s.attemptCounts, id)
+	}
+}
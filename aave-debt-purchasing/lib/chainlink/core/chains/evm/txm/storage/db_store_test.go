@@ -0,0 +1,129 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestDBStore(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	ctx := t.Context()
+	fromAddress := testutils.NewAddress()
+	s := NewDBStore(logger.Test(t), db, testutils.FixtureChainID)
+
+	IDK := "idempotency-key"
+	tx, err := s.CreateTransaction(ctx, &types.TxRequest{IdempotencyKey: &IDK, FromAddress: fromAddress, ToAddress: testutils.NewAddress()})
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.TxUnstarted, tx.State)
+	assert.Nil(t, tx.Nonce)
+
+	count, err := s.CountUnstartedTransactions(ctx, fromAddress)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	nextNonce, err := s.FetchNextNonce(ctx, fromAddress)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), nextNonce)
+
+	t.Run("assigns a nonce to the unstarted transaction", func(t *testing.T) {
+		updated, err := s.UpdateUnstartedTransactionWithNonce(ctx, fromAddress, 4)
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, tx.ID, updated.ID)
+		assert.Equal(t, txmgr.TxUnconfirmed, updated.State)
+		assert.Equal(t, uint64(4), *updated.Nonce)
+
+		updated, err = s.UpdateUnstartedTransactionWithNonce(ctx, fromAddress, 5)
+		require.NoError(t, err)
+		assert.Nil(t, updated)
+
+		nextNonce, err := s.FetchNextNonce(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), nextNonce)
+	})
+
+	t.Run("appends and broadcasts attempts", func(t *testing.T) {
+		signedTx := evmtypes.NewTx(&evmtypes.LegacyTx{Nonce: 4, GasPrice: assets.NewWeiI(1).ToInt()})
+		attempt := &types.Attempt{
+			TxID:              tx.ID,
+			Hash:              signedTx.Hash(),
+			Fee:               gas.EvmFee{GasPrice: assets.NewWeiI(1)},
+			GasLimit:          21000,
+			SignedTransaction: signedTx,
+		}
+		require.NoError(t, s.AppendAttemptToTransaction(ctx, 4, fromAddress, attempt))
+		require.NoError(t, s.UpdateTransactionBroadcast(ctx, tx.ID, 4, attempt.Hash, fromAddress))
+		require.Error(t, s.UpdateTransactionBroadcast(ctx, tx.ID, 4, common.Hash{}, fromAddress))
+
+		unconfirmed, count, err := s.FetchUnconfirmedTransactionAtNonceWithCount(ctx, 4, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		require.NotNil(t, unconfirmed)
+		assert.Equal(t, uint16(1), unconfirmed.AttemptCount)
+		assert.NotNil(t, unconfirmed.InitialBroadcastAt)
+		require.Len(t, unconfirmed.Attempts, 1)
+		assert.Equal(t, attempt.Hash, unconfirmed.Attempts[0].SignedTransaction.Hash())
+		assert.NotNil(t, unconfirmed.Attempts[0].BroadcastAt)
+	})
+
+	t.Run("survives a restart", func(t *testing.T) {
+		restarted := NewDBStore(logger.Test(t), db, testutils.FixtureChainID)
+		found, err := restarted.FindTxWithIdempotencyKey(ctx, IDK)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, uint64(4), *found.Nonce)
+		assert.Len(t, found.Attempts, 1)
+		assert.Equal(t, uint16(0), found.AttemptCount)
+
+		nextNonce, err := restarted.FetchNextNonce(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), nextNonce)
+	})
+
+	t.Run("marks transactions as confirmed and re-orged", func(t *testing.T) {
+		confirmed, unconfirmedIDs, err := s.MarkConfirmedAndReorgedTransactions(ctx, 5, fromAddress)
+		require.NoError(t, err)
+		require.Len(t, confirmed, 1)
+		assert.Equal(t, tx.ID, confirmed[0].ID)
+		assert.Empty(t, unconfirmedIDs)
+
+		_, err = s.CreateEmptyUnconfirmedTransaction(ctx, fromAddress, 4, 21000)
+		require.Error(t, err)
+
+		confirmed, unconfirmedIDs, err = s.MarkConfirmedAndReorgedTransactions(ctx, 4, fromAddress)
+		require.NoError(t, err)
+		assert.Empty(t, confirmed)
+		assert.Equal(t, []uint64{tx.ID}, unconfirmedIDs)
+	})
+
+	t.Run("abandons pending transactions", func(t *testing.T) {
+		require.NoError(t, s.AbandonPendingTransactions(ctx, fromAddress))
+		_, count, err := s.FetchUnconfirmedTransactionAtNonceWithCount(ctx, 4, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+
+		nextNonce, err := s.FetchNextNonce(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), nextNonce)
+	})
+
+	t.Run("finds no transaction for unknown idempotency key", func(t *testing.T) {
+		found, err := s.FindTxWithIdempotencyKey(ctx, "unknown")
+		require.NoError(t, err)
+		assert.Nil(t, found)
+	})
+}
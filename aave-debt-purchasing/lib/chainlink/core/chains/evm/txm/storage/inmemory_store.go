@@ -143,6 +143,7 @@ func (m *InMemoryStore) CreateTransaction(txRequest *types.TxRequest) *types.Tra
 		Value:             txRequest.Value,
 		Data:              txRequest.Data,
 		SpecifiedGasLimit: txRequest.SpecifiedGasLimit,
+		Priority:          txRequest.Priority,
 		CreatedAt:         time.Now(),
 		State:             txmgr.TxUnstarted,
 		Meta:              txRequest.Meta,
@@ -151,14 +152,16 @@ func (m *InMemoryStore) CreateTransaction(txRequest *types.TxRequest) *types.Tra
 		SignalCallback:    txRequest.SignalCallback,
 	}
 
-	uLen := len(m.UnstartedTransactions)
-	if uLen >= maxQueuedTransactions {
-		m.lggr.Warnw(fmt.Sprintf("Unstarted transactions queue for address: %v reached max limit of: %d. Dropping oldest transactions", m.address, maxQueuedTransactions),
-			"txs", m.UnstartedTransactions[0:uLen-maxQueuedTransactions+1]) // need to make room for the new tx
-		for _, tx := range m.UnstartedTransactions[0 : uLen-maxQueuedTransactions+1] {
-			delete(m.Transactions, tx.ID)
+	if len(m.UnstartedTransactions) >= maxQueuedTransactions {
+		var dropped []*types.Transaction
+		for len(m.UnstartedTransactions) >= maxQueuedTransactions { // need to make room for the new tx
+			i := m.lowestPriorityUnstartedIndex()
+			dropped = append(dropped, m.UnstartedTransactions[i])
+			delete(m.Transactions, m.UnstartedTransactions[i].ID)
+			m.UnstartedTransactions = append(m.UnstartedTransactions[:i], m.UnstartedTransactions[i+1:]...)
 		}
-		m.UnstartedTransactions = m.UnstartedTransactions[uLen-maxQueuedTransactions+1:]
+		m.lggr.Warnw(fmt.Sprintf("Unstarted transactions queue for address: %v reached max limit of: %d. Dropping oldest transactions of the lowest priority", m.address, maxQueuedTransactions),
+			"txs", dropped)
 	}
 
 	m.txIDCount++
@@ -168,6 +171,20 @@ func (m *InMemoryStore) CreateTransaction(txRequest *types.TxRequest) *types.Tra
 	return tx
 }
 
+func (m *InMemoryStore) FetchNextNonce() uint64 {
+	m.RLock()
+	defer m.RUnlock()
+
+	var nextNonce uint64
+	for nonce := range m.UnconfirmedTransactions {
+		nextNonce = max(nextNonce, nonce+1)
+	}
+	for nonce := range m.ConfirmedTransactions {
+		nextNonce = max(nextNonce, nonce+1)
+	}
+	return nextNonce
+}
+
 func (m *InMemoryStore) FetchUnconfirmedTransactionAtNonceWithCount(latestNonce uint64) (txCopy *types.Transaction, unconfirmedCount int) {
 	m.RLock()
 	defer m.RUnlock()
@@ -282,16 +299,41 @@ func (m *InMemoryStore) UpdateUnstartedTransactionWithNonce(nonce uint64) (*type
 		return nil, fmt.Errorf("an unconfirmed tx with the same nonce already exists: %v", tx)
 	}
 
-	tx := m.UnstartedTransactions[0]
+	i := m.highestPriorityUnstartedIndex()
+	tx := m.UnstartedTransactions[i]
 	tx.Nonce = &nonce
 	tx.State = txmgr.TxUnconfirmed
 
-	m.UnstartedTransactions = m.UnstartedTransactions[1:]
+	m.UnstartedTransactions = append(m.UnstartedTransactions[:i], m.UnstartedTransactions[i+1:]...)
 	m.UnconfirmedTransactions[nonce] = tx
 
 	return tx.DeepCopy(), nil
 }
 
+// highestPriorityUnstartedIndex returns the index of the oldest unstarted transaction of the highest priority.
+// Shouldn't call lock because it's being called by a method that already has the lock
+func (m *InMemoryStore) highestPriorityUnstartedIndex() int {
+	index := 0
+	for i, tx := range m.UnstartedTransactions {
+		if tx.Priority > m.UnstartedTransactions[index].Priority {
+			index = i
+		}
+	}
+	return index
+}
+
+// lowestPriorityUnstartedIndex returns the index of the oldest unstarted transaction of the lowest priority.
+// Shouldn't call lock because it's being called by a method that already has the lock
+func (m *InMemoryStore) lowestPriorityUnstartedIndex() int {
+	index := 0
+	for i, tx := range m.UnstartedTransactions {
+		if tx.Priority < m.UnstartedTransactions[index].Priority {
+			index = i
+		}
+	}
+	return index
+}
+
 // Shouldn't call lock because it's being called by a method that already has the lock
 func (m *InMemoryStore) pruneConfirmedTransactions() []uint64 {
 	noncesToPrune := make([]uint64, 0, len(m.ConfirmedTransactions))
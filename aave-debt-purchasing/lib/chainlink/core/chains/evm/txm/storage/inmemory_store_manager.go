@@ -74,6 +74,13 @@ func (m *InMemoryStoreManager) CreateTransaction(_ context.Context, txRequest *t
 	return nil, fmt.Errorf(StoreNotFoundForAddress, txRequest.FromAddress)
 }
 
+func (m *InMemoryStoreManager) FetchNextNonce(_ context.Context, fromAddress common.Address) (uint64, error) {
+	if store, exists := m.InMemoryStoreMap[fromAddress]; exists {
+		return store.FetchNextNonce(), nil
+	}
+	return 0, fmt.Errorf(StoreNotFoundForAddress, fromAddress)
+}
+
 func (m *InMemoryStoreManager) FetchUnconfirmedTransactionAtNonceWithCount(_ context.Context, nonce uint64, fromAddress common.Address) (tx *types.Transaction, count int, err error) {
 	if store, exists := m.InMemoryStoreMap[fromAddress]; exists {
 		tx, count = store.FetchUnconfirmedTransactionAtNonceWithCount(nonce)
@@ -187,6 +187,35 @@ func TestCreateTransaction(t *testing.T) {
 		//nolint:gosec // this won't overflow
 		assert.Equal(t, uint64(overshot), tx.ID)
 	})
+
+	t.Run("prunes unstarted transactions of the lowest priority first if limit is reached", func(t *testing.T) {
+		m := NewInMemoryStore(logger.Test(t), fromAddress, testutils.FixtureChainID)
+		urgentTx := m.CreateTransaction(&types.TxRequest{Priority: types.PriorityUrgent})
+		for i := 0; i < maxQueuedTransactions; i++ {
+			m.CreateTransaction(&types.TxRequest{})
+		}
+		assert.Equal(t, maxQueuedTransactions, m.CountUnstartedTransactions())
+		tx, err := m.UpdateUnstartedTransactionWithNonce(0)
+		require.NoError(t, err)
+		assert.Equal(t, urgentTx.ID, tx.ID)
+	})
+}
+
+func TestFetchNextNonce(t *testing.T) {
+	t.Parallel()
+
+	fromAddress := testutils.NewAddress()
+	m := NewInMemoryStore(logger.Test(t), fromAddress, testutils.FixtureChainID)
+	assert.Equal(t, uint64(0), m.FetchNextNonce())
+
+	_, err := insertConfirmedTransaction(m, 3)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), m.FetchNextNonce())
+
+	_, err = insertUnconfirmedTransaction(m, 5)
+	require.NoError(t, err)
+	insertUnstartedTransaction(m)
+	assert.Equal(t, uint64(6), m.FetchNextNonce())
 }
 
 func TestFetchUnconfirmedTransactionAtNonceWithCount(t *testing.T) {
@@ -385,6 +414,21 @@ func TestUpdateUnstartedTransactionWithNonce(t *testing.T) {
 		assert.Equal(t, txmgr.TxUnconfirmed, tx.State)
 		assert.Empty(t, m.UnstartedTransactions)
 	})
+
+	t.Run("picks the oldest unstarted transaction of the highest priority", func(t *testing.T) {
+		m := NewInMemoryStore(logger.Test(t), fromAddress, testutils.FixtureChainID)
+		insertUnstartedTransaction(m)
+		tx1 := m.CreateTransaction(&types.TxRequest{Priority: types.PriorityUrgent})
+		tx2 := m.CreateTransaction(&types.TxRequest{Priority: types.PriorityUrgent})
+
+		tx, err := m.UpdateUnstartedTransactionWithNonce(0)
+		require.NoError(t, err)
+		assert.Equal(t, tx1.ID, tx.ID)
+		tx, err = m.UpdateUnstartedTransactionWithNonce(1)
+		require.NoError(t, err)
+		assert.Equal(t, tx2.ID, tx.ID)
+		assert.Len(t, m.UnstartedTransactions, 1)
+	})
 }
 
 func TestDeleteAttemptForUnconfirmedTx(t *testing.T) {
@@ -13,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/types"
 )
@@ -37,6 +38,7 @@ type TxStore interface {
 	AppendAttemptToTransaction(context.Context, uint64, common.Address, *types.Attempt) error
 	CreateEmptyUnconfirmedTransaction(context.Context, common.Address, uint64, uint64) (*types.Transaction, error)
 	CreateTransaction(context.Context, *types.TxRequest) (*types.Transaction, error)
+	FetchNextNonce(context.Context, common.Address) (uint64, error)
 	FetchUnconfirmedTransactionAtNonceWithCount(context.Context, uint64, common.Address) (*types.Transaction, int, error)
 	MarkConfirmedAndReorgedTransactions(context.Context, uint64, common.Address) ([]*types.Transaction, []uint64, error)
 	MarkUnconfirmedTransactionPurgeable(context.Context, uint64, common.Address) error
@@ -70,6 +72,21 @@ type Config struct {
 	BlockTime           time.Duration
 	RetryBlockThreshold uint16
 	EmptyTxLimitDefault uint64
+	// FeePolicies holds the FeePolicy of each transaction priority. Transactions of a priority without a policy follow
+	// the defaults above.
+	FeePolicies map[types.Priority]FeePolicy
+}
+
+// FeePolicy controls the fees and retries of the transactions of a priority.
+type FeePolicy struct {
+	// MaxFeeCap caps the fee of the attempts, on top of the max gas price of the key. Nil means no additional cap.
+	MaxFeeCap *assets.Wei
+	// BumpPercent is the minimum fee increase over the previous attempt when a transaction is retried. Zero means
+	// retries get a new fee estimation instead of a bump.
+	BumpPercent uint16
+	// Deadline is the time after its creation by which a transaction is expected to be confirmed. Past the deadline,
+	// an unconfirmed transaction is retried at every block instead of every RetryBlockThreshold blocks. Zero disables it.
+	Deadline time.Duration
 }
 
 type Txm struct {
@@ -141,7 +158,7 @@ func (t *Txm) initializeNonce(ctx context.Context, address common.Address) {
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, pendingNonceDefaultTimeout)
 	defer cancel()
 	for {
-		pendingNonce, err := t.client.PendingNonceAt(ctxWithTimeout, address)
+		nonce, err := t.fetchInitialNonce(ctxWithTimeout, address)
 		if err != nil {
 			t.lggr.Errorw("Error when fetching initial nonce", "address", address, "err", err)
 			select {
@@ -152,12 +169,30 @@ func (t *Txm) initializeNonce(ctx context.Context, address common.Address) {
 			}
 			continue
 		}
-		t.setNonce(address, pendingNonce)
-		t.lggr.Debugf("Set initial nonce for address: %v to %d", address, pendingNonce)
+		t.setNonce(address, nonce)
+		t.lggr.Debugf("Set initial nonce for address: %v to %d", address, nonce)
 		return
 	}
 }
 
+// fetchInitialNonce returns the pending nonce of the address, unless the store has transactions with a higher nonce.
+// Those were assigned before a restart but may have never reached the mempool, and will be rebroadcasted by the backfill.
+func (t *Txm) fetchInitialNonce(ctx context.Context, address common.Address) (uint64, error) {
+	pendingNonce, err := t.client.PendingNonceAt(ctx, address)
+	if err != nil {
+		return 0, err
+	}
+	nextNonce, err := t.txStore.FetchNextNonce(ctx, address)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch next nonce from store: %w", err)
+	}
+	if nextNonce > pendingNonce {
+		t.lggr.Infow("Resuming from the nonce of stored transactions", "address", address, "pendingNonce", pendingNonce, "nextNonce", nextNonce)
+		return nextNonce, nil
+	}
+	return pendingNonce, nil
+}
+
 func (t *Txm) Close() error {
 	return t.StopOnce("Txm", func() error {
 		close(t.stopCh)
@@ -328,11 +363,37 @@ func (t *Txm) createAndSendAttempt(ctx context.Context, tx *types.Transaction, a
 	if err != nil {
 		return err
 	}
+	return t.appendAndSendAttempt(ctx, tx, attempt, address)
+}
+
+// createAndSendRetryAttempt bumps the fee of the last attempt if the FeePolicy of the transaction sets a BumpPercent,
+// otherwise it creates a new attempt.
+func (t *Txm) createAndSendRetryAttempt(ctx context.Context, tx *types.Transaction, address common.Address) error {
+	policy := t.config.FeePolicies[tx.Priority]
+	if policy.BumpPercent == 0 || tx.IsPurgeable || len(tx.Attempts) == 0 {
+		return t.createAndSendAttempt(ctx, tx, address)
+	}
+	attempt, err := t.attemptBuilder.NewBumpAttempt(ctx, t.lggr, tx, *tx.Attempts[len(tx.Attempts)-1])
+	if err != nil {
+		return err
+	}
+	return t.appendAndSendAttempt(ctx, tx, attempt, address)
+}
+
+// retryInterval returns how long to wait for an unconfirmed transaction before rebroadcasting it.
+func (t *Txm) retryInterval(tx *types.Transaction) time.Duration {
+	policy := t.config.FeePolicies[tx.Priority]
+	if policy.Deadline > 0 && time.Since(tx.CreatedAt) > policy.Deadline {
+		return t.config.BlockTime
+	}
+	return t.config.BlockTime * time.Duration(t.config.RetryBlockThreshold)
+}
 
+func (t *Txm) appendAndSendAttempt(ctx context.Context, tx *types.Transaction, attempt *types.Attempt, address common.Address) error {
 	if tx.Nonce == nil {
 		return fmt.Errorf("nonce for txID: %v is empty", tx.ID)
 	}
-	if err = t.txStore.AppendAttemptToTransaction(ctx, *tx.Nonce, address, attempt); err != nil {
+	if err := t.txStore.AppendAttemptToTransaction(ctx, *tx.Nonce, address, attempt); err != nil {
 		return err
 	}
 
@@ -422,10 +483,9 @@ func (t *Txm) backfillTransactions(ctx context.Context, address common.Address)
 				tx.PrintWithAttempts())
 		}
 
-		if tx.LastBroadcastAt == nil || time.Since(*tx.LastBroadcastAt) > (t.config.BlockTime*time.Duration(t.config.RetryBlockThreshold)) {
-			// TODO: add optional graceful bumping strategy
+		if tx.LastBroadcastAt == nil || time.Since(*tx.LastBroadcastAt) > t.retryInterval(tx) {
 			t.lggr.Info("Rebroadcasting attempt for txID: ", tx.ID)
-			return false, t.createAndSendAttempt(ctx, tx, address)
+			return false, t.createAndSendRetryAttempt(ctx, tx, address)
 		}
 	}
 	return false, nil
@@ -47,6 +47,22 @@ func TestLifecycle(t *testing.T) {
 		tests.AssertLogEventually(t, observedLogs, fmt.Sprintf("Set initial nonce for address: %v to %d", address1, 100))
 	})
 
+	t.Run("resumes from the nonce of stored transactions if higher than the pending nonce", func(t *testing.T) {
+		lggr, observedLogs := logger.TestObserved(t, zap.DebugLevel)
+		config := Config{BlockTime: 1 * time.Minute}
+		txStore := storage.NewInMemoryStoreManager(lggr, testutils.FixtureChainID)
+		require.NoError(t, txStore.Add(address2))
+		_, err := txStore.CreateTransaction(t.Context(), &types.TxRequest{FromAddress: address2})
+		require.NoError(t, err)
+		_, err = txStore.UpdateUnstartedTransactionWithNonce(t.Context(), address2, 5)
+		require.NoError(t, err)
+		keystore := keystest.Addresses{address2}
+		txm := NewTxm(lggr, testutils.FixtureChainID, client, nil, txStore, nil, config, keystore)
+		client.On("PendingNonceAt", mock.Anything, address2).Return(uint64(3), nil).Once()
+		servicetest.Run(t, txm)
+		tests.AssertLogEventually(t, observedLogs, fmt.Sprintf("Set initial nonce for address: %v to %d", address2, 6))
+	})
+
 	t.Run("tests lifecycle successfully without any transactions", func(t *testing.T) {
 		config := Config{BlockTime: 200 * time.Millisecond}
 		keystore := keystest.Addresses(addresses)
@@ -321,4 +337,51 @@ func TestBackfillTransactions(t *testing.T) {
 		require.NoError(t, err)
 		tests.AssertLogEventually(t, observedLogs, fmt.Sprintf("Rebroadcasting attempt for txID: %d", attempt.TxID))
 	})
+
+	t.Run("retries transaction past its deadline with a bump attempt", func(t *testing.T) {
+		lggr, observedLogs := logger.TestObserved(t, zap.DebugLevel)
+		txStore := storage.NewInMemoryStoreManager(lggr, testutils.FixtureChainID)
+		require.NoError(t, txStore.Add(address))
+		ab := newMockAttemptBuilder(t)
+		c := Config{EIP1559: false, BlockTime: 1 * time.Second, RetryBlockThreshold: 100, EmptyTxLimitDefault: 22000,
+			FeePolicies: map[types.Priority]FeePolicy{types.PriorityUrgent: {BumpPercent: 20, Deadline: time.Nanosecond}}}
+		txm := NewTxm(lggr, testutils.FixtureChainID, client, ab, txStore, nil, c, keystore)
+		emptyMetrics, err := NewTxmMetrics(testutils.FixtureChainID)
+		require.NoError(t, err)
+		txm.metrics = emptyMetrics
+
+		txRequest := &types.TxRequest{
+			ChainID:           testutils.FixtureChainID,
+			FromAddress:       address,
+			ToAddress:         testutils.NewAddress(),
+			SpecifiedGasLimit: 22000,
+			Priority:          types.PriorityUrgent,
+		}
+		tx, err := txm.CreateTransaction(t.Context(), txRequest)
+		require.NoError(t, err)
+		_, err = txStore.UpdateUnstartedTransactionWithNonce(t.Context(), address, 0)
+		require.NoError(t, err)
+
+		attempt := &types.Attempt{
+			TxID:     tx.ID,
+			Fee:      gas.EvmFee{GasPrice: assets.NewWeiI(1)},
+			GasLimit: 22000,
+		}
+		require.NoError(t, txStore.AppendAttemptToTransaction(t.Context(), 0, address, attempt))
+		require.NoError(t, txStore.UpdateTransactionBroadcast(t.Context(), tx.ID, 0, attempt.Hash, address))
+		time.Sleep(c.BlockTime) // the retry is due after a block instead of RetryBlockThreshold blocks
+
+		bumpAttempt := &types.Attempt{
+			TxID:     tx.ID,
+			Fee:      gas.EvmFee{GasPrice: assets.NewWeiI(2)},
+			GasLimit: 22000,
+		}
+		ab.On("NewBumpAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(bumpAttempt, nil).Once()
+
+		client.On("NonceAt", mock.Anything, address, mock.Anything).Return(uint64(0), nil).Once()
+		client.On("SendTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		_, err = txm.backfillTransactions(t.Context(), address)
+		require.NoError(t, err)
+		tests.AssertLogEventually(t, observedLogs, fmt.Sprintf("Rebroadcasting attempt for txID: %d", tx.ID))
+	})
 }
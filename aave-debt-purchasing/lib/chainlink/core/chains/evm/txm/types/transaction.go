@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -20,6 +21,55 @@ import (
 	commontypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
 )
 
+// Priority is the class of a transaction. Unstarted transactions of a higher priority are broadcasted before queued
+// transactions of a lower priority from the same address.
+type Priority uint8
+
+const (
+	PriorityNormal Priority = iota
+	PriorityHigh
+	PriorityUrgent
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityNormal:
+		return "normal"
+	case PriorityHigh:
+		return "high"
+	case PriorityUrgent:
+		return "urgent"
+	default:
+		return fmt.Sprintf("Priority(%d)", uint8(p))
+	}
+}
+
+// ParsePriority parses the name of a priority, as returned by Priority.String.
+func ParsePriority(s string) (Priority, error) {
+	for _, p := range []Priority{PriorityNormal, PriorityHigh, PriorityUrgent} {
+		if p.String() == s {
+			return p, nil
+		}
+	}
+	return PriorityNormal, fmt.Errorf("unknown transaction priority: %q", s)
+}
+
+type priorityCtxKey struct{}
+
+// WithPriority returns a copy of ctx requesting priority for the transactions created with it. It lets callers set
+// the priority of transactions created through interfaces that don't carry it in their request.
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityCtxKey{}, priority)
+}
+
+// PriorityFromContext returns the priority requested with WithPriority, or PriorityNormal.
+func PriorityFromContext(ctx context.Context) Priority {
+	if p, ok := ctx.Value(priorityCtxKey{}).(Priority); ok {
+		return p
+	}
+	return PriorityNormal
+}
+
 type Transaction struct {
 	ID                uint64
 	IdempotencyKey    *string
@@ -30,6 +80,7 @@ type Transaction struct {
 	Value             *big.Int
 	Data              []byte
 	SpecifiedGasLimit uint64
+	Priority          Priority
 
 	CreatedAt          time.Time
 	InitialBroadcastAt *time.Time
@@ -52,10 +103,10 @@ type Transaction struct {
 
 func (t *Transaction) String() string {
 	return fmt.Sprintf(`{txID:%d, IdempotencyKey:%v, ChainID:%v, Nonce:%s, FromAddress:%v, ToAddress:%v, Value:%v, `+
-		`Data:%s, SpecifiedGasLimit:%d, CreatedAt:%v, InitialBroadcastAt:%v, LastBroadcastAt:%v, State:%v, IsPurgeable:%v, AttemptCount:%d, `+
+		`Data:%s, SpecifiedGasLimit:%d, Priority:%v, CreatedAt:%v, InitialBroadcastAt:%v, LastBroadcastAt:%v, State:%v, IsPurgeable:%v, AttemptCount:%d, `+
 		`Meta:%v, Subject:%v}`,
 		t.ID, stringOrNull(t.IdempotencyKey), t.ChainID, stringOrNull(t.Nonce), t.FromAddress, t.ToAddress, t.Value,
-		base64.StdEncoding.EncodeToString(t.Data), t.SpecifiedGasLimit, t.CreatedAt, stringOrNull(t.InitialBroadcastAt), stringOrNull(t.LastBroadcastAt),
+		base64.StdEncoding.EncodeToString(t.Data), t.SpecifiedGasLimit, t.Priority, t.CreatedAt, stringOrNull(t.InitialBroadcastAt), stringOrNull(t.LastBroadcastAt),
 		t.State, t.IsPurgeable, t.AttemptCount, t.Meta, t.Subject)
 }
 
@@ -140,6 +191,7 @@ type TxRequest struct {
 	Value             *big.Int
 	Data              []byte
 	SpecifiedGasLimit uint64
+	Priority          Priority
 
 	Meta             *sqlutil.JSON // TODO: *TxMeta after migration
 	ForwarderAddress common.Address
@@ -23,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/clientwrappers"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/storage"
+	txmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/types"
 )
 
 type latestAndFinalizedBlockHeadTracker interface {
@@ -55,7 +56,7 @@ func NewTxm(
 	} else {
 		lggr.Info("EvmForwarderManager: Disabled")
 	}
-	checker := &CheckerFactory{Client: client}
+	checker := &CheckerFactory{Client: client, Policies: NewTxPolicyORM(ds, lggr), ChainID: client.ConfiguredChainID()}
 	// create tx attempt builder
 	txAttemptBuilder := NewEvmTxAttemptBuilder(*client.ConfiguredChainID(), fCfg, keyStore, estimator)
 	txStore := NewTxStore(ds, lggr)
@@ -76,6 +77,31 @@ func NewTxm(
 	return txm, nil
 }
 
+// NewEvmNonceRepairer constructs the dependencies of the NonceRepairer of a chain. The repairs are stored
+// in the store of the Txm of the chain, and sent with the attempt builder and client of the Txm.
+func NewEvmNonceRepairer(
+	ds sqlutil.DataSource,
+	chainConfig ChainConfig,
+	fCfg FeeConfig,
+	txConfig config.Transactions,
+	clientErrors config.ClientErrors,
+	dbConfig DatabaseConfig,
+	cfg NonceRepairConfig,
+	client client.Client,
+	lggr logger.Logger,
+	keyStore keys.ChainStore,
+	estimator gas.EvmFeeEstimator,
+) *NonceRepairer {
+	// Dual broadcast keeps sending the transactions with the Txm
+	txmV2Cfg := txConfig.TransactionManagerV2()
+	txmV2 := txmV2Cfg.Enabled() && (txmV2Cfg.DualBroadcast() == nil || !*txmV2Cfg.DualBroadcast())
+	txAttemptBuilder := NewEvmTxAttemptBuilder(*client.ConfiguredChainID(), fCfg, keyStore, estimator)
+	txStore := NewTxStore(ds, lggr)
+	txmClient := NewEvmTxmClient(client, clientErrors)
+	stuckTxDetector := NewStuckTxDetector(lggr, client.ConfiguredChainID(), chainConfig.ChainType(), fCfg.PriceMax(), txConfig.AutoPurge(), estimator, txStore, client)
+	return NewNonceRepairer(lggr, client.ConfiguredChainID(), cfg, txmV2, dbConfig, fCfg, keyStore, client, txStore, stuckTxDetector, estimator, txAttemptBuilder, txmClient)
+}
+
 // NewEvmTxm creates a new concrete EvmTxm
 func NewEvmTxm(
 	chainId *big.Int,
@@ -103,6 +129,7 @@ func NewTxmV2(
 	fCfg FeeConfig,
 	txConfig config.Transactions,
 	txmV2Config config.TransactionManagerV2,
+	txmV2FeeConfig TxmV2Config,
 	client client.Client,
 	lggr logger.Logger,
 	logPoller logpoller.LogPoller,
@@ -128,23 +155,57 @@ func NewTxmV2(
 		stuckTxDetector = txm.NewStuckTxDetector(lggr, chainConfig.ChainType(), stuckTxDetectorConfig)
 	}
 
-	attemptBuilder := txm.NewAttemptBuilder(fCfg.PriceMaxKey, estimator, keyStore)
-	inMemoryStoreManager := storage.NewInMemoryStoreManager(lggr, chainID)
+	feePolicies, err := newTxmV2FeePolicies(chainID, txmV2FeeConfig)
+	if err != nil {
+		return nil, err
+	}
+	txStore := storage.NewDBStore(lggr, ds, chainID)
 	config := txm.Config{
 		EIP1559:   fCfg.EIP1559DynamicFees(),
 		BlockTime: *txmV2Config.BlockTime(),
 		//nolint:gosec // reuse existing config until migration
 		RetryBlockThreshold: uint16(fCfg.BumpThreshold()),
 		EmptyTxLimitDefault: fCfg.LimitDefault(),
+		FeePolicies:         feePolicies,
 	}
+	attemptBuilder := txm.NewAttemptBuilder(fCfg.PriceMaxKey, estimator, keyStore, config.FeePolicies)
 	var c txm.Client
 	if txmV2Config.DualBroadcast() != nil && *txmV2Config.DualBroadcast() {
 		c = clientwrappers.NewDualBroadcastClient(client, keyStore, txmV2Config.CustomURL())
 	} else {
 		c = clientwrappers.NewChainClient(client)
 	}
-	t := txm.NewTxm(lggr, chainID, c, attemptBuilder, inMemoryStoreManager, stuckTxDetector, config, keyStore)
-	return txm.NewTxmOrchestrator(lggr, chainID, t, inMemoryStoreManager, fwdMgr, keyStore, attemptBuilder), nil
+	t := txm.NewTxm(lggr, chainID, c, attemptBuilder, txStore, stuckTxDetector, config, keyStore)
+	return txm.NewTxmOrchestrator(lggr, chainID, t, txStore, fwdMgr, keyStore, attemptBuilder), nil
+}
+
+// newTxmV2FeePolicies returns the fee policy of each priority for chainID. A policy for the chain takes precedence over
+// a policy without ChainID for the same priority.
+func newTxmV2FeePolicies(chainID *big.Int, cfg TxmV2Config) (map[txmtypes.Priority]txm.FeePolicy, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	policies := make(map[txmtypes.Priority]txm.FeePolicy)
+	chainSpecific := make(map[txmtypes.Priority]bool)
+	for _, p := range cfg.FeePolicies() {
+		if p.ChainID() != nil && p.ChainID().Cmp(chainID) != 0 {
+			continue
+		}
+		priority, err := txmtypes.ParsePriority(p.Priority())
+		if err != nil {
+			return nil, err
+		}
+		if p.ChainID() == nil && chainSpecific[priority] {
+			continue
+		}
+		chainSpecific[priority] = p.ChainID() != nil
+		policies[priority] = txm.FeePolicy{
+			MaxFeeCap:   p.MaxFeeCap(),
+			BumpPercent: p.BumpPercent(),
+			Deadline:    p.Deadline(),
+		}
+	}
+	return policies, nil
 }
 
 // NewEvmResender creates a new concrete EvmResender
@@ -0,0 +1,74 @@
+package txmgr
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm"
+	txmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/types"
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+type testFeePolicy struct {
+	chainID     *big.Int
+	priority    string
+	maxFeeCap   *assets.Wei
+	bumpPercent uint16
+	deadline    time.Duration
+}
+
+func (p testFeePolicy) ChainID() *big.Int       { return p.chainID }
+func (p testFeePolicy) Priority() string        { return p.priority }
+func (p testFeePolicy) MaxFeeCap() *assets.Wei  { return p.maxFeeCap }
+func (p testFeePolicy) BumpPercent() uint16     { return p.bumpPercent }
+func (p testFeePolicy) Deadline() time.Duration { return p.deadline }
+
+type testTxmV2Config []coreconfig.TxmV2FeePolicy
+
+func (c testTxmV2Config) FeePolicies() []coreconfig.TxmV2FeePolicy { return c }
+
+func TestNewTxmV2FeePolicies(t *testing.T) {
+	t.Parallel()
+
+	cfg := testTxmV2Config{
+		testFeePolicy{chainID: big.NewInt(1), priority: "urgent", maxFeeCap: assets.GWei(200), bumpPercent: 20},
+		testFeePolicy{priority: "urgent", maxFeeCap: assets.GWei(50)},
+		testFeePolicy{priority: "high", deadline: time.Minute},
+		testFeePolicy{chainID: big.NewInt(137), priority: "normal", bumpPercent: 10},
+	}
+
+	t.Run("chain specific policies take precedence", func(t *testing.T) {
+		policies, err := newTxmV2FeePolicies(big.NewInt(1), cfg)
+		require.NoError(t, err)
+		assert.Equal(t, map[txmtypes.Priority]txm.FeePolicy{
+			txmtypes.PriorityUrgent: {MaxFeeCap: assets.GWei(200), BumpPercent: 20},
+			txmtypes.PriorityHigh:   {Deadline: time.Minute},
+		}, policies)
+	})
+
+	t.Run("policies without chain ID apply to other chains", func(t *testing.T) {
+		policies, err := newTxmV2FeePolicies(big.NewInt(10), cfg)
+		require.NoError(t, err)
+		assert.Equal(t, map[txmtypes.Priority]txm.FeePolicy{
+			txmtypes.PriorityUrgent: {MaxFeeCap: assets.GWei(50)},
+			txmtypes.PriorityHigh:   {Deadline: time.Minute},
+		}, policies)
+	})
+
+	t.Run("unknown priority", func(t *testing.T) {
+		_, err := newTxmV2FeePolicies(big.NewInt(1), testTxmV2Config{testFeePolicy{priority: "low"}})
+		require.ErrorContains(t, err, "unknown transaction priority")
+	})
+
+	t.Run("no config", func(t *testing.T) {
+		policies, err := newTxmV2FeePolicies(big.NewInt(1), nil)
+		require.NoError(t, err)
+		assert.Empty(t, policies)
+	})
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/chaintype"
+
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 )
 
 // ChainConfig encompasses config used by txmgr package
@@ -43,6 +45,17 @@ type ListenerConfig interface {
 	FallbackPollInterval() time.Duration
 }
 
+type NonceRepairConfig interface {
+	Enabled() bool
+	AutoRepair() bool
+	PollPeriod() time.Duration
+	StuckThreshold() time.Duration
+}
+
+type TxmV2Config interface {
+	FeePolicies() []coreconfig.TxmV2FeePolicy
+}
+
 type (
 	EvmTxmConfig         txmgrtypes.TransactionManagerChainConfig
 	EvmTxmFeeConfig      txmgrtypes.TransactionManagerFeeConfig
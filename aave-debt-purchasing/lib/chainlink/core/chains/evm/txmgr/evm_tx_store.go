@@ -561,6 +561,31 @@ func (o *evmTxStore) InsertTxAttempt(ctx context.Context, attempt *TxAttempt) er
 	return pkgerrors.Wrap(err, "InsertTxAttempt failed")
 }
 
+// InsertUnconfirmedTx inserts etx as an unconfirmed transaction together with its in_progress attempt,
+// which is left for the Confirmer to send if the caller fails to. Used by the NonceRepairer to fill
+// nonce gaps without going through the Broadcaster, which assigns nonces of its own.
+func (o *evmTxStore) InsertUnconfirmedTx(ctx context.Context, etx *Tx, attempt *TxAttempt) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if etx.Sequence == nil {
+		return errors.New("InsertUnconfirmedTx failed: unconfirmed transaction must have nonce")
+	}
+	if etx.State != txmgr.TxUnconfirmed {
+		return fmt.Errorf("InsertUnconfirmedTx failed: transaction state must be unconfirmed, got %s", etx.State)
+	}
+	if attempt.State != txmgrtypes.TxAttemptInProgress {
+		return errors.New("InsertUnconfirmedTx failed: attempt state must be in_progress")
+	}
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		if err := orm.InsertTx(ctx, etx); err != nil {
+			return err
+		}
+		attempt.TxID = etx.ID
+		return orm.InsertTxAttempt(ctx, attempt)
+	})
+}
+
 // InsertReceipt only used in tests. Use SaveFetchedReceipts instead
 func (o *evmTxStore) InsertReceipt(ctx context.Context, receipt *types.Receipt) (int64, error) {
 	// convert to database representation
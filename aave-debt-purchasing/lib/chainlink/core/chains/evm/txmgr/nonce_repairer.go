@@ -0,0 +1,506 @@
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+	"github.com/smartcontractkit/chainlink-framework/multinode"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+// NonceRepairAction is the repair of a single nonce.
+type NonceRepairAction string
+
+const (
+	// NonceRepairFill fills a nonce held by no transaction with a zero-value transaction from the key to itself.
+	NonceRepairFill NonceRepairAction = "fill"
+	// NonceRepairRebroadcast rebroadcasts the stuck transaction holding a nonce with a higher gas price.
+	NonceRepairRebroadcast NonceRepairAction = "rebroadcast"
+)
+
+var (
+	// ErrNonceRepairKeyDisabled is returned when planning the repair of a key which is not enabled on the chain.
+	ErrNonceRepairKeyDisabled = errors.New("key is not enabled")
+	// ErrNonceRepairTxmV2 is returned by chains whose transactions are sent by TransactionManagerV2, which
+	// does not keep them in the store the NonceRepairer repairs.
+	ErrNonceRepairTxmV2 = errors.New("nonce repair is not supported by TransactionManagerV2")
+)
+
+// maxNonceRepairSteps bounds the size of a plan. Larger gaps are repaired over several plans.
+const maxNonceRepairSteps = 100
+
+// defaultNonceRepairStuckThreshold is used when the NonceRepairer is not configured.
+const defaultNonceRepairStuckThreshold = 10 * time.Minute
+
+type NonceRepairStep struct {
+	Nonce  evmtypes.Nonce
+	Action NonceRepairAction
+	// TxID is the transaction to rebroadcast, and zero for fills.
+	TxID int64
+}
+
+// NonceRepairPlan is the repair of the nonce gaps and stuck transaction of a key.
+type NonceRepairPlan struct {
+	Address      common.Address
+	OnChainNonce evmtypes.Nonce
+	// GasPrice is the legacy gas price of every repair transaction.
+	GasPrice *assets.Wei
+	// Steps are sorted by nonce.
+	Steps []NonceRepairStep
+}
+
+// Nonces returns the nonces repaired by p.
+func (p NonceRepairPlan) Nonces() []evmtypes.Nonce {
+	nonces := make([]evmtypes.Nonce, len(p.Steps))
+	for i, step := range p.Steps {
+		nonces[i] = step.Nonce
+	}
+	return nonces
+}
+
+// Retain returns p without the steps whose nonce is not in nonces.
+func (p NonceRepairPlan) Retain(nonces []evmtypes.Nonce) NonceRepairPlan {
+	p.Steps = slices.DeleteFunc(slices.Clone(p.Steps), func(step NonceRepairStep) bool {
+		return !slices.Contains(nonces, step.Nonce)
+	})
+	return p
+}
+
+type nonceRepairerKeyStore interface {
+	CheckEnabled(ctx context.Context, address common.Address) error
+	EnabledAddresses(ctx context.Context) ([]common.Address, error)
+}
+
+type nonceRepairerClient interface {
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+type nonceRepairerTxStore interface {
+	FindTxsByStateAndFromAddresses(ctx context.Context, addresses []common.Address, state types.TxState, chainID *big.Int) (txs []*Tx, err error)
+	FindTxWithSequence(ctx context.Context, fromAddress common.Address, seq evmtypes.Nonce) (etx *Tx, err error)
+	FindTxWithAttempts(ctx context.Context, etxID int64) (etx Tx, err error)
+	InsertUnconfirmedTx(ctx context.Context, etx *Tx, attempt *TxAttempt) error
+	SaveInProgressAttempt(ctx context.Context, attempt *TxAttempt) error
+	SaveSentAttempt(ctx context.Context, timeout time.Duration, attempt *TxAttempt, broadcastAt time.Time) error
+}
+
+type nonceRepairerStuckTxDetector interface {
+	FindUnconfirmedTxWithLowestNonce(ctx context.Context, enabledAddresses []common.Address) ([]Tx, error)
+}
+
+type nonceRepairerFeeConfig interface {
+	BumpPercent() uint16
+	LimitDefault() uint64
+	PriceMaxKey(common.Address) *assets.Wei
+}
+
+type nonceRepairerAttemptBuilder interface {
+	NewCustomTxAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, gasLimit uint64, txType int, lggr logger.Logger) (attempt TxAttempt, retryable bool, err error)
+}
+
+type nonceRepairerTxmClient interface {
+	SendTransactionReturnCode(ctx context.Context, etx Tx, attempt TxAttempt, lggr logger.SugaredLogger) (multinode.SendTxReturnCode, error)
+}
+
+// NonceRepairer finds the nonce gaps and stuck transactions blocking the keys of a chain, and repairs
+// them by filling the gaps and rebroadcasting the stuck transactions.
+//
+// It runs alongside the transaction manager of the chain: every repair is stored as an in_progress attempt
+// of an unconfirmed transaction before it is sent, as the Confirmer does for its gas bumps, so the Confirmer
+// tracks, resends and bumps the repairs as its own. Fills are stored as unconfirmed transactions directly,
+// and never pass through the Broadcaster, so its nonce tracking is left alone. The unique indexes of the
+// store on in_progress attempts and on nonces reject repairs racing with the transaction manager.
+//
+// If enabled by its config, it checks the keys periodically, and either repairs them or logs the plans
+// for an operator to approve them.
+type NonceRepairer struct {
+	services.StateMachine
+	lggr    logger.SugaredLogger
+	chainID *big.Int
+	cfg     NonceRepairConfig
+	// txmV2 is set if the transactions of the chain are sent by TransactionManagerV2.
+	txmV2        bool
+	queryTimeout time.Duration
+
+	feeConfig       nonceRepairerFeeConfig
+	keyStore        nonceRepairerKeyStore
+	client          nonceRepairerClient
+	txStore         nonceRepairerTxStore
+	stuckTxDetector nonceRepairerStuckTxDetector
+	gasEstimator    stuckTxDetectorGasEstimator
+	attemptBuilder  nonceRepairerAttemptBuilder
+	txmClient       nonceRepairerTxmClient
+
+	// executeMu serializes the repairs of the periodic check and of the operators.
+	executeMu sync.Mutex
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+// NewNonceRepairer returns a NonceRepairer. A nil cfg disables the periodic check. If txmV2 is set, it
+// refuses to plan or execute repairs with ErrNonceRepairTxmV2.
+func NewNonceRepairer(
+	lggr logger.Logger,
+	chainID *big.Int,
+	cfg NonceRepairConfig,
+	txmV2 bool,
+	dbConfig DatabaseConfig,
+	feeConfig nonceRepairerFeeConfig,
+	keyStore nonceRepairerKeyStore,
+	client nonceRepairerClient,
+	txStore nonceRepairerTxStore,
+	stuckTxDetector nonceRepairerStuckTxDetector,
+	gasEstimator stuckTxDetectorGasEstimator,
+	attemptBuilder nonceRepairerAttemptBuilder,
+	txmClient nonceRepairerTxmClient,
+) *NonceRepairer {
+	return &NonceRepairer{
+		lggr:            logger.Sugared(logger.Named(lggr, "NonceRepairer")),
+		chainID:         chainID,
+		cfg:             cfg,
+		txmV2:           txmV2,
+		queryTimeout:    dbConfig.DefaultQueryTimeout(),
+		feeConfig:       feeConfig,
+		keyStore:        keyStore,
+		client:          client,
+		txStore:         txStore,
+		stuckTxDetector: stuckTxDetector,
+		gasEstimator:    gasEstimator,
+		attemptBuilder:  attemptBuilder,
+		txmClient:       txmClient,
+		stopCh:          make(chan struct{}),
+	}
+}
+
+func (r *NonceRepairer) Start(context.Context) error {
+	return r.StartOnce("NonceRepairer", func() error {
+		if r.cfg == nil || !r.cfg.Enabled() {
+			r.lggr.Debug("Periodic nonce repair disabled")
+			return nil
+		}
+		if r.txmV2 {
+			r.lggr.Warnw("Periodic nonce repair disabled", "err", ErrNonceRepairTxmV2)
+			return nil
+		}
+		r.wg.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *NonceRepairer) Close() error {
+	return r.StopOnce("NonceRepairer", func() error {
+		close(r.stopCh)
+		r.wg.Wait()
+		return nil
+	})
+}
+
+func (r *NonceRepairer) Name() string {
+	return r.lggr.Name()
+}
+
+func (r *NonceRepairer) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
+
+func (r *NonceRepairer) run() {
+	defer r.wg.Done()
+	ctx, cancel := r.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(r.cfg.PollPeriod())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.repairAll(ctx)
+		}
+	}
+}
+
+func (r *NonceRepairer) repairAll(ctx context.Context) {
+	plans, err := r.Plan(ctx)
+	if err != nil {
+		r.lggr.Errorw("Failed to plan nonce repairs", "err", err)
+		return
+	}
+	for _, plan := range plans {
+		if !r.cfg.AutoRepair() {
+			r.lggr.Warnw("Found nonce gaps or a stuck transaction, run `chainlink txs repair` to repair them",
+				"address", plan.Address, "onChainNonce", plan.OnChainNonce, "steps", plan.Steps, "gasPrice", plan.GasPrice)
+			continue
+		}
+		if err := r.Execute(ctx, plan); err != nil {
+			r.lggr.Errorw("Failed to repair nonces", "address", plan.Address, "err", err)
+		}
+	}
+}
+
+// Plan returns the repair plans of addresses, or of all the enabled addresses if none are given.
+// Addresses with nothing to repair are left out.
+func (r *NonceRepairer) Plan(ctx context.Context, addresses ...common.Address) ([]NonceRepairPlan, error) {
+	if r.txmV2 {
+		return nil, ErrNonceRepairTxmV2
+	}
+	if len(addresses) == 0 {
+		var err error
+		if addresses, err = r.keyStore.EnabledAddresses(ctx); err != nil {
+			return nil, fmt.Errorf("failed to get enabled addresses: %w", err)
+		}
+	} else {
+		for _, address := range addresses {
+			if err := r.keyStore.CheckEnabled(ctx, address); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrNonceRepairKeyDisabled, err)
+			}
+		}
+	}
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	txs, err := r.txStore.FindTxsByStateAndFromAddresses(ctx, addresses, txmgr.TxUnconfirmed, r.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find unconfirmed transactions: %w", err)
+	}
+	unconfirmed := make(map[common.Address][]*Tx)
+	for _, tx := range txs {
+		unconfirmed[tx.FromAddress] = append(unconfirmed[tx.FromAddress], tx)
+	}
+	lowestTxs, err := r.stuckTxDetector.FindUnconfirmedTxWithLowestNonce(ctx, addresses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find unconfirmed transactions with lowest nonce: %w", err)
+	}
+	lowest := make(map[common.Address]Tx, len(lowestTxs))
+	for _, tx := range lowestTxs {
+		lowest[tx.FromAddress] = tx
+	}
+
+	var plans []NonceRepairPlan
+	for _, address := range addresses {
+		// Keys without unconfirmed transactions have nothing stuck and no gap to fill
+		if len(unconfirmed[address]) == 0 {
+			continue
+		}
+		var lowestTx *Tx
+		if tx, ok := lowest[address]; ok {
+			lowestTx = &tx
+		}
+		plan, err := r.plan(ctx, address, unconfirmed[address], lowestTx)
+		if err != nil {
+			return nil, err
+		}
+		if len(plan.Steps) > 0 {
+			plans = append(plans, plan)
+		}
+	}
+	return plans, nil
+}
+
+func (r *NonceRepairer) plan(ctx context.Context, address common.Address, unconfirmed []*Tx, lowest *Tx) (NonceRepairPlan, error) {
+	n, err := r.client.NonceAt(ctx, address, nil)
+	if err != nil {
+		return NonceRepairPlan{}, fmt.Errorf("failed to get on-chain nonce of %s: %w", address, err)
+	}
+	plan := NonceRepairPlan{Address: address, OnChainNonce: evmtypes.Nonce(n)}
+
+	// Only the transaction with the on-chain nonce can be stuck, the ones above are waiting for it.
+	var stuck *Tx
+	if lowest != nil && *lowest.Sequence == plan.OnChainNonce && r.isStuck(*lowest) {
+		stuck = lowest
+		plan.Steps = append(plan.Steps, NonceRepairStep{Nonce: plan.OnChainNonce, Action: NonceRepairRebroadcast, TxID: lowest.ID})
+	}
+
+	// Nonces below the highest unconfirmed one are gaps if no transaction holds them. In progress
+	// transactions always hold the next nonce, so they are never in this range.
+	held := make(map[evmtypes.Nonce]bool, len(unconfirmed))
+	highest := plan.OnChainNonce
+	for _, tx := range unconfirmed {
+		held[*tx.Sequence] = true
+		highest = max(highest, *tx.Sequence)
+	}
+	for nonce := plan.OnChainNonce; nonce < highest && len(plan.Steps) < maxNonceRepairSteps; nonce++ {
+		if held[nonce] {
+			continue
+		}
+		tx, err := r.txStore.FindTxWithSequence(ctx, address, nonce)
+		if err != nil {
+			return NonceRepairPlan{}, fmt.Errorf("failed to find transaction with nonce %d of %s: %w", nonce, address, err)
+		}
+		if tx != nil {
+			// Confirmed by a node ahead of the one we asked for the on-chain nonce.
+			continue
+		}
+		plan.Steps = append(plan.Steps, NonceRepairStep{Nonce: nonce, Action: NonceRepairFill})
+	}
+	if len(plan.Steps) == 0 {
+		return plan, nil
+	}
+
+	plan.GasPrice, err = r.gasPrice(ctx, address, stuck)
+	if err != nil {
+		return NonceRepairPlan{}, err
+	}
+	return plan, nil
+}
+
+func (r *NonceRepairer) isStuck(tx Tx) bool {
+	threshold := defaultNonceRepairStuckThreshold
+	if r.cfg != nil {
+		threshold = r.cfg.StuckThreshold()
+	}
+	return tx.InitialBroadcastAt != nil && time.Since(*tx.InitialBroadcastAt) >= threshold
+}
+
+// gasPrice returns the current estimate, or the highest price paid for the stuck transaction bumped by
+// BumpPercent if that is higher, so that the rebroadcast replaces it. It is capped at PriceMaxKey.
+func (r *NonceRepairer) gasPrice(ctx context.Context, address common.Address, stuck *Tx) (*assets.Wei, error) {
+	maxPrice := r.feeConfig.PriceMaxKey(address)
+	fee, _, err := r.gasEstimator.GetFee(ctx, nil, r.feeConfig.LimitDefault(), maxPrice, &address, &address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate gas price: %w", err)
+	}
+	price := legacyGasPrice(fee)
+	if stuck != nil {
+		for _, attempt := range stuck.TxAttempts {
+			if paid := legacyGasPrice(attempt.TxFee); paid != nil {
+				if bumped := paid.AddPercentage(r.feeConfig.BumpPercent()); price == nil || bumped.Cmp(price) > 0 {
+					price = bumped
+				}
+			}
+		}
+	}
+	if price == nil {
+		return nil, fmt.Errorf("no gas price estimated for %s", address)
+	}
+	if price.Cmp(maxPrice) > 0 {
+		price = maxPrice
+	}
+	return price, nil
+}
+
+// legacyGasPrice returns the gas price of a legacy fee, and the fee cap of a dynamic one.
+func legacyGasPrice(fee gas.EvmFee) *assets.Wei {
+	if fee.GasPrice != nil {
+		return fee.GasPrice
+	}
+	return fee.GasFeeCap
+}
+
+// Execute stores and broadcasts the repair transactions of plan. The steps are applied independently,
+// and the errors of the failed ones are returned together. A repair stored but not sent is left in_progress,
+// for the Confirmer to send it with the next head.
+func (r *NonceRepairer) Execute(ctx context.Context, plan NonceRepairPlan) error {
+	if r.txmV2 {
+		return ErrNonceRepairTxmV2
+	}
+	if len(plan.Steps) == 0 {
+		return nil
+	}
+	r.executeMu.Lock()
+	defer r.executeMu.Unlock()
+
+	r.lggr.Infow("Repairing nonces", "address", plan.Address, "steps", plan.Steps, "gasPrice", plan.GasPrice)
+	fee := gas.EvmFee{GasPrice: plan.GasPrice}
+	var errs error
+	for _, step := range plan.Steps {
+		var err error
+		switch step.Action {
+		case NonceRepairFill:
+			err = r.fill(ctx, plan.Address, step.Nonce, fee)
+		case NonceRepairRebroadcast:
+			err = r.rebroadcast(ctx, plan.Address, step, fee)
+		default:
+			err = fmt.Errorf("unknown action %q", step.Action)
+		}
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to %s nonce %d of %s: %w", step.Action, step.Nonce, plan.Address, err))
+		}
+	}
+	return errs
+}
+
+// fill stores and sends a zero-value transaction from address to itself with nonce.
+func (r *NonceRepairer) fill(ctx context.Context, address common.Address, nonce evmtypes.Nonce, fee gas.EvmFee) error {
+	held, err := r.txStore.FindTxWithSequence(ctx, address, nonce)
+	if err != nil {
+		return fmt.Errorf("failed to find transaction with nonce: %w", err)
+	}
+	if held != nil {
+		return fmt.Errorf("nonce is held by transaction %d", held.ID)
+	}
+
+	now := time.Now()
+	etx := Tx{
+		FromAddress:        address,
+		ToAddress:          address,
+		EncodedPayload:     []byte{},
+		Value:              *big.NewInt(0),
+		FeeLimit:           r.feeConfig.LimitDefault(),
+		Sequence:           &nonce,
+		State:              txmgr.TxUnconfirmed,
+		BroadcastAt:        &now,
+		InitialBroadcastAt: &now,
+		ChainID:            r.chainID,
+	}
+	attempt, _, err := r.attemptBuilder.NewCustomTxAttempt(ctx, etx, fee, etx.FeeLimit, 0x0, r.lggr)
+	if err != nil {
+		return fmt.Errorf("failed to create attempt: %w", err)
+	}
+	if err = r.txStore.InsertUnconfirmedTx(ctx, &etx, &attempt); err != nil {
+		return fmt.Errorf("failed to store transaction: %w", err)
+	}
+	return r.send(ctx, etx, attempt)
+}
+
+// rebroadcast stores and sends a new attempt of the stuck transaction of step.
+func (r *NonceRepairer) rebroadcast(ctx context.Context, address common.Address, step NonceRepairStep, fee gas.EvmFee) error {
+	etx, err := r.txStore.FindTxWithAttempts(ctx, step.TxID)
+	if err != nil {
+		return fmt.Errorf("failed to find transaction %d: %w", step.TxID, err)
+	}
+	// The plan may be stale
+	if etx.FromAddress != address || etx.Sequence == nil || *etx.Sequence != step.Nonce || etx.State != txmgr.TxUnconfirmed {
+		return fmt.Errorf("transaction %d is no longer unconfirmed with this nonce", step.TxID)
+	}
+
+	attempt, _, err := r.attemptBuilder.NewCustomTxAttempt(ctx, etx, fee, etx.FeeLimit, 0x0, r.lggr)
+	if err != nil {
+		return fmt.Errorf("failed to create attempt: %w", err)
+	}
+	if err = r.txStore.SaveInProgressAttempt(ctx, &attempt); err != nil {
+		return fmt.Errorf("failed to store attempt: %w", err)
+	}
+	return r.send(ctx, etx, attempt)
+}
+
+// send broadcasts the stored in_progress attempt, and marks it broadcast once it is accepted.
+func (r *NonceRepairer) send(ctx context.Context, etx Tx, attempt TxAttempt) error {
+	now := time.Now()
+	code, err := r.txmClient.SendTransactionReturnCode(ctx, etx, attempt, r.lggr)
+	if code != multinode.Successful {
+		return fmt.Errorf("failed to send attempt %s, left for the Confirmer: %s: %w", attempt.Hash, code, err)
+	}
+	if err = r.txStore.SaveSentAttempt(ctx, r.queryTimeout, &attempt, now); err != nil {
+		return fmt.Errorf("failed to mark attempt %s broadcast: %w", attempt.Hash, err)
+	}
+	r.lggr.Infow("Sent nonce repair", "txID", etx.ID, "nonce", *etx.Sequence, "txHash", attempt.Hash)
+	return nil
+}
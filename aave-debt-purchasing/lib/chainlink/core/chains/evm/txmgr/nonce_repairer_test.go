@@ -0,0 +1,325 @@
+package txmgr_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+	"github.com/smartcontractkit/chainlink-framework/multinode"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	evmconfig "github.com/smartcontractkit/chainlink-evm/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/configtest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
+	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
+	gasmocks "github.com/smartcontractkit/chainlink-evm/pkg/gas/mocks"
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys/keystest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+)
+
+func TestNonceRepairer_Plan(t *testing.T) {
+	t.Parallel()
+
+	notStuck := testNonceRepairConfig{stuckThreshold: time.Hour}
+	stuck := testNonceRepairConfig{stuckThreshold: time.Nanosecond}
+
+	t.Run("returns no plan if nothing is blocked", func(t *testing.T) {
+		r := newTestNonceRepairer(t, notStuck, nil)
+		ethClient, fromAddress := r.ethClient, r.fromAddress
+		ethClient.On("NonceAt", mock.Anything, fromAddress, mock.Anything).Return(uint64(1), nil)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, r.txStore, 1, fromAddress)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, r.txStore, 2, fromAddress)
+
+		plans, err := r.Plan(tests.Context(t))
+		require.NoError(t, err)
+		assert.Empty(t, plans)
+	})
+
+	t.Run("fills the nonces held by no transaction", func(t *testing.T) {
+		r := newTestNonceRepairer(t, notStuck, nil)
+		ethClient, fromAddress := r.ethClient, r.fromAddress
+		ethClient.On("NonceAt", mock.Anything, fromAddress, mock.Anything).Return(uint64(0), nil)
+		r.estimator.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{GasPrice: oneGwei}, uint64(0), nil)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, r.txStore, 0, fromAddress)
+		// Confirmed by a node ahead of the one returning the on-chain nonce
+		mustInsertConfirmedEthTxWithReceipt(t, r.txStore, fromAddress, 1, 42)
+		mustInsertFatalErrorTxWithError(t, r.txStore, 2, fromAddress, 42)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, r.txStore, 4, fromAddress)
+
+		plans, err := r.Plan(tests.Context(t))
+		require.NoError(t, err)
+		require.Len(t, plans, 1)
+		assert.Equal(t, fromAddress, plans[0].Address)
+		assert.Equal(t, evmtypes.Nonce(0), plans[0].OnChainNonce)
+		assert.Equal(t, oneGwei, plans[0].GasPrice)
+		assert.Equal(t, []txmgr.NonceRepairStep{
+			{Nonce: 2, Action: txmgr.NonceRepairFill},
+			{Nonce: 3, Action: txmgr.NonceRepairFill},
+		}, plans[0].Steps)
+	})
+
+	t.Run("rebroadcasts the stuck transaction with a bumped gas price", func(t *testing.T) {
+		r := newTestNonceRepairer(t, stuck, nil)
+		ethClient, fromAddress := r.ethClient, r.fromAddress
+		ethClient.On("NonceAt", mock.Anything, fromAddress, mock.Anything).Return(uint64(5), nil)
+		r.estimator.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{GasPrice: oneGwei}, uint64(0), nil)
+		etx := mustInsertUnconfirmedTxWithBroadcastAttempts(t, r.txStore, 5, fromAddress, 2, 100, tenGwei)
+		// Waiting for the stuck transaction
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, r.txStore, 6, fromAddress)
+
+		plans, err := r.Plan(tests.Context(t), fromAddress)
+		require.NoError(t, err)
+		require.Len(t, plans, 1)
+		assert.Equal(t, []txmgr.NonceRepairStep{
+			{Nonce: 5, Action: txmgr.NonceRepairRebroadcast, TxID: etx.ID},
+		}, plans[0].Steps)
+		assert.Equal(t, tenGwei.AddPercentage(r.feeConfig.BumpPercent()), plans[0].GasPrice)
+	})
+
+	t.Run("caps the gas price at PriceMax", func(t *testing.T) {
+		priceMax := assets.NewWeiI(11_000_000_000)
+		r := newTestNonceRepairer(t, stuck, func(c *toml.EVMConfig) {
+			c.GasEstimator.PriceDefault = oneGwei
+			c.GasEstimator.PriceMax = priceMax
+		})
+		ethClient, fromAddress := r.ethClient, r.fromAddress
+		ethClient.On("NonceAt", mock.Anything, fromAddress, mock.Anything).Return(uint64(0), nil)
+		r.estimator.On("GetFee", mock.Anything, mock.Anything, mock.Anything, priceMax, mock.Anything, mock.Anything).Return(gas.EvmFee{GasPrice: oneGwei}, uint64(0), nil)
+		mustInsertUnconfirmedTxWithBroadcastAttempts(t, r.txStore, 0, fromAddress, 1, 100, tenGwei)
+
+		plans, err := r.Plan(tests.Context(t))
+		require.NoError(t, err)
+		require.Len(t, plans, 1)
+		assert.Equal(t, priceMax, plans[0].GasPrice)
+	})
+
+	t.Run("returns an error for a key which is not enabled", func(t *testing.T) {
+		r := newTestNonceRepairer(t, notStuck, nil)
+
+		_, err := r.Plan(tests.Context(t), testutils.NewAddress())
+		require.ErrorIs(t, err, txmgr.ErrNonceRepairKeyDisabled)
+	})
+}
+
+func TestNonceRepairer_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stores and sends the approved repairs", func(t *testing.T) {
+		r := newTestNonceRepairer(t, testNonceRepairConfig{}, nil)
+		ctx := tests.Context(t)
+		etx := mustInsertUnconfirmedTxWithBroadcastAttempts(t, r.txStore, 3, r.fromAddress, 1, 100, oneGwei)
+		plan := txmgr.NonceRepairPlan{
+			Address:  r.fromAddress,
+			GasPrice: tenGwei,
+			Steps: []txmgr.NonceRepairStep{
+				{Nonce: 3, Action: txmgr.NonceRepairRebroadcast, TxID: etx.ID},
+				{Nonce: 4, Action: txmgr.NonceRepairFill},
+				{Nonce: 5, Action: txmgr.NonceRepairFill},
+			},
+		}
+		r.ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, r.fromAddress).Return(multinode.Successful, nil).Twice()
+
+		require.NoError(t, r.Execute(ctx, plan.Retain([]evmtypes.Nonce{3, 5})))
+		assert.Len(t, plan.Steps, 3, "Retain must not modify the plan")
+
+		etx, err := r.txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.TxAttempts, 2)
+		assert.Equal(t, tenGwei, etx.TxAttempts[0].TxFee.GasPrice)
+		assert.Equal(t, txmgrtypes.TxAttemptBroadcast, etx.TxAttempts[0].State)
+
+		fill, err := r.txStore.FindTxWithSequence(ctx, r.fromAddress, 5)
+		require.NoError(t, err)
+		require.NotNil(t, fill)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, fill.State)
+		assert.Equal(t, r.fromAddress, fill.ToAddress)
+		*fill, err = r.txStore.FindTxWithAttempts(ctx, fill.ID)
+		require.NoError(t, err)
+		require.Len(t, fill.TxAttempts, 1)
+		assert.Equal(t, tenGwei, fill.TxAttempts[0].TxFee.GasPrice)
+		assert.Equal(t, txmgrtypes.TxAttemptBroadcast, fill.TxAttempts[0].State)
+
+		notApproved, err := r.txStore.FindTxWithSequence(ctx, r.fromAddress, 4)
+		require.NoError(t, err)
+		assert.Nil(t, notApproved)
+
+		require.NoError(t, r.Execute(ctx, plan.Retain(nil)), "empty plans are not executed")
+	})
+
+	t.Run("returns the errors of every failed repair", func(t *testing.T) {
+		r := newTestNonceRepairer(t, testNonceRepairConfig{}, nil)
+		ctx := tests.Context(t)
+		// Taken since the plan was made
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, r.txStore, 1, r.fromAddress)
+		plan := txmgr.NonceRepairPlan{
+			Address:  r.fromAddress,
+			GasPrice: tenGwei,
+			Steps: []txmgr.NonceRepairStep{
+				{Nonce: 0, Action: txmgr.NonceRepairRebroadcast, TxID: 42},
+				{Nonce: 1, Action: txmgr.NonceRepairFill},
+				{Nonce: 2, Action: txmgr.NonceRepairFill},
+				{Nonce: 3, Action: txmgr.NonceRepairFill},
+			},
+		}
+		r.ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, r.fromAddress).Return(multinode.Unknown, errors.New("connection refused")).Once()
+		r.ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, r.fromAddress).Return(multinode.Successful, nil).Once()
+
+		err := r.Execute(ctx, plan)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to rebroadcast nonce 0")
+		assert.Contains(t, err.Error(), "failed to fill nonce 1")
+		assert.Contains(t, err.Error(), "failed to fill nonce 2")
+		assert.Contains(t, err.Error(), "connection refused")
+		assert.NotContains(t, err.Error(), "nonce 3")
+
+		// Left for the Confirmer to send
+		unsent, err := r.txStore.FindTxWithSequence(ctx, r.fromAddress, 2)
+		require.NoError(t, err)
+		require.NotNil(t, unsent)
+		*unsent, err = r.txStore.FindTxWithAttempts(ctx, unsent.ID)
+		require.NoError(t, err)
+		require.Len(t, unsent.TxAttempts, 1)
+		assert.Equal(t, txmgrtypes.TxAttemptInProgress, unsent.TxAttempts[0].State)
+
+		sent, err := r.txStore.FindTxWithSequence(ctx, r.fromAddress, 3)
+		require.NoError(t, err)
+		assert.NotNil(t, sent)
+	})
+}
+
+func TestNonceRepairer_TxmV2(t *testing.T) {
+	t.Parallel()
+
+	db := testutils.NewSqlxDB(t)
+	txStore := txmgr.NewTxStore(db, logger.Test(t))
+	memKS := keystest.NewMemoryChainStore()
+	memKS.MustCreate(t)
+	ethClient := clienttest.NewClientWithDefaultChainID(t)
+	keyStore := keys.NewChainStore(memKS, ethClient.ConfiguredChainID())
+	evmcfg := configtest.NewChainScopedConfig(t, nil)
+	_, dbConfig, _ := txmgr.MakeTestConfigs(t)
+	estimator := gasmocks.NewEvmFeeEstimator(t)
+	r := txmgr.NewNonceRepairer(logger.Test(t), testutils.FixtureChainID, testNonceRepairConfig{enabled: true, autoRepair: true, pollPeriod: time.Millisecond}, true,
+		dbConfig, evmcfg.EVM().GasEstimator(), keyStore, ethClient, txStore, nil, estimator, nil, txmgr.NewEvmTxmClient(ethClient, nil))
+	servicetest.Run(t, r)
+
+	_, err := r.Plan(tests.Context(t))
+	require.ErrorIs(t, err, txmgr.ErrNonceRepairTxmV2)
+	err = r.Execute(tests.Context(t), txmgr.NonceRepairPlan{Steps: []txmgr.NonceRepairStep{{Nonce: 1, Action: txmgr.NonceRepairFill}}})
+	require.ErrorIs(t, err, txmgr.ErrNonceRepairTxmV2)
+}
+
+func TestNonceRepairer_AutoRepair(t *testing.T) {
+	t.Parallel()
+
+	newRepairer := func(t *testing.T, autoRepair bool) (testNonceRepairer, chan struct{}) {
+		r := newTestNonceRepairer(t, testNonceRepairConfig{
+			enabled:        true,
+			autoRepair:     autoRepair,
+			pollPeriod:     10 * time.Millisecond,
+			stuckThreshold: time.Hour,
+		}, nil)
+		polled := make(chan struct{}, 1)
+		r.ethClient.On("NonceAt", mock.Anything, r.fromAddress, mock.Anything).Return(uint64(0), nil).Run(func(mock.Arguments) {
+			select {
+			case polled <- struct{}{}:
+			default:
+			}
+		})
+		r.estimator.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{GasPrice: oneGwei}, uint64(0), nil)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, r.txStore, 1, r.fromAddress)
+		return r, polled
+	}
+
+	t.Run("logs the plans until they are approved", func(t *testing.T) {
+		r, polled := newRepairer(t, false)
+		servicetest.Run(t, r)
+
+		// The first plan is done once the key is polled again
+		for range 2 {
+			select {
+			case <-polled:
+			case <-time.After(tests.WaitTimeout(t)):
+				t.Fatal("timed out waiting for the key to be polled")
+			}
+		}
+		fill, err := r.txStore.FindTxWithSequence(tests.Context(t), r.fromAddress, 0)
+		require.NoError(t, err)
+		assert.Nil(t, fill)
+	})
+
+	t.Run("applies the plans", func(t *testing.T) {
+		r, _ := newRepairer(t, true)
+		r.ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, r.fromAddress).Return(multinode.Successful, nil).Once()
+		servicetest.Run(t, r)
+
+		require.Eventually(t, func() bool {
+			fill, err := r.txStore.FindTxWithSequence(tests.Context(t), r.fromAddress, 0)
+			require.NoError(t, err)
+			return fill != nil
+		}, tests.WaitTimeout(t), 10*time.Millisecond)
+	})
+}
+
+type testNonceRepairer struct {
+	*txmgr.NonceRepairer
+	feeConfig   evmconfig.GasEstimator
+	txStore     txmgr.TestEvmTxStore
+	ethClient   *clienttest.Client
+	estimator   *gasmocks.EvmFeeEstimator
+	fromAddress common.Address
+}
+
+func newTestNonceRepairer(t *testing.T, cfg testNonceRepairConfig, overrideFn func(c *toml.EVMConfig)) testNonceRepairer {
+	db := testutils.NewSqlxDB(t)
+	lggr := logger.Test(t)
+	txStore := txmgr.NewTxStore(db, lggr)
+	memKS := keystest.NewMemoryChainStore()
+	fromAddress := memKS.MustCreate(t)
+
+	evmcfg := configtest.NewChainScopedConfig(t, overrideFn)
+	_, dbConfig, _ := txmgr.MakeTestConfigs(t)
+	ethClient := clienttest.NewClientWithDefaultChainID(t)
+	keyStore := keys.NewChainStore(memKS, ethClient.ConfiguredChainID())
+	feeConfig := evmcfg.EVM().GasEstimator()
+	estimator := gasmocks.NewEvmFeeEstimator(t)
+	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", feeConfig.PriceMax(), testAutoPurgeConfig{}, estimator, txStore, ethClient)
+	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), feeConfig, keyStore, estimator)
+	repairer := txmgr.NewNonceRepairer(lggr, testutils.FixtureChainID, cfg, false, dbConfig, feeConfig, keyStore,
+		ethClient, txStore, stuckTxDetector, estimator, txBuilder, txmgr.NewEvmTxmClient(ethClient, nil))
+	return testNonceRepairer{
+		NonceRepairer: repairer,
+		feeConfig:     feeConfig,
+		txStore:       txStore,
+		ethClient:     ethClient,
+		estimator:     estimator,
+		fromAddress:   fromAddress,
+	}
+}
+
+type testNonceRepairConfig struct {
+	enabled        bool
+	autoRepair     bool
+	pollPeriod     time.Duration
+	stuckThreshold time.Duration
+}
+
+func (c testNonceRepairConfig) Enabled() bool                 { return c.enabled }
+func (c testNonceRepairConfig) AutoRepair() bool              { return c.autoRepair }
+func (c testNonceRepairConfig) PollPeriod() time.Duration     { return c.pollPeriod }
+func (c testNonceRepairConfig) StuckThreshold() time.Duration { return c.stuckThreshold }
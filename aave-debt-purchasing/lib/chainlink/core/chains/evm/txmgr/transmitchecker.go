@@ -38,15 +38,27 @@ var (
 	_ TransmitChecker        = &SimulateChecker{}
 	_ TransmitChecker        = &VRFV1Checker{}
 	_ TransmitChecker        = &VRFV2Checker{}
+	_ TransmitChecker        = &PolicyChecker{}
 )
 
 // CheckerFactory is a real implementation of TransmitCheckerFactory.
 type CheckerFactory struct {
 	Client evmclient.Client
+	// Policies, if set, wraps every checker in a PolicyChecker enforcing the TxPolicy of the key on ChainID.
+	Policies TxPolicyORM
+	ChainID  *big.Int
 }
 
 // BuildChecker satisfies the TransmitCheckerFactory interface.
 func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
+	checker, err := c.buildChecker(spec)
+	if err != nil || c.Policies == nil {
+		return checker, err
+	}
+	return &PolicyChecker{Policies: c.Policies, ChainID: c.ChainID, Next: checker}, nil
+}
+
+func (c *CheckerFactory) buildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
 	switch spec.CheckerType {
 	case TransmitCheckerTypeSimulate:
 		return &SimulateChecker{c.Client}, nil
@@ -0,0 +1,293 @@
+package txmgr
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// ErrTxPolicyViolation is returned by the PolicyChecker for transactions that violate the TxPolicy of their key.
+// The error is recorded on the transaction when it is fatally errored.
+var ErrTxPolicyViolation = errors.New("tx policy violation")
+
+// txPolicySpendWindow is the window of the daily spend limit of a TxPolicy.
+const txPolicySpendWindow = 24 * time.Hour
+
+// TxPolicy restricts the transactions sent by a key on a chain. Empty allow lists allow any destination or function
+// selector, and nil limits are not enforced.
+type TxPolicy struct {
+	EVMChainID          ubig.Big
+	Address             common.Address
+	AllowedDestinations []common.Address
+	AllowedSelectors    []hexutil.Bytes
+	// MaxValue is the maximum native value of a single transaction, in wei.
+	MaxValue *ubig.Big
+	// DailySpendLimit is the maximum native value plus max fee of the transactions sent in the last 24 hours, in wei.
+	DailySpendLimit *ubig.Big
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type TxPolicyORM interface {
+	// UpsertPolicy creates or replaces the policy of the key of the policy on its chain.
+	UpsertPolicy(ctx context.Context, policy TxPolicy) (TxPolicy, error)
+	// GetPolicies returns the policies on chainID, or on every chain if chainID is nil.
+	GetPolicies(ctx context.Context, chainID *big.Int) ([]TxPolicy, error)
+	// GetPolicy returns the policy of address on chainID, or sql.ErrNoRows if there is none.
+	GetPolicy(ctx context.Context, chainID *big.Int, address common.Address) (TxPolicy, error)
+	DeletePolicy(ctx context.Context, chainID *big.Int, address common.Address) error
+	// DailySpend returns the native value plus the max fee of the transactions broadcasted by address on chainID in the
+	// last 24 hours.
+	DailySpend(ctx context.Context, chainID *big.Int, address common.Address) (*big.Int, error)
+	// FindViolations returns the transactions that were fatally errored for violating a policy on chainID, or on every
+	// chain if chainID is nil, newest first.
+	FindViolations(ctx context.Context, chainID *big.Int, offset, limit int) ([]Tx, int, error)
+}
+
+type txPolicyORM struct {
+	ds   sqlutil.DataSource
+	lggr logger.Logger
+}
+
+var _ TxPolicyORM = (*txPolicyORM)(nil)
+
+func NewTxPolicyORM(ds sqlutil.DataSource, lggr logger.Logger) *txPolicyORM {
+	return &txPolicyORM{
+		ds:   ds,
+		lggr: logger.Named(lggr, "TxPolicyORM"),
+	}
+}
+
+type dbTxPolicy struct {
+	EVMChainID          ubig.Big       `db:"evm_chain_id"`
+	Address             common.Address `db:"address"`
+	AllowedDestinations pq.ByteaArray  `db:"allowed_destinations"`
+	AllowedSelectors    pq.ByteaArray  `db:"allowed_selectors"`
+	MaxValue            *ubig.Big      `db:"max_value"`
+	DailySpendLimit     *ubig.Big      `db:"daily_spend_limit"`
+	CreatedAt           time.Time      `db:"created_at"`
+	UpdatedAt           time.Time      `db:"updated_at"`
+}
+
+func (d dbTxPolicy) toPolicy() TxPolicy {
+	p := TxPolicy{
+		EVMChainID:          d.EVMChainID,
+		Address:             d.Address,
+		AllowedDestinations: make([]common.Address, 0, len(d.AllowedDestinations)),
+		AllowedSelectors:    make([]hexutil.Bytes, 0, len(d.AllowedSelectors)),
+		MaxValue:            d.MaxValue,
+		DailySpendLimit:     d.DailySpendLimit,
+		CreatedAt:           d.CreatedAt,
+		UpdatedAt:           d.UpdatedAt,
+	}
+	for _, b := range d.AllowedDestinations {
+		p.AllowedDestinations = append(p.AllowedDestinations, common.BytesToAddress(b))
+	}
+	for _, b := range d.AllowedSelectors {
+		p.AllowedSelectors = append(p.AllowedSelectors, b)
+	}
+	return p
+}
+
+func (o *txPolicyORM) UpsertPolicy(ctx context.Context, policy TxPolicy) (TxPolicy, error) {
+	destinations := make(pq.ByteaArray, 0, len(policy.AllowedDestinations))
+	for _, d := range policy.AllowedDestinations {
+		destinations = append(destinations, d.Bytes())
+	}
+	selectors := make(pq.ByteaArray, 0, len(policy.AllowedSelectors))
+	for _, s := range policy.AllowedSelectors {
+		if len(s) != 4 {
+			return TxPolicy{}, fmt.Errorf("function selector %s must be 4 bytes", s)
+		}
+		selectors = append(selectors, s)
+	}
+	if policy.MaxValue != nil && policy.MaxValue.Cmp(ubig.NewI(0)) < 0 {
+		return TxPolicy{}, errors.New("max value must not be negative")
+	}
+	if policy.DailySpendLimit != nil && policy.DailySpendLimit.Cmp(ubig.NewI(0)) < 0 {
+		return TxPolicy{}, errors.New("daily spend limit must not be negative")
+	}
+
+	var d dbTxPolicy
+	err := o.ds.GetContext(ctx, &d,
+		`INSERT INTO evm.tx_policies (evm_chain_id, address, allowed_destinations, allowed_selectors, max_value, daily_spend_limit, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		ON CONFLICT (evm_chain_id, address) DO UPDATE SET
+			allowed_destinations = EXCLUDED.allowed_destinations,
+			allowed_selectors = EXCLUDED.allowed_selectors,
+			max_value = EXCLUDED.max_value,
+			daily_spend_limit = EXCLUDED.daily_spend_limit,
+			updated_at = EXCLUDED.updated_at
+		RETURNING *`,
+		policy.EVMChainID, policy.Address, destinations, selectors, policy.MaxValue, policy.DailySpendLimit,
+	)
+	if err != nil {
+		return TxPolicy{}, fmt.Errorf("failed to upsert tx policy: %w", err)
+	}
+	return d.toPolicy(), nil
+}
+
+func (o *txPolicyORM) GetPolicies(ctx context.Context, chainID *big.Int) ([]TxPolicy, error) {
+	var ds []dbTxPolicy
+	err := o.ds.SelectContext(ctx, &ds,
+		`SELECT * FROM evm.tx_policies WHERE $1::numeric IS NULL OR evm_chain_id = $1 ORDER BY evm_chain_id, address`,
+		(*ubig.Big)(chainID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx policies: %w", err)
+	}
+	policies := make([]TxPolicy, 0, len(ds))
+	for _, d := range ds {
+		policies = append(policies, d.toPolicy())
+	}
+	return policies, nil
+}
+
+func (o *txPolicyORM) GetPolicy(ctx context.Context, chainID *big.Int, address common.Address) (TxPolicy, error) {
+	var d dbTxPolicy
+	err := o.ds.GetContext(ctx, &d,
+		`SELECT * FROM evm.tx_policies WHERE evm_chain_id = $1 AND address = $2`,
+		ubig.New(chainID), address,
+	)
+	if err != nil {
+		return TxPolicy{}, err
+	}
+	return d.toPolicy(), nil
+}
+
+func (o *txPolicyORM) DeletePolicy(ctx context.Context, chainID *big.Int, address common.Address) error {
+	res, err := o.ds.ExecContext(ctx,
+		`DELETE FROM evm.tx_policies WHERE evm_chain_id = $1 AND address = $2`,
+		ubig.New(chainID), address,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete tx policy: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (o *txPolicyORM) DailySpend(ctx context.Context, chainID *big.Int, address common.Address) (*big.Int, error) {
+	var spent ubig.Big
+	err := o.ds.GetContext(ctx, &spent,
+		`SELECT COALESCE(SUM(txes.value + COALESCE(attempts.fee, 0)), 0) FROM evm.txes
+		LEFT JOIN LATERAL (
+			SELECT COALESCE(gas_fee_cap, gas_price) * chain_specific_gas_limit AS fee FROM evm.tx_attempts
+			WHERE eth_tx_id = evm.txes.id ORDER BY id DESC LIMIT 1
+		) attempts ON TRUE
+		WHERE evm_chain_id = $1 AND from_address = $2 AND state NOT IN ($3, $4) AND created_at > $5`,
+		ubig.New(chainID), address, txmgr.TxUnstarted, txmgr.TxFatalError, time.Now().Add(-txPolicySpendWindow),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily spend: %w", err)
+	}
+	return spent.ToInt(), nil
+}
+
+func (o *txPolicyORM) FindViolations(ctx context.Context, chainID *big.Int, offset, limit int) (txs []Tx, count int, err error) {
+	err = sqlutil.TransactDataSource(ctx, o.ds, &sqlutil.TxOptions{TxOptions: sql.TxOptions{ReadOnly: true}}, func(tx sqlutil.DataSource) error {
+		if err := tx.GetContext(ctx, &count,
+			`SELECT count(*) FROM evm.txes WHERE ($1::numeric IS NULL OR evm_chain_id = $1) AND state = $2 AND error LIKE $3`,
+			(*ubig.Big)(chainID), txmgr.TxFatalError, ErrTxPolicyViolation.Error()+"%",
+		); err != nil {
+			return fmt.Errorf("failed to count tx policy violations: %w", err)
+		}
+
+		var dbTxs []DbEthTx
+		if err := tx.SelectContext(ctx, &dbTxs,
+			`SELECT * FROM evm.txes WHERE ($1::numeric IS NULL OR evm_chain_id = $1) AND state = $2 AND error LIKE $3
+			ORDER BY id DESC LIMIT $4 OFFSET $5`,
+			(*ubig.Big)(chainID), txmgr.TxFatalError, ErrTxPolicyViolation.Error()+"%", limit, offset,
+		); err != nil {
+			return fmt.Errorf("failed to find tx policy violations: %w", err)
+		}
+		txs = dbEthTxsToEvmEthTxs(dbTxs)
+		return nil
+	})
+	return
+}
+
+// PolicyChecker checks transactions against the TxPolicy of their key before running the Next checker. It fails open
+// if the policy cannot be loaded, so that database errors do not fatally error transactions.
+type PolicyChecker struct {
+	Policies TxPolicyORM
+	ChainID  *big.Int
+	Next     TransmitChecker
+}
+
+// Check satisfies the TransmitChecker interface.
+func (p *PolicyChecker) Check(ctx context.Context, l logger.SugaredLogger, tx Tx, a TxAttempt) error {
+	if err := p.checkPolicy(ctx, l, tx, a); err != nil {
+		return err
+	}
+	return p.Next.Check(ctx, l, tx, a)
+}
+
+func (p *PolicyChecker) checkPolicy(ctx context.Context, l logger.SugaredLogger, tx Tx, a TxAttempt) error {
+	policy, err := p.Policies.GetPolicy(ctx, p.ChainID, tx.FromAddress)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		l.Errorw("Failed to load tx policy, skipping policy check", "err", err)
+		return nil
+	}
+
+	if len(policy.AllowedDestinations) > 0 && !slices.Contains(policy.AllowedDestinations, tx.ToAddress) {
+		return fmt.Errorf("%w: destination %s is not allowed", ErrTxPolicyViolation, tx.ToAddress)
+	}
+	if len(policy.AllowedSelectors) > 0 {
+		if len(tx.EncodedPayload) < 4 {
+			return fmt.Errorf("%w: payload has no function selector", ErrTxPolicyViolation)
+		}
+		selector := tx.EncodedPayload[:4]
+		if !slices.ContainsFunc(policy.AllowedSelectors, func(s hexutil.Bytes) bool { return bytes.Equal(s, selector) }) {
+			return fmt.Errorf("%w: function selector %s is not allowed", ErrTxPolicyViolation, hexutil.Bytes(selector))
+		}
+	}
+	if policy.MaxValue != nil && tx.Value.Cmp(policy.MaxValue.ToInt()) > 0 {
+		return fmt.Errorf("%w: value %s exceeds the max value of %s", ErrTxPolicyViolation, tx.Value.String(), policy.MaxValue)
+	}
+	if policy.DailySpendLimit != nil {
+		spent, err := p.Policies.DailySpend(ctx, p.ChainID, tx.FromAddress)
+		if err != nil {
+			l.Errorw("Failed to load daily spend, skipping spend limit check", "err", err)
+			return nil
+		}
+		spend := new(big.Int).Add(&tx.Value, attemptMaxFee(a))
+		if total := spend.Add(spend, spent); total.Cmp(policy.DailySpendLimit.ToInt()) > 0 {
+			return fmt.Errorf("%w: daily spend of %s would exceed the limit of %s", ErrTxPolicyViolation, total, policy.DailySpendLimit)
+		}
+	}
+	return nil
+}
+
+// attemptMaxFee returns the maximum fee the attempt can pay.
+func attemptMaxFee(a TxAttempt) *big.Int {
+	price := a.TxFee.GasPrice
+	if a.TxFee.GasFeeCap != nil {
+		price = a.TxFee.GasFeeCap
+	}
+	if price == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Mul(price.ToInt(), new(big.Int).SetUint64(a.ChainSpecificFeeLimit))
+}
@@ -0,0 +1,197 @@
+package txmgr_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+)
+
+func TestTxPolicyORM(t *testing.T) {
+	t.Parallel()
+
+	db := testutils.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+	orm := txmgr.NewTxPolicyORM(db, logger.Test(t))
+	txStore := txmgr.NewTxStore(db, logger.Test(t))
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	chainID := testutils.FixtureChainID
+
+	_, err := orm.GetPolicy(ctx, chainID, fromAddress)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = orm.UpsertPolicy(ctx, txmgr.TxPolicy{EVMChainID: *ubig.New(chainID), Address: fromAddress, AllowedSelectors: []hexutil.Bytes{{1, 2}}})
+	require.Error(t, err)
+
+	destination := testutils.NewAddress()
+	policy, err := orm.UpsertPolicy(ctx, txmgr.TxPolicy{
+		EVMChainID:          *ubig.New(chainID),
+		Address:             fromAddress,
+		AllowedDestinations: []common.Address{destination},
+		AllowedSelectors:    []hexutil.Bytes{{1, 2, 3, 4}},
+		MaxValue:            ubig.NewI(100),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []common.Address{destination}, policy.AllowedDestinations)
+	assert.Nil(t, policy.DailySpendLimit)
+
+	t.Run("replaces the policy", func(t *testing.T) {
+		_, err := orm.UpsertPolicy(ctx, txmgr.TxPolicy{EVMChainID: *ubig.New(chainID), Address: fromAddress, DailySpendLimit: ubig.NewI(10)})
+		require.NoError(t, err)
+
+		policies, err := orm.GetPolicies(ctx, nil)
+		require.NoError(t, err)
+		require.Len(t, policies, 1)
+		assert.Empty(t, policies[0].AllowedDestinations)
+		assert.Nil(t, policies[0].MaxValue)
+		assert.Equal(t, ubig.NewI(10), policies[0].DailySpendLimit)
+
+		policies, err = orm.GetPolicies(ctx, big.NewInt(1234))
+		require.NoError(t, err)
+		assert.Empty(t, policies)
+	})
+
+	t.Run("sums the spend of the last day", func(t *testing.T) {
+		spent, err := orm.DailySpend(ctx, chainID, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), spent.Int64())
+
+		etx := cltest.NewEthTx(fromAddress)
+		etx.ChainID = chainID
+		etx.Value = *big.NewInt(1000)
+		etx.State = txmgrcommon.TxConfirmed
+		now := time.Now()
+		etx.BroadcastAt = &now
+		etx.InitialBroadcastAt = &now
+		nonce := evmtypes.Nonce(0)
+		etx.Sequence = &nonce
+		require.NoError(t, txStore.InsertTx(ctx, &etx))
+		attempt := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+		require.NoError(t, txStore.InsertTxAttempt(ctx, &attempt))
+
+		spent, err = orm.DailySpend(ctx, chainID, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000+42), spent.Int64())
+	})
+
+	t.Run("finds violations", func(t *testing.T) {
+		etx := cltest.NewEthTx(fromAddress)
+		etx.ChainID = chainID
+		etx.State = txmgrcommon.TxFatalError
+		etx.Error = null.StringFrom(txmgr.ErrTxPolicyViolation.Error() + ": destination is not allowed")
+		require.NoError(t, txStore.InsertTx(ctx, &etx))
+
+		violations, count, err := orm.FindViolations(ctx, chainID, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		require.Len(t, violations, 1)
+		assert.Equal(t, etx.ID, violations[0].ID)
+	})
+
+	t.Run("deletes the policy", func(t *testing.T) {
+		require.NoError(t, orm.DeletePolicy(ctx, chainID, fromAddress))
+		require.ErrorIs(t, orm.DeletePolicy(ctx, chainID, fromAddress), sql.ErrNoRows)
+	})
+}
+
+type testTxPolicies struct {
+	txmgr.TxPolicyORM
+	policy *txmgr.TxPolicy
+	spent  *big.Int
+	err    error
+}
+
+func (p *testTxPolicies) GetPolicy(context.Context, *big.Int, common.Address) (txmgr.TxPolicy, error) {
+	if p.err != nil {
+		return txmgr.TxPolicy{}, p.err
+	}
+	if p.policy == nil {
+		return txmgr.TxPolicy{}, sql.ErrNoRows
+	}
+	return *p.policy, nil
+}
+
+func (p *testTxPolicies) DailySpend(context.Context, *big.Int, common.Address) (*big.Int, error) {
+	return p.spent, nil
+}
+
+func TestPolicyChecker(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	lggr := logger.Sugared(logger.Test(t))
+	destination := testutils.NewAddress()
+	tx := txmgr.Tx{
+		FromAddress:    testutils.NewAddress(),
+		ToAddress:      destination,
+		EncodedPayload: []byte{1, 2, 3, 4, 5},
+		Value:          *big.NewInt(100),
+	}
+	attempt := txmgr.TxAttempt{TxFee: gas.EvmFee{GasPrice: assets.NewWeiI(2)}, ChainSpecificFeeLimit: 10}
+
+	newChecker := func(policies *testTxPolicies) *txmgr.PolicyChecker {
+		return &txmgr.PolicyChecker{Policies: policies, ChainID: testutils.FixtureChainID, Next: txmgr.NoChecker}
+	}
+
+	t.Run("allows transactions without policy", func(t *testing.T) {
+		require.NoError(t, newChecker(&testTxPolicies{}).Check(ctx, lggr, tx, attempt))
+	})
+
+	t.Run("allows transactions if the policy cannot be loaded", func(t *testing.T) {
+		require.NoError(t, newChecker(&testTxPolicies{err: errors.New("db down")}).Check(ctx, lggr, tx, attempt))
+	})
+
+	t.Run("allows transactions within the policy", func(t *testing.T) {
+		policy := &txmgr.TxPolicy{
+			AllowedDestinations: []common.Address{destination},
+			AllowedSelectors:    []hexutil.Bytes{{1, 2, 3, 4}},
+			MaxValue:            ubig.NewI(100),
+			DailySpendLimit:     ubig.NewI(1000),
+		}
+		require.NoError(t, newChecker(&testTxPolicies{policy: policy, spent: big.NewInt(880)}).Check(ctx, lggr, tx, attempt))
+	})
+
+	for _, tc := range []struct {
+		name   string
+		policy txmgr.TxPolicy
+		err    string
+	}{
+		{"destination", txmgr.TxPolicy{AllowedDestinations: []common.Address{testutils.NewAddress()}}, "destination"},
+		{"selector", txmgr.TxPolicy{AllowedSelectors: []hexutil.Bytes{{4, 3, 2, 1}}}, "function selector 0x01020304 is not allowed"},
+		{"max value", txmgr.TxPolicy{MaxValue: ubig.NewI(99)}, "value 100 exceeds the max value of 99"},
+		{"daily spend", txmgr.TxPolicy{DailySpendLimit: ubig.NewI(1000)}, "daily spend of 1001 would exceed the limit of 1000"},
+	} {
+		t.Run("rejects transactions violating the "+tc.name, func(t *testing.T) {
+			err := newChecker(&testTxPolicies{policy: &tc.policy, spent: big.NewInt(881)}).Check(ctx, lggr, tx, attempt)
+			require.ErrorIs(t, err, txmgr.ErrTxPolicyViolation)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	t.Run("factory wraps checkers", func(t *testing.T) {
+		factory := &txmgr.CheckerFactory{Policies: &testTxPolicies{}, ChainID: testutils.FixtureChainID}
+		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.PolicyChecker{Policies: factory.Policies, ChainID: testutils.FixtureChainID, Next: txmgr.NoChecker}, c)
+	})
+}
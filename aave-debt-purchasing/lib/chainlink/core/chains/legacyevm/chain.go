@@ -29,8 +29,10 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/balance"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/log"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 )
 
 type Chain interface {
@@ -42,6 +44,8 @@ type Chain interface {
 	LogBroadcaster() log.Broadcaster
 	HeadBroadcaster() heads.Broadcaster
 	TxManager() txmgr.TxManager
+	// NonceRepairer returns nil if the transaction manager is disabled.
+	NonceRepairer() *txmgr.NonceRepairer
 	HeadTracker() heads.Tracker
 	Logger() logger.Logger
 	BalanceMonitor() monitor.BalanceMonitor
@@ -106,13 +110,16 @@ type chain struct {
 	cfg             *config.ChainScoped
 	client          client.Client
 	txm             txmgr.TxManager
+	nonceRepairer   *txmgr.NonceRepairer
 	logger          logger.Logger
 	headBroadcaster heads.Broadcaster
 	headTracker     heads.Tracker
 	logBroadcaster  log.Broadcaster
 	logPoller       logpoller.LogPoller
 	balanceMonitor  monitor.BalanceMonitor
-	gasEstimator    gas.EvmFeeEstimator
+	// balancePolicyMonitor is nil if Ethereum is disabled
+	balancePolicyMonitor *balance.PolicyMonitor
+	gasEstimator         gas.EvmFeeEstimator
 }
 
 type errChainDisabled struct {
@@ -144,6 +151,15 @@ type ChainOpts struct {
 
 	DS sqlutil.DataSource
 
+	// RemoteSigner signs with the Eth keys of an external signer instead of the keystore, if set.
+	RemoteSigner *remotesigner.Client
+
+	// NonceRepairConfig enables the periodic nonce repair, if set.
+	NonceRepairConfig txmgr.NonceRepairConfig
+
+	// TxmV2Config sets the fee policies of the transaction manager v2, if set.
+	TxmV2Config txmgr.TxmV2Config
+
 	// TODO BCF-2513 remove test code from the API
 	// Gen-functions are useful for dependency injection by tests
 	GenChainStore     func(ks core.Keystore, i *big.Int) keys.ChainStore
@@ -257,6 +273,7 @@ func newChain(cfg *config.ChainScoped, nodes []*toml.Node, opts ChainRelayOpts,
 
 	// note: gas estimator is started as a part of the txm
 	var txm txmgr.TxManager
+	var nonceRepairer *txmgr.NonceRepairer
 	//nolint:gocritic // ignoring suggestion to convert to switch statement
 	if !opts.ChainConfigs.RPCEnabled() {
 		txm = &txmgr.NullTxManager{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
@@ -267,6 +284,8 @@ func newChain(cfg *config.ChainScoped, nodes []*toml.Node, opts ChainRelayOpts,
 		if err != nil {
 			return nil, fmt.Errorf("failed to instantiate EvmTxm for chain with ID %s: %w", chainID, err)
 		}
+		nonceRepairer = txmgr.NewEvmNonceRepairer(opts.DS, cfg.EVM(), txmgr.NewEvmTxmFeeConfig(cfg.EVM().GasEstimator()), cfg.EVM().Transactions(),
+			cfg.EVM().NodePool().Errors(), opts.DatabaseConfig, opts.NonceRepairConfig, cl, l, opts.KeyStore, gasEstimator)
 	}
 
 	headBroadcaster.Subscribe(txm)
@@ -277,6 +296,11 @@ func newChain(cfg *config.ChainScoped, nodes []*toml.Node, opts ChainRelayOpts,
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
+	var balancePolicyMonitor *balance.PolicyMonitor
+	if opts.ChainConfigs.RPCEnabled() {
+		balancePolicyMonitor = balance.NewPolicyMonitor(l, chainID, balance.NewORM(opts.DS), cl, opts.KeyStore, txm, cfg.EVM().GasEstimator().LimitTransfer())
+	}
+
 	var logBroadcaster log.Broadcaster
 	if !opts.ChainConfigs.RPCEnabled() {
 		logBroadcaster = &log.NullBroadcaster{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
@@ -297,17 +321,19 @@ func newChain(cfg *config.ChainScoped, nodes []*toml.Node, opts ChainRelayOpts,
 	headBroadcaster.Subscribe(logBroadcaster)
 
 	return &chain{
-		id:              chainID,
-		cfg:             cfg,
-		client:          cl,
-		txm:             txm,
-		logger:          l,
-		headBroadcaster: headBroadcaster,
-		headTracker:     headTracker,
-		logBroadcaster:  logBroadcaster,
-		logPoller:       logPoller,
-		balanceMonitor:  balanceMonitor,
-		gasEstimator:    gasEstimator,
+		id:                   chainID,
+		cfg:                  cfg,
+		client:               cl,
+		txm:                  txm,
+		nonceRepairer:        nonceRepairer,
+		logger:               l,
+		headBroadcaster:      headBroadcaster,
+		headTracker:          headTracker,
+		logBroadcaster:       logBroadcaster,
+		logPoller:            logPoller,
+		balanceMonitor:       balanceMonitor,
+		gasEstimator:         gasEstimator,
+		balancePolicyMonitor: balancePolicyMonitor,
 	}, nil
 }
 
@@ -334,6 +360,16 @@ func (c *chain) Start(ctx context.Context) error {
 				return err
 			}
 		}
+		if c.nonceRepairer != nil {
+			if err := ms.Start(ctx, c.nonceRepairer); err != nil {
+				return err
+			}
+		}
+		if c.balancePolicyMonitor != nil {
+			if err := ms.Start(ctx, c.balancePolicyMonitor); err != nil {
+				return err
+			}
+		}
 
 		return nil
 	})
@@ -343,9 +379,17 @@ func (c *chain) Close() error {
 	return c.StopOnce("Chain", func() (merr error) {
 		c.logger.Debug("Chain: stopping")
 
+		if c.balancePolicyMonitor != nil {
+			c.logger.Debug("Chain: stopping balance policy monitor")
+			merr = c.balancePolicyMonitor.Close()
+		}
+		if c.nonceRepairer != nil {
+			c.logger.Debug("Chain: stopping nonce repairer")
+			merr = multierr.Combine(merr, c.nonceRepairer.Close())
+		}
 		if c.balanceMonitor != nil {
 			c.logger.Debug("Chain: stopping balance monitor")
-			merr = c.balanceMonitor.Close()
+			merr = multierr.Combine(merr, c.balanceMonitor.Close())
 		}
 		c.logger.Debug("Chain: stopping logBroadcaster")
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
@@ -373,6 +417,12 @@ func (c *chain) Ready() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Ready())
 	}
+	if c.nonceRepairer != nil {
+		merr = multierr.Combine(merr, c.nonceRepairer.Ready())
+	}
+	if c.balancePolicyMonitor != nil {
+		merr = multierr.Combine(merr, c.balancePolicyMonitor.Ready())
+	}
 	return
 }
 
@@ -390,6 +440,12 @@ func (c *chain) HealthReport() map[string]error {
 	if c.balanceMonitor != nil {
 		services.CopyHealth(report, c.balanceMonitor.HealthReport())
 	}
+	if c.nonceRepairer != nil {
+		services.CopyHealth(report, c.nonceRepairer.HealthReport())
+	}
+	if c.balancePolicyMonitor != nil {
+		services.CopyHealth(report, c.balancePolicyMonitor.HealthReport())
+	}
 
 	return report
 }
@@ -487,6 +543,7 @@ func (c *chain) LogBroadcaster() log.Broadcaster        { return c.logBroadcaste
 func (c *chain) LogPoller() logpoller.LogPoller         { return c.logPoller }
 func (c *chain) HeadBroadcaster() heads.Broadcaster     { return c.headBroadcaster }
 func (c *chain) TxManager() txmgr.TxManager             { return c.txm }
+func (c *chain) NonceRepairer() *txmgr.NonceRepairer    { return c.nonceRepairer }
 func (c *chain) HeadTracker() heads.Tracker             { return c.headTracker }
 func (c *chain) Logger() logger.Logger                  { return c.logger }
 func (c *chain) BalanceMonitor() monitor.BalanceMonitor { return c.balanceMonitor }
@@ -50,6 +50,7 @@ func newEvmTxm(
 				txmgr.NewEvmTxmFeeConfig(cfg.GasEstimator()),
 				cfg.Transactions(),
 				cfg.Transactions().TransactionManagerV2(),
+				opts.TxmV2Config,
 				client,
 				lggr,
 				logPoller,
@@ -12,6 +12,8 @@ import (
 
 	context "context"
 
+	evmtxmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+
 	gas "github.com/smartcontractkit/chainlink-evm/pkg/gas"
 
 	heads "github.com/smartcontractkit/chainlink-framework/chains/heads"
@@ -839,6 +841,53 @@ func (_c *Chain_Name_Call) RunAndReturn(run func() string) *Chain_Name_Call {
 	return _c
 }
 
+// NonceRepairer provides a mock function with no fields
+func (_m *Chain) NonceRepairer() *evmtxmgr.NonceRepairer {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NonceRepairer")
+	}
+
+	var r0 *evmtxmgr.NonceRepairer
+	if rf, ok := ret.Get(0).(func() *evmtxmgr.NonceRepairer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evmtxmgr.NonceRepairer)
+		}
+	}
+
+	return r0
+}
+
+// Chain_NonceRepairer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NonceRepairer'
+type Chain_NonceRepairer_Call struct {
+	*mock.Call
+}
+
+// NonceRepairer is a helper method to define mock.On call
+func (_e *Chain_Expecter) NonceRepairer() *Chain_NonceRepairer_Call {
+	return &Chain_NonceRepairer_Call{Call: _e.mock.On("NonceRepairer")}
+}
+
+func (_c *Chain_NonceRepairer_Call) Run(run func()) *Chain_NonceRepairer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Chain_NonceRepairer_Call) Return(_a0 *evmtxmgr.NonceRepairer) *Chain_NonceRepairer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Chain_NonceRepairer_Call) RunAndReturn(run func() *evmtxmgr.NonceRepairer) *Chain_NonceRepairer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Ready provides a mock function with no fields
 func (_m *Chain) Ready() error {
 	ret := _m.Called()
@@ -137,6 +137,74 @@ func NewApp(s *Shell) *cli.App {
 		}
 		return nil
 	}
+
+	// localConfigFlags and initLocalConfig load the config of the commands which run locally, on the same
+	// machine as the Chainlink node, instead of calling its API.
+	localConfigFlags := []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "config, c",
+			Usage: "TOML configuration file(s) via flag, or raw TOML via env var. If used, legacy env vars must not be set. Multiple files can be used (-c configA.toml -c configB.toml), and they are applied in order with duplicated fields overriding any earlier values. If the 'CL_CONFIG' env var is specified, it is always processed last with the effect of being the final override. [$CL_CONFIG]",
+		},
+		cli.StringSliceFlag{
+			Name:  "secrets, s",
+			Usage: "TOML configuration file for secrets. Must be set if and only if config is set. Multiple files can be used (-s secretsA.toml -s secretsB.toml), and fields from the files will be merged. No overrides are allowed.",
+		},
+	}
+	initLocalConfig := func(c *cli.Context) error {
+		errNoDuplicateFlags := errors.New("multiple commands with --config or --secrets flags. only one command may specify these flags. when secrets are used, they must be specific together in the same command")
+		if c.IsSet("config") {
+			if s.configFilesIsSet || s.secretsFileIsSet {
+				return errNoDuplicateFlags
+			}
+			s.configFiles = c.StringSlice("config")
+		}
+
+		if c.IsSet("secrets") {
+			if s.configFilesIsSet || s.secretsFileIsSet {
+				return errNoDuplicateFlags
+			}
+			s.secretsFiles = c.StringSlice("secrets")
+		}
+
+		// flags here, or ENV VAR only
+		cfg, err := initServerConfig(&opts, s.configFiles, s.secretsFiles)
+		if err != nil {
+			return err
+		}
+		s.Config = cfg
+
+		logFileMaxSizeMB := s.Config.Log().File().MaxSize() / utils.MB
+		if logFileMaxSizeMB > 0 {
+			err = utils.EnsureDirAndMaxPerms(s.Config.Log().File().Dir(), os.FileMode(0700))
+			if err != nil {
+				return err
+			}
+		}
+
+		// Swap out the logger, replacing the old one.
+		err = s.CloseLogger()
+		if err != nil {
+			return err
+		}
+
+		lggrCfg := logger.Config{
+			LogLevel:       s.Config.Log().Level(),
+			Dir:            s.Config.Log().File().Dir(),
+			JsonConsole:    s.Config.Log().JSONConsole(),
+			UnixTS:         s.Config.Log().UnixTimestamps(),
+			FileMaxSizeMB:  int(logFileMaxSizeMB),
+			FileMaxAgeDays: int(s.Config.Log().File().MaxAgeDays()),
+			FileMaxBackups: int(s.Config.Log().File().MaxBackups()),
+			SentryEnabled:  s.Config.Sentry().DSN() != "",
+		}
+		l, closeFn := lggrCfg.New()
+
+		s.Logger = l
+		s.CloseLogger = closeFn
+
+		return nil
+	}
+
 	app.Commands = removeHidden([]cli.Command{
 		{
 			Name:        "admin",
@@ -204,6 +272,10 @@ func NewApp(s *Shell) *cli.App {
 				keysCommand("Tron", NewTronKeysClient(s)),
 
 				initVRFKeysSubCmd(s),
+
+				initKeysBackupSubCmd(s),
+				initKeysRestoreSubCmd(s),
+				initKeysRotatePasswordSubCmd(s, localConfigFlags, initLocalConfig),
 			},
 		},
 		{
@@ -212,70 +284,8 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for admin actions that must be run locally",
 			Description: "Commands can only be run from on the same machine as the Chainlink node.",
 			Subcommands: initLocalSubCmds(s, build.IsProd()),
-			Flags: []cli.Flag{
-				cli.StringSliceFlag{
-					Name:  "config, c",
-					Usage: "TOML configuration file(s) via flag, or raw TOML via env var. If used, legacy env vars must not be set. Multiple files can be used (-c configA.toml -c configB.toml), and they are applied in order with duplicated fields overriding any earlier values. If the 'CL_CONFIG' env var is specified, it is always processed last with the effect of being the final override. [$CL_CONFIG]",
-				},
-				cli.StringSliceFlag{
-					Name:  "secrets, s",
-					Usage: "TOML configuration file for secrets. Must be set if and only if config is set. Multiple files can be used (-s secretsA.toml -s secretsB.toml), and fields from the files will be merged. No overrides are allowed.",
-				},
-			},
-			Before: func(c *cli.Context) error {
-				errNoDuplicateFlags := errors.New("multiple commands with --config or --secrets flags. only one command may specify these flags. when secrets are used, they must be specific together in the same command")
-				if c.IsSet("config") {
-					if s.configFilesIsSet || s.secretsFileIsSet {
-						return errNoDuplicateFlags
-					}
-					s.configFiles = c.StringSlice("config")
-				}
-
-				if c.IsSet("secrets") {
-					if s.configFilesIsSet || s.secretsFileIsSet {
-						return errNoDuplicateFlags
-					}
-					s.secretsFiles = c.StringSlice("secrets")
-				}
-
-				// flags here, or ENV VAR only
-				cfg, err := initServerConfig(&opts, s.configFiles, s.secretsFiles)
-				if err != nil {
-					return err
-				}
-				s.Config = cfg
-
-				logFileMaxSizeMB := s.Config.Log().File().MaxSize() / utils.MB
-				if logFileMaxSizeMB > 0 {
-					err = utils.EnsureDirAndMaxPerms(s.Config.Log().File().Dir(), os.FileMode(0700))
-					if err != nil {
-						return err
-					}
-				}
-
-				// Swap out the logger, replacing the old one.
-				err = s.CloseLogger()
-				if err != nil {
-					return err
-				}
-
-				lggrCfg := logger.Config{
-					LogLevel:       s.Config.Log().Level(),
-					Dir:            s.Config.Log().File().Dir(),
-					JsonConsole:    s.Config.Log().JSONConsole(),
-					UnixTS:         s.Config.Log().UnixTimestamps(),
-					FileMaxSizeMB:  int(logFileMaxSizeMB),
-					FileMaxAgeDays: int(s.Config.Log().File().MaxAgeDays()),
-					FileMaxBackups: int(s.Config.Log().File().MaxBackups()),
-					SentryEnabled:  s.Config.Sentry().DSN() != "",
-				}
-				l, closeFn := lggrCfg.New()
-
-				s.Logger = l
-				s.CloseLogger = closeFn
-
-				return nil
-			},
+			Flags:       localConfigFlags,
+			Before:      initLocalConfig,
 		},
 		{
 			Name:        "initiators",
@@ -289,6 +299,7 @@ func NewApp(s *Shell) *cli.App {
 				initEVMTxSubCmd(s),
 				initCosmosTxSubCmd(s),
 				initSolanaTxSubCmd(s),
+				initTxRepairSubCmd(s),
 			},
 		},
 		{
@@ -306,6 +317,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing forwarder addresses.",
 			Subcommands: initFowardersSubCmds(s),
 		},
+		{
+			Name:        "workflows",
+			Usage:       "Commands for managing workflow executions",
+			Subcommands: initWorkflowsSubCmds(s),
+		},
 		{
 			Name:  "help-all",
 			Usage: "Shows a list of all commands and sub-commands",
@@ -6,15 +6,19 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/url"
+	"strconv"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
 	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -66,6 +70,29 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 	}
 }
 
+func initTxRepairSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:   "repair",
+		Usage:  "Show the repair plans of the nonce gaps and stuck transactions of the EVM keys, and apply them once approved",
+		Action: s.RepairTransactions,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:     "evmChainID, evm-chain-id",
+				Usage:    "chain ID of the keys to repair",
+				Required: true,
+			},
+			cli.StringFlag{
+				Name:  "address, a",
+				Usage: "address of the key to repair, every key of the chain if not set",
+			},
+			cli.BoolFlag{
+				Name:  "yes, y",
+				Usage: "skip the confirmation prompt",
+			},
+		},
+	}
+}
+
 type EthTxPresenter struct {
 	JAID
 	presenters.EthTxResource
@@ -211,3 +238,110 @@ func (s *Shell) SendEther(c *cli.Context) (err error) {
 	err = s.renderAPIResponse(resp, &EthTxPresenter{})
 	return err
 }
+
+type EVMNonceRepairPlanPresenter struct {
+	JAID
+	presenters.EVMNonceRepairPlanResource
+}
+
+// ToRows returns a row per step of the plan.
+func (p *EVMNonceRepairPlanPresenter) ToRows() [][]string {
+	var rows [][]string
+	for _, step := range p.Steps {
+		txID := ""
+		if step.TxID != 0 {
+			txID = strconv.FormatInt(step.TxID, 10)
+		}
+		rows = append(rows, []string{
+			p.Address.Hex(),
+			strconv.FormatInt(p.OnChainNonce, 10),
+			strconv.FormatInt(step.Nonce, 10),
+			step.Action,
+			txID,
+			p.GasPrice,
+		})
+	}
+	return rows
+}
+
+var evmNonceRepairPlanHeaders = []string{"Address", "On-chain Nonce", "Nonce", "Action", "Tx ID", "Gas Price"}
+
+// RenderTable implements TableRenderer
+func (p *EVMNonceRepairPlanPresenter) RenderTable(rt RendererTable) error {
+	renderList(evmNonceRepairPlanHeaders, p.ToRows(), rt.Writer)
+	return nil
+}
+
+type EVMNonceRepairPlanPresenters []EVMNonceRepairPlanPresenter
+
+// RenderTable implements TableRenderer
+func (ps EVMNonceRepairPlanPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRows()...)
+	}
+	renderList(evmNonceRepairPlanHeaders, rows, rt.Writer)
+	return nil
+}
+
+// RepairTransactions shows the repair plans of the nonce gaps and stuck transactions of the keys of
+// a chain, and applies them once approved.
+func (s *Shell) RepairTransactions(c *cli.Context) (err error) {
+	query := url.Values{}
+	query.Set("evmChainID", c.String("evmChainID"))
+	if c.IsSet("address") {
+		query.Set("address", c.String("address"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/evm/nonce_repair?"+query.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var plans EVMNonceRepairPlanPresenters
+	if err = s.renderAPIResponse(resp, &plans, "Nonce repair plans"); err != nil {
+		return err
+	}
+	if len(plans) == 0 || !confirmAction(c) {
+		return nil
+	}
+
+	for _, plan := range plans {
+		if err = s.repairNonces(plan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// repairNonces applies the approved steps of plan.
+func (s *Shell) repairNonces(plan EVMNonceRepairPlanPresenter) (err error) {
+	request := web.RepairEVMNoncesRequest{
+		EVMChainID: &plan.EVMChainID,
+		Address:    plan.Address,
+	}
+	for _, step := range plan.Steps {
+		request.Nonces = append(request.Nonces, evmtypes.Nonce(step.Nonce))
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/evm/nonce_repair", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &EVMNonceRepairPlanPresenter{}, "Repaired nonces")
+}
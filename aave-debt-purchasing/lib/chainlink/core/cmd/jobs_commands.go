@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"time"
 
@@ -40,6 +41,38 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Create a job",
 			Action: s.CreateJob,
 		},
+		{
+			Name:   "update",
+			Usage:  "Replace a job with a new spec, keeping the old job running if the new one fails to start",
+			Action: s.UpdateJob,
+		},
+		{
+			Name:   "versions",
+			Usage:  "List the versions of the spec of a job, with the diff of each version",
+			Action: s.ListJobSpecVersions,
+		},
+		{
+			Name:   "rollback",
+			Usage:  "Replace a job with a previous version of its spec",
+			Action: s.RollbackJob,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:     "version",
+					Usage:    "version of the spec to roll back to",
+					Required: true,
+				},
+			},
+		},
+		{
+			Name:   "pause",
+			Usage:  "Pause a job, stopping it without deleting it",
+			Action: s.PauseJob,
+		},
+		{
+			Name:   "resume",
+			Usage:  "Resume a paused job",
+			Action: s.ResumeJob,
+		},
 		{
 			Name:   "delete",
 			Usage:  "Delete a job",
@@ -50,6 +83,25 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Simulate a run of a job without saving it, using canned results for external tasks",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "results",
+					Usage: "path to a JSON file of canned task results, keyed by task ID",
+				},
+				cli.Int64Flag{
+					Name:  "run-id",
+					Usage: "ID of a pipeline run to take recorded task results from",
+				},
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON object of pipeline variables for the run",
+				},
+			},
+		},
 	}
 }
 
@@ -213,6 +265,33 @@ func (ps JobPresenters) RenderTable(rt RendererTable) error {
 	return nil
 }
 
+// JobSpecVersionPresenter wraps the JSONAPI Job Spec Version Resource and adds rendering functionality
+type JobSpecVersionPresenter struct {
+	JAID
+	presenters.JobSpecVersionResource
+}
+
+type JobSpecVersionPresenters []JobSpecVersionPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobSpecVersionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Version", "Created At"})
+	for _, p := range ps {
+		table.Append([]string{strconv.Itoa(int(p.Version)), p.CreatedAt.Format(time.RFC3339)})
+	}
+	render("Job Spec Versions", table)
+
+	for _, p := range ps {
+		if p.Diff == "" {
+			continue
+		}
+		if _, err := fmt.Fprintf(rt, "\n%s", p.Diff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ListJobs lists all jobs
 func (s *Shell) ListJobs(c *cli.Context) (err error) {
 	return s.getPage("/v2/jobs", c.Int("page"), &JobPresenters{})
@@ -280,6 +359,109 @@ func (s *Shell) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
+// UpdateJob replaces a job with a new spec
+// Valid input is the job id and a TOML string or a path to TOML file
+func (s *Shell) UpdateJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the job id and TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.UpdateJobRequest{
+		TOML: tomlString,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Put(s.ctx(), "/v2/jobs/"+c.Args().First(), bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, "Job updated")
+}
+
+// ListJobSpecVersions lists the versions of the spec of a job
+func (s *Shell) ListJobSpecVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/"+c.Args().First()+"/versions")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobSpecVersionPresenters{})
+}
+
+// RollbackJob replaces a job with a previous version of its spec
+func (s *Shell) RollbackJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+
+	request, err := json.Marshal(web.RollbackJobRequest{
+		Version: int32(c.Int("version")), //nolint:gosec // versions are int32 in the database
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/rollback", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, fmt.Sprintf("Job rolled back to version %d", c.Int("version")))
+}
+
+// PauseJob pauses a job
+func (s *Shell) PauseJob(c *cli.Context) (err error) {
+	return s.setJobPaused(c, "pause", "Job paused")
+}
+
+// ResumeJob resumes a paused job
+func (s *Shell) ResumeJob(c *cli.Context) (err error) {
+	return s.setJobPaused(c, "resume", "Job resumed")
+}
+
+func (s *Shell) setJobPaused(c *cli.Context, action string, headline string) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/"+action, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, headline)
+}
+
 // DeleteJob deletes a job
 func (s *Shell) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -298,6 +480,57 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// SimulateJob simulates a run of a job from its TOML without saving it
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request := web.SimulateJobRequest{TOML: tomlString}
+	if c.IsSet("results") {
+		buf, ferr := fromFile(c.String("results"))
+		if ferr != nil {
+			return s.errorOut(errors.Wrap(ferr, "failed to read results file"))
+		}
+		if err = json.Unmarshal(buf.Bytes(), &request.TaskResults); err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to parse results file"))
+		}
+	}
+	if c.IsSet("run-id") {
+		runID := c.Int64("run-id")
+		request.PipelineRunID = &runID
+	}
+	if c.IsSet("vars") {
+		if err = json.Unmarshal([]byte(c.String("vars")), &request.Vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to parse vars"))
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/simulate", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var run presenters.PipelineRunResource
+	err = s.renderAPIResponse(resp, &run, "Simulated pipeline run")
+	return err
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
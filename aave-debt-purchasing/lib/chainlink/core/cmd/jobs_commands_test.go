@@ -5,6 +5,8 @@ import (
 	_ "embed"
 	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -403,6 +405,93 @@ func TestShell_CreateJobV2(t *testing.T) {
 	assert.Equal(t, "0x27548a32b9aD5D64c5945EaE9Da5337bc3169D15", output.OffChainReportingSpec.ContractAddress.String())
 }
 
+func TestShell_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	spec := `
+type            = "webhook"
+schemaVersion   = 1
+observationSource   = """
+    ds    [type=http method=GET url="http://example.invalid"]
+    parse [type=jsonparse path="data,result"]
+
+    ds -> parse
+"""
+`
+	resultsFile := filepath.Join(t.TempDir(), "results.json")
+	require.NoError(t, os.WriteFile(resultsFile, []byte(`{"ds": {"value": "{\"data\": {\"result\": \"42\"}}"}}`), 0600))
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.SimulateJob, set, "")
+	require.NoError(t, set.Set("results", resultsFile))
+	require.NoError(t, set.Parse([]string{spec}))
+
+	require.NoError(t, client.SimulateJob(cli.NewContext(nil, set, nil)))
+	requireJobsCount(t, app.JobORM(), 0)
+
+	run := *r.Renders[0].(*presenters.PipelineRunResource)
+	assert.Len(t, run.TaskRuns, 2)
+	require.Len(t, run.Outputs, 1)
+	assert.Equal(t, "42", *run.Outputs[0])
+}
+
+func TestShell_UpdateAndRollbackJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	specWithName := func(name string) string {
+		return fmt.Sprintf(`
+type            = "webhook"
+schemaVersion   = 1
+name            = "%s"
+observationSource   = """
+    ds    [type=http method=GET url="http://example.invalid"]
+"""
+`, name)
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.CreateJob, set, "")
+	require.NoError(t, set.Parse([]string{specWithName("first")}))
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, set, nil)))
+	created := *r.Renders[0].(*cmd.JobPresenter)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.UpdateJob, set, "")
+	require.NoError(t, set.Parse([]string{created.ID}))
+	require.Equal(t, "must pass the job id and TOML or filepath", client.UpdateJob(cli.NewContext(nil, set, nil)).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.UpdateJob, set, "")
+	require.NoError(t, set.Parse([]string{created.ID, specWithName("second")}))
+	require.NoError(t, client.UpdateJob(cli.NewContext(nil, set, nil)))
+	updated := *r.Renders[1].(*cmd.JobPresenter)
+	assert.Equal(t, created.ID, updated.ID)
+	assert.Equal(t, "second", updated.Name)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListJobSpecVersions, set, "")
+	require.NoError(t, set.Parse([]string{created.ID}))
+	require.NoError(t, client.ListJobSpecVersions(cli.NewContext(nil, set, nil)))
+	versions := *r.Renders[2].(*cmd.JobSpecVersionPresenters)
+	require.Len(t, versions, 2)
+	assert.Contains(t, versions[1].Diff, `+name            = "second"`)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RollbackJob, set, "")
+	require.NoError(t, set.Set("version", "1"))
+	require.NoError(t, set.Parse([]string{created.ID}))
+	require.NoError(t, client.RollbackJob(cli.NewContext(nil, set, nil)))
+	rolledBack := *r.Renders[3].(*cmd.JobPresenter)
+	assert.Equal(t, "first", rolledBack.Name)
+	requireJobsCount(t, app.JobORM(), 1)
+}
+
 func TestShell_DeleteJob(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initKeysBackupSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "backup",
+		Usage: format(`Exports every key of the node, and the states of its EVM keys, to a single encrypted bundle.`),
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "new-password, newpassword, p",
+				Usage: "`FILE` containing the password to encrypt the bundle (required)",
+			},
+			cli.StringFlag{
+				Name:  "output, o",
+				Usage: "`FILE` where the bundle will be saved (required)",
+			},
+		},
+		Action: s.BackupKeystore,
+	}
+}
+
+func initKeysRestoreSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:      "restore",
+		Usage:     format(`Restores the keys and EVM key states of a bundle made by backup which are missing from the node, and reports the conflicting ones.`),
+		ArgsUsage: "<bundle file>",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "old-password, oldpassword, p",
+				Usage: "`FILE` containing the password used to encrypt the bundle",
+			},
+			cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "only report what would be restored, without changing the keystore",
+			},
+		},
+		Action: s.RestoreKeystore,
+	}
+}
+
+// KeystoreRestorePresenter implements TableRenderer for a KeystoreRestoreResource
+type KeystoreRestorePresenter struct {
+	JAID
+	presenters.KeystoreRestoreResource
+}
+
+var keystoreRestoreHeaders = []string{"Action", "Key Type", "ID", "Details"}
+
+// RenderTable implements TableRenderer
+func (p *KeystoreRestorePresenter) RenderTable(rt RendererTable) error {
+	restoreAction := "restored"
+	if p.DryRun {
+		restoreAction = "to restore"
+	}
+
+	var rows [][]string
+	for _, k := range p.Keys {
+		rows = append(rows, []string{restoreAction, k.KeyType, k.ID, ""})
+	}
+	for _, s := range p.KeyStates {
+		rows = append(rows, []string{restoreAction, "Eth", s.Address.Hex(), fmt.Sprintf("state on chain %s, disabled=%s", s.EVMChainID.String(), strconv.FormatBool(s.Disabled))})
+	}
+	for _, c := range p.Conflicts {
+		rows = append(rows, []string{"conflict", c.KeyType, c.ID, c.Reason})
+	}
+
+	if _, err := rt.Write([]byte("🔑 Keystore Restore\n")); err != nil {
+		return err
+	}
+	renderList(keystoreRestoreHeaders, rows, rt.Writer)
+	return nil
+}
+
+// BackupKeystore exports every key of the node to an encrypted bundle.
+func (s *Shell) BackupKeystore(c *cli.Context) (err error) {
+	newPasswordFile := c.String("new-password")
+	if len(newPasswordFile) == 0 {
+		return s.errorOut(errors.New("Must specify --new-password/-p flag"))
+	}
+	newPassword, err := os.ReadFile(newPasswordFile)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	filepath := c.String("output")
+	if len(filepath) == 0 {
+		return s.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+
+	backupURL := url.URL{
+		Path: "/v2/keys/backup",
+	}
+	query := backupURL.Query()
+	query.Set("newpassword", normalizePassword(string(newPassword)))
+
+	backupURL.RawQuery = query.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), backupURL.String(), nil)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(fmt.Errorf("error backing up keystore: %w", httpError(resp)))
+	}
+
+	bundle, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read response body"))
+	}
+
+	err = utils.WriteFileWithMaxPerms(filepath, bundle, 0o600)
+	if err != nil {
+		return s.errorOut(errors.Wrapf(err, "Could not write %v", filepath))
+	}
+
+	_, err = os.Stderr.WriteString("🔑 Backed up keystore to " + filepath + "\n")
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	return nil
+}
+
+// RestoreKeystore restores the missing keys of a bundle made by BackupKeystore. Path to the
+// bundle must be passed.
+func (s *Shell) RestoreKeystore(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("Must pass the filepath of the bundle to be restored"))
+	}
+
+	oldPasswordFile := c.String("old-password")
+	if len(oldPasswordFile) == 0 {
+		return s.errorOut(errors.New("Must specify --old-password/-p flag"))
+	}
+	oldPassword, err := os.ReadFile(oldPasswordFile)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	bundle, err := os.ReadFile(c.Args().Get(0))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	restoreURL := url.URL{
+		Path: "/v2/keys/restore",
+	}
+	query := restoreURL.Query()
+	query.Set("oldpassword", normalizePassword(string(oldPassword)))
+	query.Set("dryRun", strconv.FormatBool(c.Bool("dry-run")))
+
+	restoreURL.RawQuery = query.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), restoreURL.String(), bytes.NewReader(bundle))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &KeystoreRestorePresenter{})
+}
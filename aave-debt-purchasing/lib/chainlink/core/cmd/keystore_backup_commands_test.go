@@ -0,0 +1,104 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeystoreRestorePresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+	address := common.HexToAddress("0x1234")
+
+	p := cmd.KeystoreRestorePresenter{
+		KeystoreRestoreResource: presenters.KeystoreRestoreResource{
+			DryRun:    true,
+			Keys:      []keystore.RestoredKey{{KeyType: "P2P", ID: "p2p-id"}},
+			KeyStates: []keystore.BackupKeyState{{Address: address, EVMChainID: *ubig.NewI(1)}},
+			Conflicts: []keystore.RestoreConflict{{KeyType: "CSA", ID: "csa-id", Reason: "key already exists"}},
+		},
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "to restore")
+	assert.Contains(t, output, "p2p-id")
+	assert.Contains(t, output, address.Hex())
+	assert.Contains(t, output, "state on chain 1, disabled=false")
+	assert.Contains(t, output, "key already exists")
+}
+
+func TestShell_BackupRestoreKeystore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	keys, err := app.GetKeyStore().P2P().GetAll()
+	require.NoError(t, err)
+	keyCount := len(keys) + 1
+	key, err := app.GetKeyStore().P2P().Create(ctx)
+	require.NoError(t, err)
+	requireP2PKeyCount(t, app, keyCount)
+	bundleName := keyNameForTest(t)
+
+	// Backup requires an output file
+	set := flag.NewFlagSet("test keystore backup", 0)
+	flagSetApplyFromAction(client.BackupKeystore, set, "")
+	require.NoError(t, set.Set("new-password", "../internal/fixtures/incorrect_password.txt"))
+	require.Error(t, client.BackupKeystore(cli.NewContext(nil, set, nil)))
+
+	// Backup
+	set = flag.NewFlagSet("test keystore backup", 0)
+	flagSetApplyFromAction(client.BackupKeystore, set, "")
+	require.NoError(t, set.Set("new-password", "../internal/fixtures/incorrect_password.txt"))
+	require.NoError(t, set.Set("output", bundleName))
+	require.NoError(t, client.BackupKeystore(cli.NewContext(nil, set, nil)))
+	require.NoError(t, utils.JustError(os.Stat(bundleName)))
+
+	_, err = app.GetKeyStore().P2P().Delete(ctx, key.PeerID())
+	require.NoError(t, err)
+	requireP2PKeyCount(t, app, keyCount-1)
+
+	// Dry run
+	set = flag.NewFlagSet("test keystore restore", 0)
+	flagSetApplyFromAction(client.RestoreKeystore, set, "")
+	require.NoError(t, set.Parse([]string{bundleName}))
+	require.NoError(t, set.Set("old-password", "../internal/fixtures/incorrect_password.txt"))
+	require.NoError(t, set.Set("dry-run", "true"))
+	require.NoError(t, client.RestoreKeystore(cli.NewContext(nil, set, nil)))
+	requireP2PKeyCount(t, app, keyCount-1)
+
+	report := *r.Renders[0].(*cmd.KeystoreRestorePresenter)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, []keystore.RestoredKey{{KeyType: "P2P", ID: key.ID()}}, report.Keys)
+	assert.NotEmpty(t, report.Conflicts)
+
+	// Restore
+	set = flag.NewFlagSet("test keystore restore", 0)
+	flagSetApplyFromAction(client.RestoreKeystore, set, "")
+	require.NoError(t, set.Parse([]string{bundleName}))
+	require.NoError(t, set.Set("old-password", "../internal/fixtures/incorrect_password.txt"))
+	require.NoError(t, client.RestoreKeystore(cli.NewContext(nil, set, nil)))
+	requireP2PKeyCount(t, app, keyCount)
+
+	_, err = app.GetKeyStore().P2P().Get(key.PeerID())
+	require.NoError(t, err)
+}
@@ -210,7 +210,11 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		return nil, errors.Wrap(err, "error authenticating keystore")
 	}
 
-	beholderAuthHeaders, csaPubKeyHex, err := keystore.BuildBeholderAuth(ctx, keyStore.CSA())
+	remoteSigner, err := chainlink.NewRemoteSigner(cfg.RemoteSigner())
+	if err != nil {
+		return nil, err
+	}
+	beholderAuthHeaders, csaPubKeyHex, err := keystore.BuildBeholderAuth(ctx, chainlink.NewCSASigner(keyStore.CSA(), remoteSigner))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to build Beholder auth")
 	}
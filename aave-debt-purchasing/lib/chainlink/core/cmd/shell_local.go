@@ -8,9 +8,11 @@ import (
 	"math/big"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
@@ -31,6 +33,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
@@ -382,7 +385,10 @@ func (s *Shell) runNode(c *cli.Context) error {
 
 	legacyEVMChains := app.GetRelayers().LegacyEVMChains()
 
-	if s.Config.EVMEnabled() {
+	// The Eth and CSA keys are held by the remote signer, if there is one.
+	remoteSigner := s.Config.RemoteSigner().URL() != nil
+
+	if s.Config.EVMEnabled() && !remoteSigner {
 		// ensure any imported keys are imported
 		for _, k := range s.Config.ImportedEthKeys().List() {
 			lggr.Debug("Importing eth key")
@@ -505,9 +511,11 @@ func (s *Shell) runNode(c *cli.Context) error {
 		return errors.Wrap(err2, "failed to ensure workflow key")
 	}
 
-	err2 = app.GetKeyStore().CSA().EnsureKey(rootCtx)
-	if err2 != nil {
-		return errors.Wrap(err2, "failed to ensure CSA key")
+	if !remoteSigner {
+		err2 = app.GetKeyStore().CSA().EnsureKey(rootCtx)
+		if err2 != nil {
+			return errors.Wrap(err2, "failed to ensure CSA key")
+		}
 	}
 
 	if e := checkFilePermissions(lggr, s.Config.RootDir()); e != nil {
@@ -546,6 +554,11 @@ func (s *Shell) runNode(c *cli.Context) error {
 		return nil
 	})
 
+	grp.Go(func() error {
+		s.reloadConfigOnSignal(grpCtx, app)
+		return nil
+	})
+
 	lggr.Infow(fmt.Sprintf("Chainlink booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
 
 	grp.Go(func() error {
@@ -562,6 +575,32 @@ func (s *Shell) runNode(c *cli.Context) error {
 	return grp.Wait()
 }
 
+// reloadConfigOnSignal reads the config and secrets files again each time SIGHUP is received,
+// and applies them to the running app, until ctx is done.
+func (s *Shell) reloadConfigOnSignal(ctx context.Context, app chainlink.Application) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	defer signal.Stop(ch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch:
+			s.Logger.Info("Reloading config due to SIGHUP signal received...")
+			var opts chainlink.GeneralConfigOpts
+			cfg, err := initServerConfig(&opts, s.configFiles, s.secretsFiles)
+			if err != nil {
+				s.Logger.Errorw("Failed to read config, keeping the current config", "err", err)
+				continue
+			}
+			if err = app.ReloadConfig(cfg); err != nil {
+				s.Logger.Errorw("Failed to reload config", "err", err)
+			}
+		}
+	}
+}
+
 func checkFilePermissions(lggr logger.Logger, rootDir string) error {
 	// Ensure tls sub directory (and children) permissions are <= `ownerPermsMask``
 	tlsDir := filepath.Join(rootDir, "tls")
@@ -1055,3 +1094,75 @@ func (s *Shell) RemoveBlocks(c *cli.Context) error {
 
 	return nil
 }
+
+// initKeysRotatePasswordSubCmd returns the keys command rotating the keystore password. Unlike the other keys
+// commands, it runs locally against the database, so it takes the config flags of the node command.
+func initKeysRotatePasswordSubCmd(s *Shell, configFlags []cli.Flag, before cli.BeforeFunc) cli.Command {
+	return cli.Command{
+		Name:   "rotate-password",
+		Usage:  "Re-encrypts all keys in the keystore under a new password. Must be run locally, with the node stopped.",
+		Action: s.RotateKeystorePassword,
+		Before: before,
+		Flags: append([]cli.Flag{
+			cli.StringFlag{
+				Name:  "password, p",
+				Usage: "text file holding the current password for the node's account. If left blank, Password.Keystore will be used.",
+			},
+			cli.StringFlag{
+				Name:     "new-password, n",
+				Usage:    "text file holding the new password for the node's account",
+				Required: true,
+			},
+		}, configFlags...),
+	}
+}
+
+// RotateKeystorePassword re-encrypts every key of the keystore under a new password. It takes
+// the database lock, so the node has to be stopped, and its password updated before it is
+// started again.
+func (s *Shell) RotateKeystorePassword(c *cli.Context) error {
+	cfg := s.Config
+	if c.IsSet("password") {
+		pwd, err := utils.PasswordFromFile(c.String("password"))
+		if err != nil {
+			return s.errorOut(fmt.Errorf("error reading password: %w", err))
+		}
+		cfg.SetPasswords(&pwd, nil)
+	}
+	err := cfg.Validate()
+	if err != nil {
+		return s.errorOut(fmt.Errorf("error validating configuration: %w", err))
+	}
+
+	newPassword, err := utils.PasswordFromFile(c.String("new-password"))
+	if err != nil {
+		return s.errorOut(fmt.Errorf("error reading new password: %w", err))
+	}
+	if err = utils.VerifyPasswordComplexity(newPassword); err != nil {
+		return s.errorOut(err)
+	}
+	currentPassword := cfg.Password().Keystore()
+	if newPassword == currentPassword {
+		return s.errorOut(errors.New("new password must differ from the current password"))
+	}
+
+	lggr := logger.Sugared(s.Logger.Named("RotateKeystorePassword"))
+	ldb := pg.NewLockedDB(cfg.AppID(), cfg.Database(), cfg.Database().Lock(), lggr)
+	ctx := s.ctx()
+	if err = ldb.Open(ctx); err != nil {
+		return s.errorOut(errors.Wrap(err, "opening db"))
+	}
+	defer lggr.ErrorIfFn(ldb.Close, "Error closing db")
+
+	scryptParams := utils.GetScryptParams(cfg)
+	keyStore := keystore.New(ldb.DB(), scryptParams, lggr)
+	if err = keyStore.Unlock(ctx, currentPassword); err != nil {
+		return s.errorOut(errors.Wrap(err, "error authenticating keystore"))
+	}
+	if err = keyStore.RotatePassword(ctx, currentPassword, newPassword, scryptParams); err != nil {
+		return s.errorOut(err)
+	}
+
+	lggr.Info("RotateKeystorePassword: successfully rotated the keystore password, update the password of the node before starting it")
+	return nil
+}
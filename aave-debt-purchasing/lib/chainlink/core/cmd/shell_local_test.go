@@ -4,11 +4,14 @@ import (
 	"errors"
 	"flag"
 	"math/big"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
 
+	gethCommon "github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -26,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
 
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -41,6 +45,10 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	chainlinkmocks "github.com/smartcontractkit/chainlink/v2/core/services/chainlink/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -234,6 +242,75 @@ func TestShell_RunNodeWithAPICredentialsFile(t *testing.T) {
 	}
 }
 
+func TestShell_RunNodeWithRemoteSigner(t *testing.T) {
+	ethKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+	csaKey := csakey.MustNewV2XXXTestingOnly(big.NewInt(1))
+	signer := remotesigner.NewReferenceSigner("remote-signer-token")
+	signer.AddEthKey(ethKey)
+	signer.AddCSAKey(csaKey)
+	srv := httptest.NewServer(signer)
+	t.Cleanup(srv.Close)
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		s.Password.Keystore = models.NewSecret("16charlengthp4SsW0rD1!@#_")
+		c.EVM[0].Nodes[0].Name = ptr("fake")
+		c.EVM[0].Nodes[0].WSURL = commonconfig.MustParseURL("WSS://fake.com/ws")
+		c.EVM[0].Nodes[0].HTTPURL = commonconfig.MustParseURL("http://fake.com")
+		c.RemoteSigner.URL = commonconfig.MustParseURL(srv.URL)
+		s.RemoteSigner.AuthToken = models.NewSecret("remote-signer-token")
+		// seems to be needed for config validate
+		c.Insecure.OCRDevelopmentMode = nil
+	})
+	db := pgtest.NewSqlxDB(t)
+	authProviderORM := localauth.NewORM(db, time.Minute, logger.TestLogger(t), audit.NoopLogger)
+	keyStore := cltest.NewKeyStore(t, db)
+
+	remoteSigner, err := chainlink.NewRemoteSigner(cfg.RemoteSigner())
+	require.NoError(t, err)
+	csaSigner := chainlink.NewCSASigner(keyStore.CSA(), remoteSigner)
+	testRelayers := genTestEVMRelayers(t, cfg, db, keyStore.Eth(), csaSigner)
+
+	app := mocks.NewApplication(t)
+	app.On("BasicAdminUsersORM").Return(authProviderORM)
+	app.On("GetKeyStore").Return(keyStore)
+	app.On("GetRelayers").Return(testRelayers).Maybe()
+	app.On("Start", mock.Anything).Maybe().Return(nil)
+	app.On("Stop").Maybe().Return(nil)
+	app.On("ID").Maybe().Return(uuid.New())
+
+	client := cmd.Shell{
+		Config:                 cfg,
+		AppFactory:             cltest.InstanceAppFactory{App: app},
+		KeyStoreAuthenticator:  cmd.TerminalKeyStoreAuthenticator{Prompter: cmdMocks.NewPrompter(t)},
+		FallbackAPIInitializer: cltest.NewMockAPIInitializer(t),
+		Runner:                 cltest.EmptyRunner{},
+		Logger:                 logger.TestLogger(t),
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RunNode, set, "")
+	require.NoError(t, set.Set("api", "../internal/fixtures/apicredentials"))
+	require.NoError(t, client.RunNode(cli.NewContext(nil, set, nil)))
+
+	// No key material was created in the keystore of the node.
+	ethKeys, err := keyStore.Eth().GetAll(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Empty(t, ethKeys)
+	csaKeys, err := keyStore.CSA().GetAll()
+	require.NoError(t, err)
+	assert.Empty(t, csaKeys)
+
+	// The node signs with the keys of the remote signer.
+	ethSigner := keys.NewChainStore(evmrelay.NewEthSigner(keyStore.Eth(), remoteSigner, &cltest.FixtureChainID), &cltest.FixtureChainID)
+	addresses, err := ethSigner.EnabledAddresses(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Equal(t, []gethCommon.Address{ethKey.Address}, addresses)
+	_, csaPubKeyHex, err := keystore.BuildBeholderAuth(testutils.Context(t), csaSigner)
+	require.NoError(t, err)
+	assert.Equal(t, csaKey.ID(), csaPubKeyHex)
+}
+
 func TestShell_DiskMaxSizeBeforeRotateOptionDisablesAsExpected(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -563,3 +640,46 @@ func TestShell_RemoveBlocks(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestShell_RotateKeystorePassword(t *testing.T) {
+	// Use a non-transactional db, since the command opens its own connection.
+	config, sqlxDB := heavyweight.FullTestDBV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM = nil
+		// seems to be needed for config validate
+		c.Insecure.OCRDevelopmentMode = nil
+	})
+	keyStore := cltest.NewKeyStore(t, sqlxDB)
+	key, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+	shell := cmd.Shell{
+		Config: config,
+		Logger: logger.TestLogger(t),
+	}
+	newPasswordFile := filepath.Join(t.TempDir(), "new_password.txt")
+
+	t.Run("rejects a weak password", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(newPasswordFile, []byte("weak"), 0600))
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.RotateKeystorePassword, set, "")
+		require.NoError(t, set.Set("password", "../internal/fixtures/correct_password.txt"))
+		require.NoError(t, set.Set("new-password", newPasswordFile))
+		require.Error(t, shell.RotateKeystorePassword(cli.NewContext(nil, set, nil)))
+	})
+
+	t.Run("rotates the password", func(t *testing.T) {
+		const newPassword = "16charlengthp4SsW0rD1!@#_rotated"
+		require.NoError(t, os.WriteFile(newPasswordFile, []byte(newPassword), 0600))
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.RotateKeystorePassword, set, "")
+		require.NoError(t, set.Set("password", "../internal/fixtures/correct_password.txt"))
+		require.NoError(t, set.Set("new-password", newPasswordFile))
+		require.NoError(t, shell.RotateKeystorePassword(cli.NewContext(nil, set, nil)))
+
+		ctx := testutils.Context(t)
+		rotated := keystore.New(sqlxDB, utils.FastScryptParams, logger.TestLogger(t))
+		require.Error(t, rotated.Unlock(ctx, cltest.Password))
+		require.NoError(t, rotated.Unlock(ctx, newPassword))
+		_, err := rotated.Eth().Get(ctx, key.ID())
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initWorkflowsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:      "replay",
+			Usage:     "Replays a stored workflow execution against the workflow engine with stubbed capabilities",
+			ArgsUsage: "<executionID>",
+			Action:    s.ReplayWorkflowExecution,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "step",
+					Usage: "pause before each replayed step until enter is pressed",
+				},
+			},
+		},
+	}
+}
+
+// WorkflowReplayPresenter implements TableRenderer for a WorkflowReplayResource
+type WorkflowReplayPresenter struct {
+	presenters.WorkflowReplayResource
+}
+
+// ToRow presents the WorkflowReplayResource as a slice of strings.
+func (p *WorkflowReplayPresenter) ToRow() []string {
+	return []string{p.ID, p.ReplayExecutionID, p.RecordedStatus, p.Status}
+}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowReplayPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Execution ID", "Replay Execution ID", "Recorded Status", "Replayed Status"})
+	table.Append(p.ToRow())
+	render("Workflow Replay", table)
+
+	steps := make(WorkflowReplayStepPresenters, 0, len(p.Steps))
+	for _, s := range p.Steps {
+		steps = append(steps, WorkflowReplayStepPresenter{s})
+	}
+	return steps.RenderTable(rt)
+}
+
+// WorkflowReplayStepPresenter implements TableRenderer for a WorkflowReplayStepResource
+type WorkflowReplayStepPresenter struct {
+	presenters.WorkflowReplayStepResource
+}
+
+// ToRow presents the WorkflowReplayStepResource as a slice of strings.
+func (p *WorkflowReplayStepPresenter) ToRow() []string {
+	var errMsg string
+	if p.Error != nil {
+		errMsg = *p.Error
+	}
+
+	return []string{
+		p.Ref,
+		p.CapabilityID,
+		p.Status,
+		strconv.FormatBool(p.Recorded),
+		strconv.FormatBool(p.InputsMatch),
+		formatReplayValue(p.Inputs),
+		formatReplayValue(p.Outputs),
+		errMsg,
+	}
+}
+
+var workflowReplayStepHeaders = []string{"Ref", "Capability ID", "Status", "Recorded", "Inputs Match", "Inputs", "Outputs", "Error"}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowReplayStepPresenter) RenderTable(rt RendererTable) error {
+	renderList(workflowReplayStepHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// WorkflowReplayStepPresenters implements TableRenderer for a slice of WorkflowReplayStepPresenter
+type WorkflowReplayStepPresenters []WorkflowReplayStepPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowReplayStepPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(workflowReplayStepHeaders, rows, rt.Writer)
+	return nil
+}
+
+func formatReplayValue(v any) string {
+	if v == nil {
+		return ""
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+// ReplayWorkflowExecution replays a stored workflow execution and renders every step the engine invoked.
+// With --step, the replay pauses before each step, and resumes when the user presses enter.
+func (s *Shell) ReplayWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the workflow execution"))
+	}
+	executionID := c.Args().First()
+
+	request, err := json.Marshal(web.WorkflowReplayRequest{Step: c.Bool("step")})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), fmt.Sprintf("/v2/workflows/executions/%s/replay", url.PathEscape(executionID)), bytes.NewBuffer(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	if !c.Bool("step") {
+		defer func() {
+			if cerr := resp.Body.Close(); cerr != nil {
+				err = multierr.Append(err, cerr)
+			}
+		}()
+		return s.renderAPIResponse(resp, &WorkflowReplayPresenter{}, "Workflow execution replayed")
+	}
+
+	prompter := NewTerminalPrompter()
+	for i := 1; ; i++ {
+		var result WorkflowReplayPresenter
+		if err = s.deserializeWorkflowReplay(resp, &result); err != nil {
+			return s.errorOut(err)
+		}
+
+		if result.PausedAt == nil {
+			result.Steps = nil
+			return s.errorOut(s.Render(&result, "Workflow execution replayed"))
+		}
+
+		if err = s.Render(&WorkflowReplayStepPresenter{*result.PausedAt}, fmt.Sprintf("Step %d", i)); err != nil {
+			return s.errorOut(err)
+		}
+		prompter.Prompt("Press enter to run the step ")
+
+		resp, err = s.HTTP.Post(s.ctx(), fmt.Sprintf("/v2/workflows/replays/%s/next", url.PathEscape(result.SessionID)), nil)
+		if err != nil {
+			return s.errorOut(err)
+		}
+	}
+}
+
+func (s *Shell) deserializeWorkflowReplay(resp *http.Response, result *WorkflowReplayPresenter) (err error) {
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var links jsonapi.Links
+	return s.deserializeAPIResponse(resp, result, &links)
+}
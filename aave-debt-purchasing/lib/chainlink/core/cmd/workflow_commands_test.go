@@ -0,0 +1,140 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/artifacts"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowReplayPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		errMsg = "stub not recorded"
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.WorkflowReplayPresenter{
+		WorkflowReplayResource: presenters.WorkflowReplayResource{
+			JAID:              presenters.NewJAID("execution-id"),
+			ReplayExecutionID: "replay-execution-id",
+			RecordedStatus:    store.StatusCompleted,
+			Status:            store.StatusErrored,
+			Steps: []presenters.WorkflowReplayStepResource{
+				{
+					Ref:          "write",
+					CapabilityID: "write_ethereum-testnet-sepolia@1.0.0",
+					Status:       store.StatusErrored,
+					Inputs:       map[string]any{"report": "0xabcd"},
+					Error:        &errMsg,
+				},
+			},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "execution-id")
+	assert.Contains(t, output, "replay-execution-id")
+	assert.Contains(t, output, store.StatusCompleted)
+	assert.Contains(t, output, "write_ethereum-testnet-sepolia@1.0.0")
+	assert.Contains(t, output, `{"report":"0xabcd"}`)
+	assert.Contains(t, output, errMsg)
+}
+
+func TestShell_ReplayWorkflowExecution(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+	lggr := logger.TestLogger(t)
+
+	const workflowID = "15c631d295ef5e32deb99a10ee6804bc4af1385568f9b3363f6552ac6dbb2cef"
+	_, err := artifacts.NewWorkflowRegistryDS(app.GetDB(), lggr).UpsertWorkflowSpec(ctx, &job.WorkflowSpec{
+		Workflow: `
+triggers:
+  - id: "cron-trigger@1.0.0"
+    config:
+      schedule: "* * * * *"
+
+targets:
+  - id: "write_ethereum-testnet-sepolia@1.0.0"
+    inputs:
+      report: "$(trigger.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+`,
+		WorkflowID:    workflowID,
+		WorkflowOwner: "owner",
+		WorkflowName:  "replay",
+		SpecType:      job.YamlSpec,
+		Status:        job.WorkflowSpecStatusActive,
+	})
+	require.NoError(t, err)
+
+	report, err := values.NewMap(map[string]any{"report": "0xabcd"})
+	require.NoError(t, err)
+	inputs, err := values.NewMap(map[string]any{"report": "0xabcd"})
+	require.NoError(t, err)
+
+	const targetRef = "write_ethereum-testnet-sepolia@1.0.0"
+	executionStore := store.NewDBStore(app.GetDB(), lggr, clockwork.NewRealClock())
+	_, err = executionStore.Add(ctx, map[string]*store.WorkflowExecutionStep{
+		"trigger": {Ref: "trigger", ExecutionID: "execution-id", Status: store.StatusCompleted, Outputs: store.StepOutput{Value: report}},
+		targetRef: {Ref: targetRef, ExecutionID: "execution-id", Status: store.StatusCompleted, Inputs: inputs, Outputs: store.StepOutput{Value: values.EmptyMap()}},
+	}, "execution-id", workflowID, store.StatusStarted)
+	require.NoError(t, err)
+	_, err = executionStore.FinishExecution(ctx, "execution-id", store.StatusCompleted)
+	require.NoError(t, err)
+
+	t.Run("replays the execution", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(client.ReplayWorkflowExecution, set, "")
+		require.NoError(t, set.Parse([]string{"execution-id"}))
+
+		r.Renders = nil
+		require.NoError(t, client.ReplayWorkflowExecution(cli.NewContext(nil, set, nil)))
+		require.Len(t, r.Renders, 1)
+		result, ok := r.Renders[0].(*cmd.WorkflowReplayPresenter)
+		require.True(t, ok, "Expected Renders[0] to be *cmd.WorkflowReplayPresenter, got %T", r.Renders[0])
+		assert.Equal(t, "execution-id", result.ID)
+		assert.Equal(t, store.StatusCompleted, result.RecordedStatus)
+		assert.Equal(t, store.StatusCompleted, result.Status)
+		require.Len(t, result.Steps, 1)
+		assert.Equal(t, targetRef, result.Steps[0].Ref)
+		assert.True(t, result.Steps[0].Recorded)
+		assert.True(t, result.Steps[0].InputsMatch)
+	})
+
+	t.Run("unknown execution", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(client.ReplayWorkflowExecution, set, "")
+		require.NoError(t, set.Parse([]string{"unknown"}))
+
+		require.Error(t, client.ReplayWorkflowExecution(cli.NewContext(nil, set, nil)))
+	})
+
+	t.Run("missing execution id", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(client.ReplayWorkflowExecution, set, "")
+
+		require.ErrorContains(t, client.ReplayWorkflowExecution(cli.NewContext(nil, set, nil)), "must provide the id of the workflow execution")
+	})
+}
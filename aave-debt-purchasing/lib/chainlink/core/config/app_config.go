@@ -46,18 +46,21 @@ type AppConfig interface {
 	Keeper() Keeper
 	Log() Log
 	Mercury() Mercury
+	NonceRepair() NonceRepair
 	OCR() OCR
 	OCR2() OCR2
 	P2P() P2P
 	Password() Password
 	Prometheus() Prometheus
 	Pyroscope() Pyroscope
+	RemoteSigner() RemoteSigner
 	Sentry() Sentry
 	TelemetryIngress() TelemetryIngress
 	Threshold() Threshold
 	WebServer() WebServer
 	Tracing() Tracing
 	Telemetry() Telemetry
+	TxmV2() TxmV2
 }
 
 type DatabaseBackupMode string
@@ -17,6 +17,10 @@ type EngineExecutionRateLimit interface {
 	GlobalBurst() int
 	PerSenderRPS() float64
 	PerSenderBurst() int
+	PerWorkflowRPS() float64
+	PerWorkflowBurst() int
+	PerTriggerRPS() float64
+	PerTriggerBurst() int
 }
 
 type CapabilitiesWorkflowRegistry interface {
@@ -0,0 +1,10 @@
+package config
+
+import "time"
+
+type NonceRepair interface {
+	Enabled() bool
+	AutoRepair() bool
+	PollPeriod() time.Duration
+	StuckThreshold() time.Duration
+}
@@ -18,7 +18,9 @@ import (
 	ocrcommontypes "github.com/smartcontractkit/libocr/commontypes"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/parse"
@@ -61,6 +63,9 @@ type Core struct {
 	Capabilities     Capabilities     `toml:",omitempty"`
 	Telemetry        Telemetry        `toml:",omitempty"`
 	Workflows        Workflows        `toml:",omitempty"`
+	RemoteSigner     RemoteSigner     `toml:",omitempty"`
+	NonceRepair      NonceRepair      `toml:",omitempty"`
+	TxmV2            TxmV2            `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -102,6 +107,9 @@ func (c *Core) SetFrom(f *Core) {
 	c.Insecure.setFrom(&f.Insecure)
 	c.Tracing.setFrom(&f.Tracing)
 	c.Telemetry.setFrom(&f.Telemetry)
+	c.RemoteSigner.setFrom(&f.RemoteSigner)
+	c.NonceRepair.setFrom(&f.NonceRepair)
+	c.TxmV2.setFrom(&f.TxmV2)
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -124,15 +132,16 @@ func (c *Core) ValidateConfig() (err error) {
 }
 
 type Secrets struct {
-	Database   DatabaseSecrets          `toml:",omitempty"`
-	Password   Passwords                `toml:",omitempty"`
-	WebServer  WebServerSecrets         `toml:",omitempty"`
-	Pyroscope  PyroscopeSecrets         `toml:",omitempty"`
-	Prometheus PrometheusSecrets        `toml:",omitempty"`
-	Mercury    MercurySecrets           `toml:",omitempty"`
-	Threshold  ThresholdKeyShareSecrets `toml:",omitempty"`
-	EVM        EthKeys                  `toml:",omitempty"` // choose EVM as the TOML field name to align with relayer config convention
-	P2PKey     P2PKey                   `toml:",omitempty"`
+	Database     DatabaseSecrets          `toml:",omitempty"`
+	Password     Passwords                `toml:",omitempty"`
+	WebServer    WebServerSecrets         `toml:",omitempty"`
+	Pyroscope    PyroscopeSecrets         `toml:",omitempty"`
+	Prometheus   PrometheusSecrets        `toml:",omitempty"`
+	Mercury      MercurySecrets           `toml:",omitempty"`
+	Threshold    ThresholdKeyShareSecrets `toml:",omitempty"`
+	RemoteSigner RemoteSignerSecrets      `toml:",omitempty"`
+	EVM          EthKeys                  `toml:",omitempty"` // choose EVM as the TOML field name to align with relayer config convention
+	P2PKey       P2PKey                   `toml:",omitempty"`
 }
 
 type EthKeys struct {
@@ -442,6 +451,31 @@ func (p *PrometheusSecrets) validateMerge(f *PrometheusSecrets) (err error) {
 	return err
 }
 
+type RemoteSignerSecrets struct {
+	AuthToken *models.Secret
+}
+
+func (r *RemoteSignerSecrets) SetFrom(f *RemoteSignerSecrets) (err error) {
+	err = r.validateMerge(f)
+	if err != nil {
+		return err
+	}
+
+	if v := f.AuthToken; v != nil {
+		r.AuthToken = v
+	}
+
+	return nil
+}
+
+func (r *RemoteSignerSecrets) validateMerge(f *RemoteSignerSecrets) (err error) {
+	if r.AuthToken != nil && f.AuthToken != nil {
+		err = multierr.Append(err, configutils.ErrOverride{Name: "AuthToken"})
+	}
+
+	return err
+}
+
 type Feature struct {
 	FeedsManager       *bool
 	LogPoller          *bool
@@ -1381,6 +1415,114 @@ func (s *Sentry) setFrom(f *Sentry) {
 	}
 }
 
+type RemoteSigner struct {
+	URL     *commonconfig.URL
+	Timeout *commonconfig.Duration
+}
+
+func (r *RemoteSigner) setFrom(f *RemoteSigner) {
+	if v := f.URL; v != nil {
+		r.URL = v
+	}
+	if v := f.Timeout; v != nil {
+		r.Timeout = v
+	}
+}
+
+func (r *RemoteSigner) ValidateConfig() (err error) {
+	if r.URL.IsZero() {
+		return
+	}
+	if scheme := r.URL.URL().Scheme; scheme != "http" && scheme != "https" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "URL", Value: r.URL.String(), Msg: "must be an http or https URL"})
+	}
+	if r.Timeout != nil && r.Timeout.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Timeout", Value: r.Timeout.String(), Msg: "must be greater than zero"})
+	}
+	return
+}
+
+type NonceRepair struct {
+	Enabled        *bool
+	AutoRepair     *bool
+	PollPeriod     *commonconfig.Duration
+	StuckThreshold *commonconfig.Duration
+}
+
+func (n *NonceRepair) setFrom(f *NonceRepair) {
+	if v := f.Enabled; v != nil {
+		n.Enabled = v
+	}
+	if v := f.AutoRepair; v != nil {
+		n.AutoRepair = v
+	}
+	if v := f.PollPeriod; v != nil {
+		n.PollPeriod = v
+	}
+	if v := f.StuckThreshold; v != nil {
+		n.StuckThreshold = v
+	}
+}
+
+func (n *NonceRepair) ValidateConfig() (err error) {
+	if n.PollPeriod != nil && n.PollPeriod.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "PollPeriod", Value: n.PollPeriod.String(), Msg: "must be greater than zero"})
+	}
+	if n.StuckThreshold != nil && n.StuckThreshold.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "StuckThreshold", Value: n.StuckThreshold.String(), Msg: "must be greater than zero"})
+	}
+	return
+}
+
+type TxmV2 struct {
+	FeePolicies []TxmV2FeePolicy `toml:",omitempty"`
+}
+
+func (t *TxmV2) setFrom(f *TxmV2) {
+	if v := f.FeePolicies; v != nil {
+		t.FeePolicies = v
+	}
+}
+
+func (t *TxmV2) ValidateConfig() (err error) {
+	type key struct {
+		chainID  string
+		priority string
+	}
+	seen := make(map[key]struct{}, len(t.FeePolicies))
+	for i, p := range t.FeePolicies {
+		if p.Priority == nil {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("FeePolicies[%d].Priority", i), Msg: "must be set"})
+			continue
+		}
+		switch *p.Priority {
+		case "normal", "high", "urgent":
+		default:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("FeePolicies[%d].Priority", i), Value: *p.Priority, Msg: "must be one of normal, high or urgent"})
+		}
+		if p.Deadline != nil && p.Deadline.Duration() < 0 {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("FeePolicies[%d].Deadline", i), Value: p.Deadline.String(), Msg: "must not be negative"})
+		}
+		k := key{priority: *p.Priority}
+		if p.ChainID != nil {
+			k.chainID = p.ChainID.String()
+		}
+		if _, ok := seen[k]; ok {
+			err = multierr.Append(err, configutils.NewErrDuplicate(fmt.Sprintf("FeePolicies[%d]", i), fmt.Sprintf("ChainID %q, Priority %q", k.chainID, k.priority)))
+		}
+		seen[k] = struct{}{}
+	}
+	return
+}
+
+type TxmV2FeePolicy struct {
+	ChainID     *big.Big
+	Priority    *string
+	MaxFeeCap   *assets.Wei
+	BumpPercent *uint16
+	Deadline    *commonconfig.Duration
+}
+
 type Insecure struct {
 	DevWebServer         *bool
 	OCRDevelopmentMode   *bool
@@ -1582,10 +1724,14 @@ func (m *MercurySecrets) ValidateConfig() (err error) {
 }
 
 type EngineExecutionRateLimit struct {
-	GlobalRPS      *float64
-	GlobalBurst    *int
-	PerSenderRPS   *float64
-	PerSenderBurst *int
+	GlobalRPS        *float64
+	GlobalBurst      *int
+	PerSenderRPS     *float64
+	PerSenderBurst   *int
+	PerWorkflowRPS   *float64
+	PerWorkflowBurst *int
+	PerTriggerRPS    *float64
+	PerTriggerBurst  *int
 }
 
 func (eerl *EngineExecutionRateLimit) setFrom(f *EngineExecutionRateLimit) {
@@ -1601,6 +1747,18 @@ func (eerl *EngineExecutionRateLimit) setFrom(f *EngineExecutionRateLimit) {
 	if f.PerSenderBurst != nil {
 		eerl.PerSenderBurst = f.PerSenderBurst
 	}
+	if f.PerWorkflowRPS != nil {
+		eerl.PerWorkflowRPS = f.PerWorkflowRPS
+	}
+	if f.PerWorkflowBurst != nil {
+		eerl.PerWorkflowBurst = f.PerWorkflowBurst
+	}
+	if f.PerTriggerRPS != nil {
+		eerl.PerTriggerRPS = f.PerTriggerRPS
+	}
+	if f.PerTriggerBurst != nil {
+		eerl.PerTriggerBurst = f.PerTriggerBurst
+	}
 }
 
 type ExternalRegistry struct {
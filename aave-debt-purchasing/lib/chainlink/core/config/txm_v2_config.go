@@ -0,0 +1,21 @@
+package config
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+)
+
+type TxmV2 interface {
+	FeePolicies() []TxmV2FeePolicy
+}
+
+type TxmV2FeePolicy interface {
+	// ChainID is nil if the policy applies to every chain.
+	ChainID() *big.Int
+	Priority() string
+	MaxFeeCap() *assets.Wei
+	BumpPercent() uint16
+	Deadline() time.Duration
+}
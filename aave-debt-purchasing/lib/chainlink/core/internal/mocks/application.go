@@ -1200,6 +1200,52 @@ func (_c *Application_PipelineORM_Call) RunAndReturn(run func() pipeline.ORM) *A
 	return _c
 }
 
+// ReloadConfig provides a mock function with given fields: cfg
+func (_m *Application) ReloadConfig(cfg chainlink.GeneralConfig) error {
+	ret := _m.Called(cfg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReloadConfig")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(chainlink.GeneralConfig) error); ok {
+		r0 = rf(cfg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_ReloadConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReloadConfig'
+type Application_ReloadConfig_Call struct {
+	*mock.Call
+}
+
+// ReloadConfig is a helper method to define mock.On call
+//   - cfg chainlink.GeneralConfig
+func (_e *Application_Expecter) ReloadConfig(cfg interface{}) *Application_ReloadConfig_Call {
+	return &Application_ReloadConfig_Call{Call: _e.mock.On("ReloadConfig", cfg)}
+}
+
+func (_c *Application_ReloadConfig_Call) Run(run func(cfg chainlink.GeneralConfig)) *Application_ReloadConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(chainlink.GeneralConfig))
+	})
+	return _c
+}
+
+func (_c *Application_ReloadConfig_Call) Return(_a0 error) *Application_ReloadConfig_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_ReloadConfig_Call) RunAndReturn(run func(chainlink.GeneralConfig) error) *Application_ReloadConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplayFromBlock provides a mock function with given fields: ctx, chainFamily, chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(ctx context.Context, chainFamily string, chainID string, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(ctx, chainFamily, chainID, number, forceBroadcast)
@@ -1508,6 +1554,76 @@ func (_c *Application_SetLogLevel_Call) RunAndReturn(run func(zapcore.Level) err
 	return _c
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jb, vars, simulated
+func (_m *Application) SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}, simulated pipeline.SimulatedResults) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, jb, vars, simulated)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}, pipeline.SimulatedResults) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, jb, vars, simulated)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}, pipeline.SimulatedResults) *pipeline.Run); ok {
+		r0 = rf(ctx, jb, vars, simulated)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, job.Job, map[string]interface{}, pipeline.SimulatedResults) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, jb, vars, simulated)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, job.Job, map[string]interface{}, pipeline.SimulatedResults) error); ok {
+		r2 = rf(ctx, jb, vars, simulated)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Application_SimulateJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateJobV2'
+type Application_SimulateJobV2_Call struct {
+	*mock.Call
+}
+
+// SimulateJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb job.Job
+//   - vars map[string]interface{}
+//   - simulated pipeline.SimulatedResults
+func (_e *Application_Expecter) SimulateJobV2(ctx interface{}, jb interface{}, vars interface{}, simulated interface{}) *Application_SimulateJobV2_Call {
+	return &Application_SimulateJobV2_Call{Call: _e.mock.On("SimulateJobV2", ctx, jb, vars, simulated)}
+}
+
+func (_c *Application_SimulateJobV2_Call) Run(run func(ctx context.Context, jb job.Job, vars map[string]interface{}, simulated pipeline.SimulatedResults)) *Application_SimulateJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.Job), args[2].(map[string]interface{}), args[3].(pipeline.SimulatedResults))
+	})
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) Return(_a0 *pipeline.Run, _a1 pipeline.TaskRunResults, _a2 error) *Application_SimulateJobV2_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) RunAndReturn(run func(context.Context, job.Job, map[string]interface{}, pipeline.SimulatedResults) (*pipeline.Run, pipeline.TaskRunResults, error)) *Application_SimulateJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -1646,6 +1762,55 @@ func (_c *Application_TxmStorageService_Call) RunAndReturn(run func() txmgr.EvmT
 	return _c
 }
 
+// UpdateJobV2 provides a mock function with given fields: ctx, jobID, _a2, spec
+func (_m *Application) UpdateJobV2(ctx context.Context, jobID int32, _a2 *job.Job, spec string) error {
+	ret := _m.Called(ctx, jobID, _a2, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJobV2")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, *job.Job, string) error); ok {
+		r0 = rf(ctx, jobID, _a2, spec)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_UpdateJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJobV2'
+type Application_UpdateJobV2_Call struct {
+	*mock.Call
+}
+
+// UpdateJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - _a2 *job.Job
+//   - spec string
+func (_e *Application_Expecter) UpdateJobV2(ctx interface{}, jobID interface{}, _a2 interface{}, spec interface{}) *Application_UpdateJobV2_Call {
+	return &Application_UpdateJobV2_Call{Call: _e.mock.On("UpdateJobV2", ctx, jobID, _a2, spec)}
+}
+
+func (_c *Application_UpdateJobV2_Call) Run(run func(ctx context.Context, jobID int32, _a2 *job.Job, spec string)) *Application_UpdateJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(*job.Job), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Application_UpdateJobV2_Call) Return(_a0 error) *Application_UpdateJobV2_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_UpdateJobV2_Call) RunAndReturn(run func(context.Context, int32, *job.Job, string) error) *Application_UpdateJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WakeSessionReaper provides a mock function with no fields
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
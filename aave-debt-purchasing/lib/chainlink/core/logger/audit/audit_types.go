@@ -51,11 +51,18 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	KeystoreBackedUp EventID = "KEYSTORE_BACKED_UP"
+	KeystoreRestored EventID = "KEYSTORE_RESTORED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthNoncesRepaired        EventID = "ETH_NONCES_REPAIRED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
 	JobCreated EventID = "JOB_CREATED"
+	JobUpdated EventID = "JOB_UPDATED"
+	JobPaused  EventID = "JOB_PAUSED"
+	JobResumed EventID = "JOB_RESUMED"
 	JobDeleted EventID = "JOB_DELETED"
 
 	ChainAdded       EventID = "CHAIN_ADDED"
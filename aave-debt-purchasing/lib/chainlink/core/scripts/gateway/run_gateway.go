@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"syscall"
 
 	"github.com/pelletier/go-toml/v2"
 
@@ -29,27 +30,24 @@ import (
 //	go run run_gateway.go --config sample_config_tls.toml
 //
 //	curl -X POST -d  '{"jsonrpc":"2.0","method":"test","id":"abcd","params":{"body":{"don_id":"example_don"}}}' https://localhost:8088/user -k
+//
+// Changes to the DONs of the config file are applied without a restart on SIGHUP:
+//
+//	kill -HUP <pid>
 func main() {
 	configFile := flag.String("config", "", "Path to TOML config file")
 	flag.Parse()
 
-	rawConfig, err := os.ReadFile(*configFile)
+	cfg, err := readConfig(*configFile)
 	if err != nil {
-		fmt.Println("error reading config:", err)
-		return
-	}
-
-	var cfg config.GatewayConfig
-	err = toml.Unmarshal(rawConfig, &cfg)
-	if err != nil {
-		fmt.Println("error parsing config:", err)
+		fmt.Println(err)
 		return
 	}
 
 	lggr, _ := logger.NewLogger()
 
 	handlerFactory := gateway.NewHandlerFactory(nil, nil, nil, lggr)
-	gw, err := gateway.NewGatewayFromConfig(&cfg, handlerFactory, lggr)
+	gw, err := gateway.NewGatewayFromConfig(cfg, handlerFactory, lggr)
 	if err != nil {
 		fmt.Println("error creating Gateway object:", err)
 		return
@@ -62,10 +60,40 @@ func main() {
 		return
 	}
 
-	<-ctx.Done()
+	hupCh := make(chan os.Signal, 1)
+	signal.Notify(hupCh, syscall.SIGHUP)
+	for done := false; !done; {
+		select {
+		case <-ctx.Done():
+			done = true
+		case <-hupCh:
+			cfg, err = readConfig(*configFile)
+			if err != nil {
+				fmt.Println(err)
+				break
+			}
+			if err = gw.UpdateConfig(ctx, cfg); err != nil {
+				fmt.Println("error updating gateway:", err)
+			}
+		}
+	}
 	err = gw.Close()
 	if err != nil {
 		fmt.Println("error closing gateway:", err)
 		return
 	}
 }
+
+func readConfig(configFile string) (*config.GatewayConfig, error) {
+	rawConfig, err := os.ReadFile(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config: %w", err)
+	}
+
+	var cfg config.GatewayConfig
+	err = toml.Unmarshal(rawConfig, &cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing config: %w", err)
+	}
+	return &cfg, nil
+}
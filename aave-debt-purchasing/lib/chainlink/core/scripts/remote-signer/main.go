@@ -0,0 +1,109 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+)
+
+type files []string
+
+func (f *files) String() string     { return strings.Join(*f, ",") }
+func (f *files) Set(v string) error { *f = append(*f, v); return nil }
+
+/*
+Usage:
+
+	chainlink keys eth export 0x... --new-password password.txt --output eth.json
+	chainlink keys csa export csa_... --new-password password.txt --output csa.json
+	go run main.go --password password.txt --token token.txt --tls-cert cert.pem --tls-key key.pem --eth eth.json --csa csa.json
+
+Then point the node to the signer with:
+
+	[RemoteSigner]
+	URL = 'https://127.0.0.1:6689'
+
+and the secret:
+
+	[RemoteSigner]
+	AuthToken = '<content of token.txt>'
+
+The node only accepts a plain http URL in dev mode, in which case the TLS flags can be left out.
+
+This is a reference implementation of the remote signer protocol, holding the keys in
+memory. It is not meant to run in production.
+*/
+func main() {
+	listen := flag.String("listen", "127.0.0.1:6689", "Address to listen on")
+	passwordFile := flag.String("password", "", "Path to the file containing the password of the exported keys")
+	tokenFile := flag.String("token", "", "Path to the file containing the auth token of the node")
+	tlsCert := flag.String("tls-cert", "", "Path to the TLS certificate, serves plain http if not set")
+	tlsKey := flag.String("tls-key", "", "Path to the TLS key")
+	var ethFiles, csaFiles, ocr2Files files
+	flag.Var(&ethFiles, "eth", "Path to an exported Eth key, can be repeated")
+	flag.Var(&csaFiles, "csa", "Path to an exported CSA key, can be repeated")
+	flag.Var(&ocr2Files, "ocr2", "Path to an exported OCR2 key bundle, can be repeated")
+	flag.Parse()
+
+	b, err := os.ReadFile(*passwordFile)
+	if err != nil {
+		log.Fatalf("failed to read password file: %v", err)
+	}
+	password := strings.TrimSpace(string(b))
+	token := strings.TrimSpace(string(mustReadFile(*tokenFile)))
+	if token == "" {
+		log.Fatal("the auth token must not be empty")
+	}
+
+	signer := remotesigner.NewReferenceSigner(token)
+	for _, f := range ethFiles {
+		key, err := keystore.DecryptKey(mustReadFile(f), password)
+		if err != nil {
+			log.Fatalf("failed to decrypt Eth key %s: %v", f, err)
+		}
+		ethKey := ethkey.FromPrivateKey(key.PrivateKey)
+		signer.AddEthKey(ethKey)
+		log.Printf("loaded Eth key %s", ethKey.ID())
+	}
+	for _, f := range csaFiles {
+		key, err := csakey.FromEncryptedJSON(mustReadFile(f), password)
+		if err != nil {
+			log.Fatalf("failed to decrypt CSA key %s: %v", f, err)
+		}
+		signer.AddCSAKey(key)
+		log.Printf("loaded CSA key %s", key.ID())
+	}
+	for _, f := range ocr2Files {
+		key, err := ocr2key.FromEncryptedJSON(mustReadFile(f), password)
+		if err != nil {
+			log.Fatalf("failed to decrypt OCR2 key bundle %s: %v", f, err)
+		}
+		signer.AddOCR2KeyBundle(key)
+		log.Printf("loaded OCR2 key bundle %s", key.ID())
+	}
+
+	log.Printf("listening on %s", *listen)
+	srv := &http.Server{Addr: *listen, Handler: signer, ReadHeaderTimeout: 10 * time.Second}
+	if *tlsCert != "" {
+		log.Fatal(srv.ListenAndServeTLS(*tlsCert, *tlsKey))
+	}
+	log.Fatal(srv.ListenAndServe())
+}
+
+func mustReadFile(path string) []byte {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("failed to read %s: %v", path, err)
+	}
+	return b
+}
@@ -64,6 +64,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
@@ -75,6 +76,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
@@ -84,6 +86,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/artifacts"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/budgets"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
 	workflowstore "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncer"
@@ -107,6 +110,8 @@ type Application interface {
 	GetDB() sqlutil.DataSource
 	GetConfig() GeneralConfig
 	SetLogLevel(lvl zapcore.Level) error
+	// ReloadConfig applies the settings of cfg that can be changed while the node is running.
+	ReloadConfig(cfg GeneralConfig) error
 	GetKeyStore() keystore.Master
 	WakeSessionReaper()
 	GetWebAuthnConfiguration() sessions.WebAuthnConfiguration
@@ -127,8 +132,10 @@ type Application interface {
 	TxmStorageService() txmgr.EvmTxStore
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
+	UpdateJobV2(ctx context.Context, jobID int32, job *job.Job, spec string) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}, simulated pipeline.SimulatedResults) (*pipeline.Run, pipeline.TaskRunResults, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -180,6 +187,7 @@ type ChainlinkApplication struct {
 	profiler                 *pyroscope.Profiler
 	loopRegistry             *plugins.LoopRegistry
 	loopRegistrarConfig      plugins.RegistrarConfig
+	workflowRateLimiter      *ratelimiter.RateLimiter
 
 	started     bool
 	startStopMu sync.Mutex
@@ -289,8 +297,15 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		opts.CapabilitiesRegistry = capabilities.NewRegistry(globalLogger)
 	}
 
-	csaKeystore := &keystore.CSASigner{CSA: keyStore.CSA()}
-	beholderAuthHeaders, csaPubKeyHex, err := keystore.BuildBeholderAuth(ctx, keyStore.CSA())
+	remoteSigner, err := NewRemoteSigner(cfg.RemoteSigner())
+	if err != nil {
+		return nil, err
+	}
+	if remoteSigner != nil {
+		globalLogger.Infow("Signing with the Eth, CSA and OCR2 onchain keys of the remote signer", "url", cfg.RemoteSigner().URL().Redacted())
+	}
+	csaKeystore := NewCSASigner(keyStore.CSA(), remoteSigner)
+	beholderAuthHeaders, csaPubKeyHex, err := keystore.BuildBeholderAuth(ctx, csaKeystore)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build Beholder auth: %w", err)
 	}
@@ -309,12 +324,15 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 
 	evmFactoryCfg := EVMFactoryConfig{
 		ChainOpts: legacyevm.ChainOpts{
-			ChainConfigs:   cfg.EVMConfigs(),
-			DatabaseConfig: cfg.Database(),
-			ListenerConfig: cfg.Database().Listener(),
-			FeatureConfig:  cfg.Feature(),
-			MailMon:        mailMon,
-			DS:             opts.DS,
+			ChainConfigs:      cfg.EVMConfigs(),
+			DatabaseConfig:    cfg.Database(),
+			ListenerConfig:    cfg.Database().Listener(),
+			FeatureConfig:     cfg.Feature(),
+			MailMon:           mailMon,
+			DS:                opts.DS,
+			NonceRepairConfig: cfg.NonceRepair(),
+			TxmV2Config:       cfg.TxmV2(),
+			RemoteSigner:      remoteSigner,
 		},
 		EthKeystore:   keyStore.Eth(),
 		CSAKeystore:   csaKeystore,
@@ -354,7 +372,11 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		return nil, err
 	}
 
-	creServices, err := newCREServices(ctx, globalLogger, opts.DS, keyStore, cfg.Capabilities(), cfg.Workflows(), relayChainInterops, opts.CREOpts)
+	// workflowORM is shared by the workflow delegate and the workflow registry syncer
+	workflowORM := workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock())
+	srvcs = append(srvcs, workflowORM)
+
+	creServices, err := newCREServices(ctx, globalLogger, opts.DS, keyStore, remoteSigner, cfg.Capabilities(), cfg.Workflows(), relayChainInterops, workflowORM, opts.CREOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initilize CRE: %w", err)
 	}
@@ -467,9 +489,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		jobORM         = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
 		txmORM         = txmgr.NewTxStore(opts.DS, globalLogger)
 		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
-		workflowORM    = workflowstore.NewInMemoryStore(globalLogger, clockwork.NewRealClock())
 	)
-	srvcs = append(srvcs, workflowORM)
 
 	promReporter := headreporter.NewPrometheusReporter(opts.DS, legacyEVMChains)
 	chainIDs := make([]*big.Int, legacyEVMChains.Len())
@@ -550,6 +570,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		workflowORM,
 		creServices.workflowRateLimiter,
 		creServices.workflowLimits,
+		creServices.workflowBudgets,
 	)
 
 	// Flux monitor requires ethereum just to boot, silence errors with a null delegate
@@ -634,6 +655,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 				Lggr:                  globalLogger,
 				Ks:                    keyStore.OCR2(),
 				EthKs:                 keyStore.Eth(),
+				RemoteSigner:          remoteSigner,
 				Relayers:              relayChainInterops,
 				MailMon:               mailMon,
 				CapabilitiesRegistry:  opts.CapabilitiesRegistry,
@@ -702,6 +724,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 			globalLogger,
 			opts.Version,
 			loopRegistrarConfig,
+			feeds.WithCSAKeystore(csaKeystore),
 		)
 	} else {
 		feedsService = &feeds.NullService{}
@@ -740,6 +763,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		profiler:                 profiler,
 		loopRegistry:             loopRegistry,
 		loopRegistrarConfig:      loopRegistrarConfig,
+		workflowRateLimiter:      creServices.workflowRateLimiter,
 
 		ds: opts.DS,
 
@@ -786,6 +810,9 @@ type CREServices struct {
 	// it will specify the amount of global an per owner workflows that can be registered
 	workflowLimits *syncerlimiter.Limits
 
+	// workflowBudgets caps the metered spend of workflows and their owners
+	workflowBudgets budgets.ORM
+
 	// gatewayConnectorWrapper is the wrapper for the gateway connector
 	// it is exposed because there are contingent services in the application
 	gatewayConnectorWrapper *gatewayconnector.ServiceWrapper
@@ -793,23 +820,33 @@ type CREServices struct {
 	srvs []services.ServiceCtx
 }
 
+func workflowRateLimiterConfig(cfg config.EngineExecutionRateLimit) ratelimiter.Config {
+	return ratelimiter.Config{
+		GlobalRPS:        cfg.GlobalRPS(),
+		GlobalBurst:      cfg.GlobalBurst(),
+		PerSenderRPS:     cfg.PerSenderRPS(),
+		PerSenderBurst:   cfg.PerSenderBurst(),
+		PerWorkflowRPS:   cfg.PerWorkflowRPS(),
+		PerWorkflowBurst: cfg.PerWorkflowBurst(),
+		PerTriggerRPS:    cfg.PerTriggerRPS(),
+		PerTriggerBurst:  cfg.PerTriggerBurst(),
+	}
+}
+
 func newCREServices(
 	ctx context.Context,
 	globalLogger logger.Logger,
 	ds sqlutil.DataSource,
 	keyStore creKeystore,
+	remoteSigner *remotesigner.Client,
 	capCfg config.Capabilities,
 	wCfg config.Workflows,
 	relayerChainInterops *CoreRelayerChainInteroperators,
+	workflowStore workflowstore.Store,
 	opts CREOpts,
 ) (*CREServices, error) {
 	var srvcs []services.ServiceCtx
-	workflowRateLimiter, err := ratelimiter.NewRateLimiter(ratelimiter.Config{
-		GlobalRPS:      capCfg.RateLimit().GlobalRPS(),
-		GlobalBurst:    capCfg.RateLimit().GlobalBurst(),
-		PerSenderRPS:   capCfg.RateLimit().PerSenderRPS(),
-		PerSenderBurst: capCfg.RateLimit().PerSenderBurst(),
-	})
+	workflowRateLimiter, err := ratelimiter.NewRateLimiter(workflowRateLimiterConfig(capCfg.RateLimit()))
 	if err != nil {
 		return nil, fmt.Errorf("could not instantiate workflow rate limiter: %w", err)
 	}
@@ -827,6 +864,8 @@ func newCREServices(
 		return nil, fmt.Errorf("could not instantiate workflow syncer limiter: %w", err)
 	}
 
+	workflowBudgets := budgets.NewORM(ds, globalLogger)
+
 	var gatewayConnectorWrapper *gatewayconnector.ServiceWrapper
 	if capCfg.GatewayConnector().DonID() != "" {
 		globalLogger.Debugw("Creating GatewayConnector wrapper", "donID", capCfg.GatewayConnector().DonID())
@@ -836,7 +875,7 @@ func newCREServices(
 		}
 		gatewayConnectorWrapper = gatewayconnector.NewGatewayConnectorServiceWrapper(
 			capCfg.GatewayConnector(),
-			keys.NewStore(keystore.NewEthSigner(keyStore.Eth(), chainID)),
+			keys.NewStore(evmrelay.NewEthSigner(keyStore.Eth(), remoteSigner, chainID)),
 			clockwork.NewRealClock(),
 			globalLogger)
 		srvcs = append(srvcs, gatewayConnectorWrapper)
@@ -930,12 +969,13 @@ func newCREServices(
 
 				eventHandler := syncer.NewEventHandler(
 					lggr,
-					workflowstore.NewInMemoryStore(lggr, clockwork.NewRealClock()),
+					workflowStore,
 					opts.CapabilitiesRegistry,
 					custmsg.NewLabeler(),
 					workflowRateLimiter,
 					workflowLimits,
 					artifactsStore,
+					syncer.WithBudgets(workflowBudgets),
 				)
 
 				globalLogger.Debugw("Creating WorkflowRegistrySyncer")
@@ -967,6 +1007,7 @@ func newCREServices(
 	return &CREServices{
 		workflowRateLimiter:     workflowRateLimiter,
 		workflowLimits:          workflowLimits,
+		workflowBudgets:         workflowBudgets,
 		gatewayConnectorWrapper: gatewayConnectorWrapper,
 		srvs:                    srvcs,
 	}, nil
@@ -980,6 +1021,19 @@ func (app *ChainlinkApplication) SetLogLevel(lvl zapcore.Level) error {
 	return nil
 }
 
+// ReloadConfig applies the workflow rate limits of cfg. Other settings require a restart.
+func (app *ChainlinkApplication) ReloadConfig(cfg GeneralConfig) error {
+	if app.workflowRateLimiter == nil {
+		return nil
+	}
+	if err := app.workflowRateLimiter.SetConfig(workflowRateLimiterConfig(cfg.Capabilities().RateLimit())); err != nil {
+		return fmt.Errorf("could not update workflow rate limits: %w", err)
+	}
+	app.logger.Infow("Reloaded workflow rate limits", "globalRPS", cfg.Capabilities().RateLimit().GlobalRPS(),
+		"perSenderRPS", cfg.Capabilities().RateLimit().PerSenderRPS())
+	return nil
+}
+
 // Start all necessary services. If successful, nil will be returned.
 // Start sequence is aborted if the context gets cancelled.
 func (app *ChainlinkApplication) Start(ctx context.Context) error {
@@ -1180,6 +1234,21 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 	return app.jobSpawner.DeleteJob(ctx, nil, jobID)
 }
 
+// UpdateJobV2 replaces the job jobID with jb, and records spec as its next version.
+func (app *ChainlinkApplication) UpdateJobV2(ctx context.Context, jobID int32, jb *job.Job, spec string) error {
+	// Do not allow the job to be updated if it is managed by the Feeds Manager
+	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
+	if err != nil {
+		return err
+	}
+
+	if isManaged {
+		return errors.New("job must be updated in the feeds manager")
+	}
+
+	return app.jobSpawner.UpdateJob(ctx, nil, jobID, jb, spec)
+}
+
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error) {
 	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
 }
@@ -1257,6 +1326,38 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(ctx, taskID, result.Value, result.Error)
 }
 
+// SimulateJobV2 runs the task graph of the given job without saving the run, and without
+// calling out of the node. Tasks take their results from simulated where present.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jb job.Job,
+	vars map[string]interface{},
+	simulated pipeline.SimulatedResults,
+) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	var spec pipeline.Spec
+	if jb.PipelineSpec != nil {
+		// The job was loaded from the DB along with its pipeline spec.
+		spec = *jb.PipelineSpec
+	} else {
+		// The job was parsed from TOML and not saved yet.
+		spec = pipeline.Spec{
+			DotDagSource:      jb.Pipeline.Source,
+			MaxTaskDuration:   jb.MaxTaskDuration,
+			ForwardingAllowed: jb.ForwardingAllowed,
+			JobID:             jb.ID,
+			JobName:           jb.Name.ValueOrZero(),
+			JobType:           string(jb.Type),
+		}
+		if jb.GasLimit.Valid {
+			spec.GasLimit = &jb.GasLimit.Uint32
+		}
+	}
+	if spec.DotDagSource == "" {
+		return nil, nil, errors.Errorf("%s jobs have no task graph to simulate", jb.Type)
+	}
+	return app.pipelineRunner.SimulateRun(ctx, spec, pipeline.NewVarsFrom(vars), simulated)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
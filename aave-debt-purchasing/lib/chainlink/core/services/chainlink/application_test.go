@@ -0,0 +1,48 @@
+package chainlink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
+)
+
+func TestChainlinkApplication_ReloadConfig(t *testing.T) {
+	rl, err := ratelimiter.NewRateLimiter(ratelimiter.Config{
+		GlobalRPS:      100.0,
+		GlobalBurst:    100,
+		PerSenderRPS:   100.0,
+		PerSenderBurst: 100,
+	})
+	require.NoError(t, err)
+	app := &ChainlinkApplication{
+		logger:              logger.Sugared(logger.TestLogger(t)),
+		workflowRateLimiter: rl,
+	}
+
+	key := ratelimiter.Key{Owner: "owner1", WorkflowID: "wf1", TriggerID: "wf_wf1_trigger_0"}
+	for range 2 {
+		allowed, _ := rl.AllowKey(key)
+		require.True(t, allowed)
+	}
+
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{`
+[Capabilities.RateLimit]
+PerTriggerRPS = 1.0
+PerTriggerBurst = 1
+`},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+	require.NoError(t, app.ReloadConfig(cfg))
+
+	allowed, _ := rl.AllowKey(key)
+	assert.True(t, allowed)
+	allowed, level := rl.AllowKey(key)
+	assert.False(t, allowed)
+	assert.Equal(t, ratelimiter.LevelTrigger, level)
+}
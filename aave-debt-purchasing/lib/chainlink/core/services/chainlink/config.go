@@ -408,6 +408,10 @@ func (s *Secrets) SetFrom(f *Secrets) (err error) {
 		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "Threshold"))
 	}
 
+	if err2 := s.RemoteSigner.SetFrom(&f.RemoteSigner); err2 != nil {
+		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "RemoteSigner"))
+	}
+
 	if err2 := s.EVM.SetFrom(&f.EVM); err2 != nil {
 		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "EthKeys"))
 	}
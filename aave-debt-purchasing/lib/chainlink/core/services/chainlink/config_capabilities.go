@@ -55,6 +55,22 @@ func (rl *engineExecutionRateLimit) PerSenderBurst() int {
 	return *rl.rl.PerSenderBurst
 }
 
+func (rl *engineExecutionRateLimit) PerWorkflowRPS() float64 {
+	return *rl.rl.PerWorkflowRPS
+}
+
+func (rl *engineExecutionRateLimit) PerWorkflowBurst() int {
+	return *rl.rl.PerWorkflowBurst
+}
+
+func (rl *engineExecutionRateLimit) PerTriggerRPS() float64 {
+	return *rl.rl.PerTriggerRPS
+}
+
+func (rl *engineExecutionRateLimit) PerTriggerBurst() int {
+	return *rl.rl.PerTriggerBurst
+}
+
 func (c *capabilitiesConfig) Dispatcher() config.Dispatcher {
 	return &dispatcher{d: c.c.Dispatcher}
 }
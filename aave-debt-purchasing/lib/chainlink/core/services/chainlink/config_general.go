@@ -511,6 +511,18 @@ func (g *generalConfig) Sentry() coreconfig.Sentry {
 	return sentryConfig{g.c.Sentry}
 }
 
+func (g *generalConfig) RemoteSigner() coreconfig.RemoteSigner {
+	return remoteSignerConfig{c: g.c.RemoteSigner, s: g.secrets.RemoteSigner}
+}
+
+func (g *generalConfig) NonceRepair() coreconfig.NonceRepair {
+	return nonceRepairConfig{g.c.NonceRepair}
+}
+
+func (g *generalConfig) TxmV2() coreconfig.TxmV2 {
+	return txmV2Config{g.c.TxmV2}
+}
+
 func (g *generalConfig) Password() coreconfig.Password {
 	return &passwordConfig{keystore: g.keystorePassword, vrf: g.vrfPassword}
 }
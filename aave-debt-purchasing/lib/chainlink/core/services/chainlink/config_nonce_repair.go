@@ -0,0 +1,27 @@
+package chainlink
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+type nonceRepairConfig struct {
+	c toml.NonceRepair
+}
+
+func (n nonceRepairConfig) Enabled() bool {
+	return *n.c.Enabled
+}
+
+func (n nonceRepairConfig) AutoRepair() bool {
+	return *n.c.AutoRepair
+}
+
+func (n nonceRepairConfig) PollPeriod() time.Duration {
+	return n.c.PollPeriod.Duration()
+}
+
+func (n nonceRepairConfig) StuckThreshold() time.Duration {
+	return n.c.StuckThreshold.Duration()
+}
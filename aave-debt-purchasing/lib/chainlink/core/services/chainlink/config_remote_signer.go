@@ -0,0 +1,31 @@
+package chainlink
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+type remoteSignerConfig struct {
+	c toml.RemoteSigner
+	s toml.RemoteSignerSecrets
+}
+
+func (r remoteSignerConfig) URL() *url.URL {
+	if r.c.URL.IsZero() {
+		return nil
+	}
+	return r.c.URL.URL()
+}
+
+func (r remoteSignerConfig) Timeout() time.Duration {
+	return r.c.Timeout.Duration()
+}
+
+func (r remoteSignerConfig) AuthToken() string {
+	if r.s.AuthToken == nil {
+		return ""
+	}
+	return string(*r.s.AuthToken)
+}
@@ -453,10 +453,14 @@ func TestConfig_Marshal(t *testing.T) {
 	}
 	full.Capabilities = toml.Capabilities{
 		RateLimit: toml.EngineExecutionRateLimit{
-			GlobalRPS:      ptr(200.00),
-			GlobalBurst:    ptr(200),
-			PerSenderRPS:   ptr(200.0),
-			PerSenderBurst: ptr(200),
+			GlobalRPS:        ptr(200.00),
+			GlobalBurst:      ptr(200),
+			PerSenderRPS:     ptr(200.0),
+			PerSenderBurst:   ptr(200),
+			PerWorkflowRPS:   ptr(100.0),
+			PerWorkflowBurst: ptr(100),
+			PerTriggerRPS:    ptr(10.0),
+			PerTriggerBurst:  ptr(10),
 		},
 
 		Peering: toml.P2P{
@@ -556,6 +560,25 @@ func TestConfig_Marshal(t *testing.T) {
 		Environment: ptr("dev"),
 		Release:     ptr("v1.2.3"),
 	}
+	full.RemoteSigner = toml.RemoteSigner{
+		URL:     mustURL("https://signer.example.com"),
+		Timeout: commoncfg.MustNewDuration(3 * time.Second),
+	}
+	full.NonceRepair = toml.NonceRepair{
+		Enabled:        ptr(true),
+		AutoRepair:     ptr(true),
+		PollPeriod:     commoncfg.MustNewDuration(30 * time.Second),
+		StuckThreshold: commoncfg.MustNewDuration(5 * time.Minute),
+	}
+	full.TxmV2 = toml.TxmV2{
+		FeePolicies: []toml.TxmV2FeePolicy{{
+			ChainID:     ubig.NewI(1),
+			Priority:    ptr("urgent"),
+			MaxFeeCap:   assets.GWei(200),
+			BumpPercent: ptr[uint16](20),
+			Deadline:    commoncfg.MustNewDuration(time.Minute),
+		}},
+	}
 	full.Telemetry = toml.Telemetry{
 		Enabled:               ptr(true),
 		CACertFile:            ptr("cert-file"),
@@ -1060,6 +1083,24 @@ Debug = true
 DSN = 'sentry-dsn'
 Environment = 'dev'
 Release = 'v1.2.3'
+`},
+		{"RemoteSigner", Config{Core: toml.Core{RemoteSigner: full.RemoteSigner}}, `[RemoteSigner]
+URL = 'https://signer.example.com'
+Timeout = '3s'
+`},
+		{"NonceRepair", Config{Core: toml.Core{NonceRepair: full.NonceRepair}}, `[NonceRepair]
+Enabled = true
+AutoRepair = true
+PollPeriod = '30s'
+StuckThreshold = '5m0s'
+`},
+		{"TxmV2", Config{Core: toml.Core{TxmV2: full.TxmV2}}, `[TxmV2]
+[[TxmV2.FeePolicies]]
+ChainID = '1'
+Priority = 'urgent'
+MaxFeeCap = '200 gwei'
+BumpPercent = 20
+Deadline = '1m0s'
 `},
 		{"EVM", Config{EVM: full.EVM}, `[[EVM]]
 ChainID = '1'
@@ -0,0 +1,56 @@
+package chainlink
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+type txmV2Config struct {
+	c toml.TxmV2
+}
+
+func (t txmV2Config) FeePolicies() []config.TxmV2FeePolicy {
+	policies := make([]config.TxmV2FeePolicy, len(t.c.FeePolicies))
+	for i, p := range t.c.FeePolicies {
+		policies[i] = txmV2FeePolicyConfig{p}
+	}
+	return policies
+}
+
+type txmV2FeePolicyConfig struct {
+	c toml.TxmV2FeePolicy
+}
+
+func (t txmV2FeePolicyConfig) ChainID() *big.Int {
+	if t.c.ChainID == nil {
+		return nil
+	}
+	return t.c.ChainID.ToInt()
+}
+
+func (t txmV2FeePolicyConfig) Priority() string {
+	return *t.c.Priority
+}
+
+func (t txmV2FeePolicyConfig) MaxFeeCap() *assets.Wei {
+	return t.c.MaxFeeCap
+}
+
+func (t txmV2FeePolicyConfig) BumpPercent() uint16 {
+	if t.c.BumpPercent == nil {
+		return 0
+	}
+	return *t.c.BumpPercent
+}
+
+func (t txmV2FeePolicyConfig) Deadline() time.Duration {
+	if t.c.Deadline == nil {
+		return 0
+	}
+	return t.c.Deadline.Duration()
+}
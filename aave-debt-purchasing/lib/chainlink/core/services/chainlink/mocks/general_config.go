@@ -1145,6 +1145,51 @@ func (_c *GeneralConfig_Mercury_Call) RunAndReturn(run func() config.Mercury) *G
 	return _c
 }
 
+// NonceRepair provides a mock function with no fields
+func (_m *GeneralConfig) NonceRepair() config.NonceRepair {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NonceRepair")
+	}
+
+	var r0 config.NonceRepair
+	if rf, ok := ret.Get(0).(func() config.NonceRepair); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(config.NonceRepair)
+	}
+
+	return r0
+}
+
+// GeneralConfig_NonceRepair_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NonceRepair'
+type GeneralConfig_NonceRepair_Call struct {
+	*mock.Call
+}
+
+// NonceRepair is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) NonceRepair() *GeneralConfig_NonceRepair_Call {
+	return &GeneralConfig_NonceRepair_Call{Call: _e.mock.On("NonceRepair")}
+}
+
+func (_c *GeneralConfig_NonceRepair_Call) Run(run func()) *GeneralConfig_NonceRepair_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_NonceRepair_Call) Return(_a0 config.NonceRepair) *GeneralConfig_NonceRepair_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_NonceRepair_Call) RunAndReturn(run func() config.NonceRepair) *GeneralConfig_NonceRepair_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OCR provides a mock function with no fields
 func (_m *GeneralConfig) OCR() config.OCR {
 	ret := _m.Called()
@@ -1427,6 +1472,53 @@ func (_c *GeneralConfig_Pyroscope_Call) RunAndReturn(run func() config.Pyroscope
 	return _c
 }
 
+// RemoteSigner provides a mock function with no fields
+func (_m *GeneralConfig) RemoteSigner() config.RemoteSigner {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoteSigner")
+	}
+
+	var r0 config.RemoteSigner
+	if rf, ok := ret.Get(0).(func() config.RemoteSigner); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.RemoteSigner)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_RemoteSigner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoteSigner'
+type GeneralConfig_RemoteSigner_Call struct {
+	*mock.Call
+}
+
+// RemoteSigner is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) RemoteSigner() *GeneralConfig_RemoteSigner_Call {
+	return &GeneralConfig_RemoteSigner_Call{Call: _e.mock.On("RemoteSigner")}
+}
+
+func (_c *GeneralConfig_RemoteSigner_Call) Run(run func()) *GeneralConfig_RemoteSigner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_RemoteSigner_Call) Return(_a0 config.RemoteSigner) *GeneralConfig_RemoteSigner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_RemoteSigner_Call) RunAndReturn(run func() config.RemoteSigner) *GeneralConfig_RemoteSigner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RootDir provides a mock function with no fields
 func (_m *GeneralConfig) RootDir() string {
 	ret := _m.Called()
@@ -2141,6 +2233,53 @@ func (_c *GeneralConfig_TronEnabled_Call) RunAndReturn(run func() bool) *General
 	return _c
 }
 
+// TxmV2 provides a mock function with no fields
+func (_m *GeneralConfig) TxmV2() config.TxmV2 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TxmV2")
+	}
+
+	var r0 config.TxmV2
+	if rf, ok := ret.Get(0).(func() config.TxmV2); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.TxmV2)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_TxmV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TxmV2'
+type GeneralConfig_TxmV2_Call struct {
+	*mock.Call
+}
+
+// TxmV2 is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) TxmV2() *GeneralConfig_TxmV2_Call {
+	return &GeneralConfig_TxmV2_Call{Call: _e.mock.On("TxmV2")}
+}
+
+func (_c *GeneralConfig_TxmV2_Call) Run(run func()) *GeneralConfig_TxmV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_TxmV2_Call) Return(_a0 config.TxmV2) *GeneralConfig_TxmV2_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_TxmV2_Call) RunAndReturn(run func() config.TxmV2) *GeneralConfig_TxmV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Validate provides a mock function with no fields
 func (_m *GeneralConfig) Validate() error {
 	ret := _m.Called()
@@ -76,7 +76,7 @@ func (r *RelayerFactory) NewEVM(config EVMFactoryConfig) (map[types.RelayID]evmr
 		relayerOpts := evmrelay.RelayerOpts{
 			DS:                    config.DS,
 			Registerer:            r.Registerer,
-			EVMKeystore:           newChainStore(keystore.NewEthSigner(config.EthKeystore, chain.ID()), chain.ID()),
+			EVMKeystore:           newChainStore(evmrelay.NewEthSigner(config.EthKeystore, config.RemoteSigner, chain.ID()), chain.ID()),
 			CSAKeystore:           config.CSAKeystore,
 			MercuryPool:           r.MercuryPool,
 			MercuryConfig:         config.MercuryConfig,
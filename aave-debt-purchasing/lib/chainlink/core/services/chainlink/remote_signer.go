@@ -0,0 +1,36 @@
+package chainlink
+
+import (
+	"fmt"
+
+	coretypes "github.com/smartcontractkit/chainlink-common/pkg/types/core"
+
+	"github.com/smartcontractkit/chainlink/v2/core/build"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+)
+
+// NewRemoteSigner returns the client of the remote signer configured by cfg, or nil if the
+// node signs with its own keystore. The signer must be served over https, except in dev
+// mode and tests.
+func NewRemoteSigner(cfg config.RemoteSigner) (*remotesigner.Client, error) {
+	signerURL := cfg.URL()
+	if signerURL == nil {
+		return nil, nil
+	}
+	client, err := remotesigner.NewClient(signerURL.String(), cfg.AuthToken(), cfg.Timeout(), build.IsDev() || build.IsTest())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create remote signer client: %w", err)
+	}
+	return client, nil
+}
+
+// NewCSASigner returns the signer of the CSA key of the node, which is held by remoteSigner
+// if it is set, and by ks otherwise.
+func NewCSASigner(ks keystore.CSA, remoteSigner *remotesigner.Client) coretypes.Keystore {
+	if remoteSigner != nil {
+		return remoteSigner.Keystore(remotesigner.KeyTypeCSA, nil)
+	}
+	return &keystore.CSASigner{CSA: ks}
+}
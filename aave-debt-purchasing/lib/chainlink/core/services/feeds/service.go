@@ -133,7 +133,7 @@ type service struct {
 	orm                 ORM
 	jobORM              job.ORM
 	ds                  sqlutil.DataSource
-	csaKeyStore         keystore.CSA
+	csaKeyStore         core.Keystore
 	csaSigner           *core.Ed25519Signer
 	p2pKeyStore         keystore.P2P
 	ocr1KeyStore        keystore.OCR
@@ -183,7 +183,7 @@ func NewService(
 		ds:                  ds,
 		jobSpawner:          jobSpawner,
 		p2pKeyStore:         keyStore.P2P(),
-		csaKeyStore:         keyStore.CSA(),
+		csaKeyStore:         keystore.CSASigner{CSA: keyStore.CSA()},
 		ocr1KeyStore:        keyStore.OCR(),
 		ocr2KeyStore:        keyStore.OCR2(),
 		workflowKeyStore:    keyStore.Workflow(),
@@ -1168,11 +1168,8 @@ func (s *service) UpdateSpecDefinition(ctx context.Context, id int64, defn strin
 // Start starts the service.
 func (s *service) Start(ctx context.Context) error {
 	return s.StartOnce("FeedsService", func() error {
-		key, err := keystore.GetDefault(ctx, s.csaKeyStore)
-		if err != nil {
-			return err
-		}
-		s.csaSigner, err = core.NewEd25519Signer(key.ID(), keystore.CSASigner{CSA: s.csaKeyStore}.Sign)
+		var err error
+		s.csaSigner, err = keystore.NewCSAEd25519Signer(ctx, s.csaKeyStore)
 		if err != nil {
 			return err
 		}
@@ -1647,6 +1644,12 @@ func WithSyncMaxAttempts(attempts uint) ServiceOption {
 	return func(s *service) { s.syncMaxAttempts = attempts }
 }
 
+// WithCSAKeystore sets the signer of the CSA key authenticating the node to the feeds managers,
+// which is the CSA keystore of the node by default.
+func WithCSAKeystore(ks core.Keystore) ServiceOption {
+	return func(s *service) { s.csaKeyStore = ks }
+}
+
 var _ Service = &NullService{}
 
 // NullService defines an implementation of the Feeds Service that is used
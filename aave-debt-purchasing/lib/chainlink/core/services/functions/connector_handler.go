@@ -56,6 +56,13 @@ type functionsConnectorHandler struct {
 
 const HeartbeatCacheSize = 1000
 
+const (
+	// MaxSecretsWatchDuration bounds the duration of secrets_watch requests.
+	MaxSecretsWatchDuration = 10 * time.Minute
+	// maxSecretsWatchBatch is the maximum number of events sent to the gateway in one message.
+	maxSecretsWatchBatch = 100
+)
+
 var (
 	_ connector.Signer                  = &functionsConnectorHandler{}
 	_ connector.GatewayConnectorHandler = &functionsConnectorHandler{}
@@ -150,6 +157,8 @@ func (h *functionsConnectorHandler) HandleGatewayMessage(ctx context.Context, ga
 		h.handleSecretsSet(ctx, gatewayId, body, fromAddr)
 	case functions.MethodHeartbeat:
 		h.handleHeartbeat(ctx, gatewayId, body, fromAddr)
+	case functions.MethodSecretsWatch:
+		h.handleSecretsWatch(ctx, gatewayId, body, fromAddr)
 	default:
 		h.lggr.Errorw("unsupported method", "id", gatewayId, "method", body.Method)
 	}
@@ -226,6 +235,74 @@ func (h *functionsConnectorHandler) handleSecretsSet(ctx context.Context, gatewa
 	h.sendResponseAndLog(ctx, gatewayId, body, response)
 }
 
+func (h *functionsConnectorHandler) handleSecretsWatch(ctx context.Context, gatewayId string, body *api.MessageBody, fromAddr ethCommon.Address) {
+	var request functions.SecretsWatchRequest
+	if err := json.Unmarshal(body.Payload, &request); err != nil {
+		h.sendSecretsWatchEnd(ctx, gatewayId, body, fmt.Sprintf("Bad request to watch secrets: %v", err))
+		return
+	}
+	duration := time.Duration(request.DurationMillis) * time.Millisecond
+	if duration <= 0 || duration > MaxSecretsWatchDuration {
+		h.sendSecretsWatchEnd(ctx, gatewayId, body, fmt.Sprintf("Watch duration must be positive and at most %s", MaxSecretsWatchDuration))
+		return
+	}
+	watchCtx, cancel := h.chStop.CtxWithTimeout(duration)
+	events, err := h.storage.Watch(watchCtx, fromAddr, request.SlotIDs)
+	if err != nil {
+		cancel()
+		h.sendSecretsWatchEnd(ctx, gatewayId, body, fmt.Sprintf("Failed to watch secrets: %v", err))
+		return
+	}
+	h.lggr.Debugw("handling a secrets_watch request", "address", fromAddr, "slotIds", request.SlotIDs, "duration", duration)
+	requestBody := *body
+	h.shutdownWaitGroup.Add(1)
+	go func() {
+		defer h.shutdownWaitGroup.Done()
+		defer cancel()
+		h.watchSecrets(watchCtx, gatewayId, &requestBody, events)
+	}()
+}
+
+// watchSecrets sends the events to the gateway until the watch ends.
+func (h *functionsConnectorHandler) watchSecrets(ctx context.Context, gatewayId string, body *api.MessageBody, events <-chan s4.Event) {
+	for event := range events {
+		response := functions.SecretsWatchResponse{
+			ResponseBase: functions.ResponseBase{Success: true},
+			Events:       []functions.SecretsWatchEvent{newSecretsWatchEvent(event)},
+		}
+		// events received meanwhile are sent together
+		for len(events) > 0 && len(response.Events) < maxSecretsWatchBatch {
+			response.Events = append(response.Events, newSecretsWatchEvent(<-events))
+		}
+		h.sendResponseAndLog(ctx, gatewayId, body, response)
+	}
+	sendCtx, cancel := h.chStop.NewCtx()
+	defer cancel()
+	if ctx.Err() == nil {
+		h.sendSecretsWatchEnd(sendCtx, gatewayId, body, "Watch fell behind, secrets need to be listed to catch up")
+		return
+	}
+	h.sendSecretsWatchEnd(sendCtx, gatewayId, body, "")
+}
+
+// sendSecretsWatchEnd ends the watch, successfully if errorMessage is empty.
+func (h *functionsConnectorHandler) sendSecretsWatchEnd(ctx context.Context, gatewayId string, body *api.MessageBody, errorMessage string) {
+	response := functions.SecretsWatchResponse{
+		ResponseBase: functions.ResponseBase{Success: errorMessage == "", ErrorMessage: errorMessage},
+		Done:         true,
+	}
+	h.sendResponseAndLog(ctx, gatewayId, body, response)
+}
+
+func newSecretsWatchEvent(event s4.Event) functions.SecretsWatchEvent {
+	return functions.SecretsWatchEvent{
+		Type:       string(event.Type),
+		SlotID:     event.Key.SlotId,
+		Version:    event.Key.Version,
+		Expiration: event.Expiration,
+	}
+}
+
 func (h *functionsConnectorHandler) handleHeartbeat(ctx context.Context, gatewayId string, requestBody *api.MessageBody, fromAddr ethCommon.Address) {
 	var request *OffchainRequest
 	err := json.Unmarshal(requestBody.Payload, &request)
@@ -1,6 +1,7 @@
 package functions_test
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
@@ -247,6 +248,64 @@ func TestFunctionsConnectorHandler(t *testing.T) {
 			})
 		})
 
+		t.Run("secrets_watch", func(t *testing.T) {
+			msg := api.Message{
+				Body: api.MessageBody{
+					DonId:     "fun4",
+					MessageId: "1",
+					Method:    "secrets_watch",
+					Sender:    addr.Hex(),
+					Payload:   json.RawMessage(`{"slot_ids":[1],"duration_millis":100}`),
+				},
+			}
+			require.NoError(t, msg.Sign(privateKey))
+
+			ctx := testutils.Context(t)
+			events := make(chan s4.Event, 2)
+			events <- s4.Event{Type: s4.EventUpdated, Key: s4.Key{Address: addr, SlotId: 1, Version: 2}, Expiration: 3}
+			events <- s4.Event{Type: s4.EventConfirmed, Key: s4.Key{Address: addr, SlotId: 1, Version: 2}, Expiration: 3}
+			storage.On("Watch", mock.Anything, addr, []uint{1}).Run(func(args mock.Arguments) {
+				watchCtx := args.Get(0).(context.Context)
+				go func() {
+					<-watchCtx.Done()
+					close(events)
+				}()
+			}).Return((<-chan s4.Event)(events), nil).Once()
+			allowlist.On("Allow", addr).Return(true).Once()
+			payloads := make(chan string, 2)
+			connector.On("SendToGateway", mock.Anything, "gw1", mock.Anything).Run(func(args mock.Arguments) {
+				msg, ok := args[2].(*api.Message)
+				require.True(t, ok)
+				payloads <- string(msg.Body.Payload)
+			}).Return(nil).Twice()
+
+			handler.HandleGatewayMessage(ctx, "gw1", &msg)
+			require.JSONEq(t, `{"success":true,"events":[{"type":"updated","slot_id":1,"version":2,"expiration":3},{"type":"confirmed","slot_id":1,"version":2,"expiration":3}]}`, <-payloads)
+			require.JSONEq(t, `{"success":true,"done":true}`, <-payloads)
+
+			t.Run("invalid duration", func(t *testing.T) {
+				msg := api.Message{
+					Body: api.MessageBody{
+						DonId:     "fun4",
+						MessageId: "1",
+						Method:    "secrets_watch",
+						Sender:    addr.Hex(),
+						Payload:   json.RawMessage(`{"duration_millis":0}`),
+					},
+				}
+				require.NoError(t, msg.Sign(privateKey))
+
+				allowlist.On("Allow", addr).Return(true).Once()
+				connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
+					msg, ok := args[2].(*api.Message)
+					require.True(t, ok)
+					require.JSONEq(t, `{"success":false,"error_message":"Watch duration must be positive and at most 10m0s","done":true}`, string(msg.Body.Payload))
+				}).Return(nil).Once()
+
+				handler.HandleGatewayMessage(ctx, "gw1", &msg)
+			})
+		})
+
 		t.Run("unsupported method", func(t *testing.T) {
 			msg := api.Message{
 				Body: api.MessageBody{
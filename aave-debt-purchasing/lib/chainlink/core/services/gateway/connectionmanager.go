@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"maps"
 	"strings"
 	"sync"
 	"time"
@@ -38,6 +39,11 @@ type ConnectionManager interface {
 	network.ConnectionAcceptor
 
 	DONConnectionManager(donId string) *donConnectionManager
+	// UpdateDON applies the membership of donConfig to its DON, adding the DON if it does not exist.
+	// Connections of nodes remaining members are kept. Can only be called once started.
+	UpdateDON(ctx context.Context, donConfig *config.DONConfig) (*donConnectionManager, error)
+	// RemoveDON closes the connections of a DON and removes it. Can only be called once started.
+	RemoveDON(donId string) error
 	GetPort() int
 }
 
@@ -46,18 +52,24 @@ type connectionManager struct {
 
 	config             *config.ConnectionManagerConfig
 	dons               map[string]*donConnectionManager
+	donsMu             sync.RWMutex
+	codec              api.Codec
 	wsServer           network.WebSocketServer
 	clock              clockwork.Clock
 	connAttempts       map[string]*connAttempt
 	connAttemptCounter uint64
 	connAttemptsMu     sync.Mutex
-	lggr               logger.Logger
+	// donLggr is the parent logger of DONs and node connections
+	donLggr logger.Logger
+	lggr    logger.Logger
 }
 
 func (m *connectionManager) HealthReport() map[string]error {
 	hr := map[string]error{m.Name(): m.Healthy()}
+	m.donsMu.RLock()
+	defer m.donsMu.RUnlock()
 	for _, d := range m.dons {
-		for _, n := range d.nodes {
+		for _, n := range d.getNodes() {
 			services.CopyHealth(hr, n.conn.HealthReport())
 		}
 	}
@@ -70,20 +82,25 @@ type donConnectionManager struct {
 	donConfig  *config.DONConfig
 	nodes      map[string]*nodeState
 	handler    handlers.Handler
+	mu         sync.RWMutex // guards donConfig, nodes and handler
 	codec      api.Codec
 	closeWait  sync.WaitGroup
 	shutdownCh services.StopChan
 	lggr       logger.Logger
 }
 
+// immutable
 type nodeState struct {
 	name string
 	conn network.WSConnectionWrapper
+	// stopCh stops the read loop of a node removed from its DON
+	stopCh services.StopChan
 }
 
 // immutable
 type connAttempt struct {
 	nodeState   *nodeState
+	donId       string
 	nodeAddress string
 	challenge   network.ChallengeElems
 	timestamp   uint32
@@ -94,42 +111,23 @@ func NewConnectionManager(gwConfig *config.GatewayConfig, clock clockwork.Clock,
 	dons := make(map[string]*donConnectionManager)
 	for _, donConfig := range gwConfig.Dons {
 		donConfig := donConfig
-		if donConfig.DonId == "" {
-			return nil, errors.New("empty DON ID")
-		}
 		_, ok := dons[donConfig.DonId]
 		if ok {
 			return nil, fmt.Errorf("duplicate DON ID %s", donConfig.DonId)
 		}
-		nodes := make(map[string]*nodeState)
-		for _, nodeConfig := range donConfig.Members {
-			nodeAddress := strings.ToLower(nodeConfig.Address)
-			_, ok := nodes[nodeAddress]
-			if ok {
-				return nil, fmt.Errorf("duplicate node address %s in DON %s", nodeAddress, donConfig.DonId)
-			}
-			connWrapper := network.NewWSConnectionWrapper(lggr)
-			if connWrapper == nil {
-				return nil, fmt.Errorf("error creating WSConnectionWrapper for node %s", nodeAddress)
-			}
-			nodes[nodeAddress] = &nodeState{
-				name: nodeConfig.Name,
-				conn: connWrapper,
-			}
-		}
-		dons[donConfig.DonId] = &donConnectionManager{
-			donConfig:  &donConfig,
-			codec:      codec,
-			nodes:      nodes,
-			shutdownCh: make(chan struct{}),
-			lggr:       lggr.Named("DONConnectionManager." + donConfig.DonId),
+		donConnMgr, err := newDONConnectionManager(&donConfig, codec, lggr)
+		if err != nil {
+			return nil, err
 		}
+		dons[donConfig.DonId] = donConnMgr
 	}
 	connMgr := &connectionManager{
 		config:       &gwConfig.ConnectionManagerConfig,
 		dons:         dons,
+		codec:        codec,
 		connAttempts: make(map[string]*connAttempt),
 		clock:        clock,
+		donLggr:      lggr,
 		lggr:         lggr.Named("ConnectionManager"),
 	}
 	wsServer := network.NewWebSocketServer(&gwConfig.NodeServerConfig, connMgr, lggr)
@@ -137,23 +135,59 @@ func NewConnectionManager(gwConfig *config.GatewayConfig, clock clockwork.Clock,
 	return connMgr, nil
 }
 
+func newDONConnectionManager(donConfig *config.DONConfig, codec api.Codec, lggr logger.Logger) (*donConnectionManager, error) {
+	if donConfig.DonId == "" {
+		return nil, errors.New("empty DON ID")
+	}
+	nodes := make(map[string]*nodeState)
+	for _, nodeConfig := range donConfig.Members {
+		nodeAddress := strings.ToLower(nodeConfig.Address)
+		_, ok := nodes[nodeAddress]
+		if ok {
+			return nil, fmt.Errorf("duplicate node address %s in DON %s", nodeAddress, donConfig.DonId)
+		}
+		nodeState, err := newNodeState(nodeConfig.Name, nodeAddress, lggr)
+		if err != nil {
+			return nil, err
+		}
+		nodes[nodeAddress] = nodeState
+	}
+	return &donConnectionManager{
+		donConfig:  donConfig,
+		codec:      codec,
+		nodes:      nodes,
+		shutdownCh: make(chan struct{}),
+		lggr:       lggr.Named("DONConnectionManager." + donConfig.DonId),
+	}, nil
+}
+
+func newNodeState(name string, nodeAddress string, lggr logger.Logger) (*nodeState, error) {
+	connWrapper := network.NewWSConnectionWrapper(lggr)
+	if connWrapper == nil {
+		return nil, fmt.Errorf("error creating WSConnectionWrapper for node %s", nodeAddress)
+	}
+	return &nodeState{
+		name:   name,
+		conn:   connWrapper,
+		stopCh: make(chan struct{}),
+	}, nil
+}
+
 func (m *connectionManager) DONConnectionManager(donId string) *donConnectionManager {
+	m.donsMu.RLock()
+	defer m.donsMu.RUnlock()
 	return m.dons[donId]
 }
 
 func (m *connectionManager) Start(ctx context.Context) error {
 	return m.StartOnce("ConnectionManager", func() error {
 		m.lggr.Info("starting connection manager")
+		m.donsMu.RLock()
+		defer m.donsMu.RUnlock()
 		for _, donConnMgr := range m.dons {
-			donConnMgr.closeWait.Add(len(donConnMgr.nodes))
-			for nodeAddress, nodeState := range donConnMgr.nodes {
-				if err := nodeState.conn.Start(ctx); err != nil {
-					return err
-				}
-				go donConnMgr.readLoop(nodeAddress, nodeState)
+			if err := donConnMgr.start(ctx, m.config.HeartbeatIntervalSec); err != nil {
+				return err
 			}
-			donConnMgr.closeWait.Add(1)
-			go donConnMgr.keepaliveLoop(m.config.HeartbeatIntervalSec)
 		}
 		return m.wsServer.Start(ctx)
 	})
@@ -163,11 +197,10 @@ func (m *connectionManager) Close() error {
 	return m.StopOnce("ConnectionManager", func() (err error) {
 		m.lggr.Info("closing connection manager")
 		err = multierr.Combine(err, m.wsServer.Close())
+		m.donsMu.RLock()
+		defer m.donsMu.RUnlock()
 		for _, donConnMgr := range m.dons {
-			close(donConnMgr.shutdownCh)
-			for _, nodeState := range donConnMgr.nodes {
-				nodeState.conn.Close()
-			}
+			donConnMgr.close()
 		}
 		for _, donConnMgr := range m.dons {
 			donConnMgr.closeWait.Wait()
@@ -176,6 +209,47 @@ func (m *connectionManager) Close() error {
 	})
 }
 
+func (m *connectionManager) UpdateDON(ctx context.Context, donConfig *config.DONConfig) (*donConnectionManager, error) {
+	if err := m.Ready(); err != nil {
+		return nil, err
+	}
+	m.donsMu.Lock()
+	defer m.donsMu.Unlock()
+	donConnMgr, ok := m.dons[donConfig.DonId]
+	if ok {
+		return donConnMgr, donConnMgr.updateMembers(ctx, donConfig, m.donLggr)
+	}
+	donConnMgr, err := newDONConnectionManager(donConfig, m.codec, m.donLggr)
+	if err != nil {
+		return nil, err
+	}
+	if err = donConnMgr.start(ctx, m.config.HeartbeatIntervalSec); err != nil {
+		donConnMgr.close()
+		donConnMgr.closeWait.Wait()
+		return nil, err
+	}
+	m.dons[donConfig.DonId] = donConnMgr
+	m.lggr.Infow("added DON", "donID", donConfig.DonId)
+	return donConnMgr, nil
+}
+
+func (m *connectionManager) RemoveDON(donId string) error {
+	if err := m.Ready(); err != nil {
+		return err
+	}
+	m.donsMu.Lock()
+	donConnMgr, ok := m.dons[donId]
+	delete(m.dons, donId)
+	m.donsMu.Unlock()
+	if !ok {
+		return fmt.Errorf("DON %s not found", donId)
+	}
+	donConnMgr.close()
+	donConnMgr.closeWait.Wait()
+	m.lggr.Infow("removed DON", "donID", donId)
+	return nil
+}
+
 func (m *connectionManager) StartHandshake(authHeader []byte) (attemptId string, challenge []byte, err error) {
 	m.lggr.Debug("StartHandshake")
 	authHeaderElems, signer, err := network.UnpackSignedAuthHeader(authHeader)
@@ -183,12 +257,12 @@ func (m *connectionManager) StartHandshake(authHeader []byte) (attemptId string,
 		return "", nil, multierr.Append(network.ErrAuthHeaderParse, err)
 	}
 	nodeAddress := "0x" + hex.EncodeToString(signer)
-	donConnMgr, ok := m.dons[authHeaderElems.DonId]
-	if !ok {
+	donConnMgr := m.DONConnectionManager(authHeaderElems.DonId)
+	if donConnMgr == nil {
 		return "", nil, network.ErrAuthInvalidDonId
 	}
-	nodeState, ok := donConnMgr.nodes[nodeAddress]
-	if !ok {
+	nodeState := donConnMgr.getNode(nodeAddress)
+	if nodeState == nil {
 		return "", nil, network.ErrAuthInvalidNode
 	}
 	if authHeaderElems.GatewayId != m.config.AuthGatewayId {
@@ -199,14 +273,14 @@ func (m *connectionManager) StartHandshake(authHeader []byte) (attemptId string,
 	if ts < nowTs-m.config.AuthTimestampToleranceSec || nowTs+m.config.AuthTimestampToleranceSec < ts {
 		return "", nil, network.ErrAuthInvalidTimestamp
 	}
-	attemptId, challenge, err = m.newAttempt(nodeState, nodeAddress, ts)
+	attemptId, challenge, err = m.newAttempt(nodeState, authHeaderElems.DonId, nodeAddress, ts)
 	if err != nil {
 		return "", nil, err
 	}
 	return attemptId, challenge, nil
 }
 
-func (m *connectionManager) newAttempt(nodeSt *nodeState, nodeAddress string, timestamp uint32) (string, []byte, error) {
+func (m *connectionManager) newAttempt(nodeSt *nodeState, donId string, nodeAddress string, timestamp uint32) (string, []byte, error) {
 	challengeBytes := make([]byte, m.config.AuthChallengeLen)
 	_, err := rand.Read(challengeBytes)
 	if err != nil {
@@ -217,7 +291,7 @@ func (m *connectionManager) newAttempt(nodeSt *nodeState, nodeAddress string, ti
 	defer m.connAttemptsMu.Unlock()
 	m.connAttemptCounter++
 	newId := fmt.Sprintf("%s_%d", nodeAddress, m.connAttemptCounter)
-	m.connAttempts[newId] = &connAttempt{nodeState: nodeSt, nodeAddress: nodeAddress, challenge: challenge, timestamp: timestamp}
+	m.connAttempts[newId] = &connAttempt{nodeState: nodeSt, donId: donId, nodeAddress: nodeAddress, challenge: challenge, timestamp: timestamp}
 	return newId, network.PackChallenge(&challenge), nil
 }
 
@@ -234,6 +308,10 @@ func (m *connectionManager) FinalizeHandshake(attemptId string, response []byte,
 	if err != nil || attempt.nodeAddress != "0x"+hex.EncodeToString(signer) {
 		return network.ErrChallengeInvalidSignature
 	}
+	// the node may have been removed from its DON during the handshake
+	if donConnMgr := m.DONConnectionManager(attempt.donId); donConnMgr == nil || !donConnMgr.hasConn(attempt.nodeAddress, attempt.nodeState.conn) {
+		return network.ErrAuthInvalidNode
+	}
 	if conn != nil {
 		conn.SetPongHandler(func(data string) error {
 			m.lggr.Debugw("received keepalive pong from node", "nodeAddress", attempt.nodeAddress)
@@ -257,9 +335,106 @@ func (m *connectionManager) GetPort() int {
 }
 
 func (m *donConnectionManager) SetHandler(handler handlers.Handler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.handler = handler
 }
 
+func (m *donConnectionManager) getHandler() handlers.Handler {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.handler
+}
+
+func (m *donConnectionManager) getNode(nodeAddress string) *nodeState {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.nodes[nodeAddress]
+}
+
+// hasConn returns true if conn is the connection of a member node.
+func (m *donConnectionManager) hasConn(nodeAddress string, conn network.WSConnectionWrapper) bool {
+	nodeState := m.getNode(nodeAddress)
+	return nodeState != nil && nodeState.conn == conn
+}
+
+// getNodes returns a copy of the member nodes.
+func (m *donConnectionManager) getNodes() map[string]*nodeState {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return maps.Clone(m.nodes)
+}
+
+func (m *donConnectionManager) start(ctx context.Context, heartbeatIntervalSec uint32) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for nodeAddress, nodeState := range m.nodes {
+		if err := m.startNode(ctx, nodeAddress, nodeState); err != nil {
+			return err
+		}
+	}
+	m.closeWait.Add(1)
+	go m.keepaliveLoop(heartbeatIntervalSec)
+	return nil
+}
+
+func (m *donConnectionManager) startNode(ctx context.Context, nodeAddress string, nodeState *nodeState) error {
+	if err := nodeState.conn.Start(ctx); err != nil {
+		return err
+	}
+	m.closeWait.Add(1)
+	go m.readLoop(nodeAddress, nodeState)
+	return nil
+}
+
+// close stops all loops and connections of the DON, closeWait then waits for them.
+func (m *donConnectionManager) close() {
+	close(m.shutdownCh)
+	for _, nodeState := range m.getNodes() {
+		nodeState.conn.Close()
+	}
+}
+
+// updateMembers removes the nodes which are not members of donConfig and starts the new ones.
+func (m *donConnectionManager) updateMembers(ctx context.Context, donConfig *config.DONConfig, lggr logger.Logger) error {
+	names := make(map[string]string)
+	for _, nodeConfig := range donConfig.Members {
+		nodeAddress := strings.ToLower(nodeConfig.Address)
+		if _, ok := names[nodeAddress]; ok {
+			return fmt.Errorf("duplicate node address %s in DON %s", nodeAddress, donConfig.DonId)
+		}
+		names[nodeAddress] = nodeConfig.Name
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for nodeAddress, nodeState := range m.nodes {
+		if _, ok := names[nodeAddress]; !ok {
+			close(nodeState.stopCh)
+			nodeState.conn.Close()
+			delete(m.nodes, nodeAddress)
+			m.lggr.Infow("removed node", "nodeAddress", nodeAddress, "name", nodeState.name)
+		}
+	}
+	for nodeAddress, name := range names {
+		if existing, ok := m.nodes[nodeAddress]; ok {
+			// keep the connection and read loop of existing nodes
+			m.nodes[nodeAddress] = &nodeState{name: name, conn: existing.conn, stopCh: existing.stopCh}
+			continue
+		}
+		nodeState, err := newNodeState(name, nodeAddress, lggr)
+		if err != nil {
+			return err
+		}
+		if err = m.startNode(ctx, nodeAddress, nodeState); err != nil {
+			return err
+		}
+		m.nodes[nodeAddress] = nodeState
+		m.lggr.Infow("added node", "nodeAddress", nodeAddress, "name", name)
+	}
+	m.donConfig = donConfig
+	return nil
+}
+
 func (m *donConnectionManager) SendToNode(ctx context.Context, nodeAddress string, msg *api.Message) error {
 	if msg == nil {
 		return errors.New("nil message")
@@ -268,7 +443,7 @@ func (m *donConnectionManager) SendToNode(ctx context.Context, nodeAddress strin
 	if err != nil {
 		return fmt.Errorf("error encoding request for node %s: %w", nodeAddress, err)
 	}
-	nodeState := m.nodes[nodeAddress]
+	nodeState := m.getNode(nodeAddress)
 	if nodeState == nil {
 		return fmt.Errorf("node %s not found", nodeAddress)
 	}
@@ -282,6 +457,9 @@ func (m *donConnectionManager) readLoop(nodeAddress string, nodeState *nodeState
 		case <-m.shutdownCh:
 			m.closeWait.Done()
 			return
+		case <-nodeState.stopCh:
+			m.closeWait.Done()
+			return
 		case item := <-nodeState.conn.ReadChannel():
 			msg, err := m.codec.DecodeResponse(item.Data)
 			if err != nil {
@@ -296,7 +474,13 @@ func (m *donConnectionManager) readLoop(nodeAddress string, nodeState *nodeState
 				m.lggr.Errorw("message sender mismatch when reading from node", "nodeAddress", nodeAddress, "sender", msg.Body.Sender)
 				break
 			}
-			err = m.handler.HandleNodeMessage(ctx, msg, nodeAddress)
+			handler := m.getHandler()
+			if handler == nil {
+				// a node of a DON added at runtime can connect before its handler is set
+				m.lggr.Debugw("dropping message from node of a DON without handler", "nodeAddress", nodeAddress)
+				break
+			}
+			err = handler.HandleNodeMessage(ctx, msg, nodeAddress)
 			if err != nil {
 				m.lggr.Error("error when calling HandleNodeMessage ", err)
 			}
@@ -308,11 +492,14 @@ func (m *donConnectionManager) keepaliveLoop(intervalSec uint32) {
 	ctx, _ := m.shutdownCh.NewCtx()
 	defer m.closeWait.Done()
 
+	m.mu.RLock()
+	donId := m.donConfig.DonId
+	m.mu.RUnlock()
 	if intervalSec == 0 {
-		m.lggr.Errorw("keepalive interval is 0, keepalive disabled", "donID", m.donConfig.DonId)
+		m.lggr.Errorw("keepalive interval is 0, keepalive disabled", "donID", donId)
 		return
 	}
-	m.lggr.Infow("starting keepalive loop", "donID", m.donConfig.DonId)
+	m.lggr.Infow("starting keepalive loop", "donID", donId)
 
 	keepaliveTicker := time.NewTicker(time.Duration(intervalSec) * time.Second)
 	defer keepaliveTicker.Stop()
@@ -323,15 +510,16 @@ func (m *donConnectionManager) keepaliveLoop(intervalSec uint32) {
 			return
 		case <-keepaliveTicker.C:
 			errorCount := 0
-			for nodeAddress, nodeState := range m.nodes {
+			nodes := m.getNodes()
+			for nodeAddress, nodeState := range nodes {
 				err := nodeState.conn.Write(ctx, websocket.PingMessage, []byte{})
 				if err != nil {
-					m.lggr.Debugw("unable to send keepalive ping to node", "nodeAddress", nodeAddress, "name", nodeState.name, "donID", m.donConfig.DonId, "err", err)
+					m.lggr.Debugw("unable to send keepalive ping to node", "nodeAddress", nodeAddress, "name", nodeState.name, "donID", donId, "err", err)
 					errorCount++
 				}
 			}
-			promKeepalivesSent.WithLabelValues(m.donConfig.DonId).Set(float64(len(m.nodes) - errorCount))
-			m.lggr.Infow("sent keepalive pings to nodes", "donID", m.donConfig.DonId, "errCount", errorCount)
+			promKeepalivesSent.WithLabelValues(donId).Set(float64(len(nodes) - errorCount))
+			m.lggr.Infow("sent keepalive pings to nodes", "donID", donId, "errCount", errorCount)
 		}
 	}
 }
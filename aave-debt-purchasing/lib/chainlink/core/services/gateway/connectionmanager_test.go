@@ -8,6 +8,7 @@ import (
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
@@ -242,3 +243,64 @@ func TestConnectionManager_CleanStartClose(t *testing.T) {
 	err = mgr.Close()
 	require.NoError(t, err)
 }
+
+func TestConnectionManager_UpdateDON(t *testing.T) {
+	t.Parallel()
+
+	cfg, nodes := newTestConfig(t, 2)
+	newNode := gc.NewTestNodes(t, 1)[0]
+	clock := clockwork.NewFakeClock()
+	mgr, err := gateway.NewConnectionManager(cfg, clock, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	ctx := testutils.Context(t)
+	// only running managers can be updated
+	_, err = mgr.UpdateDON(ctx, &cfg.Dons[0])
+	require.Error(t, err)
+	servicetest.Run(t, mgr)
+
+	startHandshake := func(donId string, node gc.TestNode) (string, []byte, error) {
+		authHeaderElems := network.AuthHeaderElems{
+			Timestamp: uint32(clock.Now().Unix()),
+			DonId:     donId,
+			GatewayId: "my_gateway_no_3",
+		}
+		return mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, node.PrivateKey))
+	}
+	attemptId, challenge, err := startHandshake("my_don_1", nodes[0])
+	require.NoError(t, err)
+
+	// replace the first node
+	donConfig := cfg.Dons[0]
+	donConfig.Members = []config.NodeConfig{donConfig.Members[1], {Name: "new_node", Address: newNode.Address}}
+	_, err = mgr.UpdateDON(ctx, &donConfig)
+	require.NoError(t, err)
+	_, _, err = startHandshake("my_don_1", nodes[0])
+	require.ErrorIs(t, err, network.ErrAuthInvalidNode)
+	_, _, err = startHandshake("my_don_1", nodes[1])
+	require.NoError(t, err)
+	_, _, err = startHandshake("my_don_1", newNode)
+	require.NoError(t, err)
+
+	// handshakes of removed nodes can not complete
+	response, err := gc.SignData(nodes[0].PrivateKey, challenge)
+	require.NoError(t, err)
+	require.ErrorIs(t, mgr.FinalizeHandshake(attemptId, response, nil), network.ErrAuthInvalidNode)
+
+	// add and remove a DON
+	newDonConfig := config.DONConfig{
+		DonId:       "my_don_2",
+		HandlerName: "dummy",
+		Members:     []config.NodeConfig{{Name: "new_node", Address: newNode.Address}},
+	}
+	_, err = mgr.UpdateDON(ctx, &newDonConfig)
+	require.NoError(t, err)
+	_, _, err = startHandshake("my_don_2", newNode)
+	require.NoError(t, err)
+
+	require.NoError(t, mgr.RemoveDON("my_don_2"))
+	require.Nil(t, mgr.DONConnectionManager("my_don_2"))
+	_, _, err = startHandshake("my_don_2", newNode)
+	require.ErrorIs(t, err, network.ErrAuthInvalidDonId)
+	require.Error(t, mgr.RemoveDON("my_don_2"))
+}
@@ -3,8 +3,11 @@ package gateway
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
+	"sync"
 
 	"go.uber.org/multierr"
 
@@ -28,10 +31,19 @@ var promRequest = promauto.NewCounterVec(prometheus.CounterOpts{
 	Help: "Metric to track received requests and response codes",
 }, []string{"response_code"})
 
+// progressBufferSize is the number of progress events of a streamed request buffered before they are dropped.
+const progressBufferSize = 32
+
+// ErrRestartRequired is returned when updating a running gateway with a config which changes more than its DONs.
+var ErrRestartRequired = errors.New("only DONs can be updated without restarting the gateway")
+
 type Gateway interface {
-	job.ServiceCtx
-	gw_net.HTTPRequestHandler
+	job.ReloadableServiceCtx
+	gw_net.StreamingHTTPRequestHandler
 
+	// UpdateConfig applies the DONs of cfg to the running gateway. DONs whose config changed get their
+	// node connections updated and a new handler, while the other DONs are left untouched.
+	UpdateConfig(ctx context.Context, cfg *config.GatewayConfig) error
 	GetUserPort() int
 	GetNodePort() int
 }
@@ -48,20 +60,30 @@ type gateway struct {
 	codec      api.Codec
 	httpServer gw_net.HttpServer
 	handlers   map[string]handlers.Handler
+	handlersMu sync.RWMutex
 	connMgr    ConnectionManager
 	lggr       logger.Logger
+
+	// set when created from a config, to be updated at runtime
+	config         *config.GatewayConfig
+	dons           map[string]config.DONConfig
+	handlerFactory HandlerFactory
+	updateMu       sync.Mutex
 }
 
-func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFactory, lggr logger.Logger) (Gateway, error) {
+func NewGatewayFromConfig(gwConfig *config.GatewayConfig, handlerFactory HandlerFactory, lggr logger.Logger) (Gateway, error) {
+	// copied before the servers apply their defaults to it, to be compared with updated configs
+	originalConfig := *gwConfig
 	codec := &api.JsonRPCCodec{}
-	httpServer := gw_net.NewHttpServer(&config.UserServerConfig, lggr)
-	connMgr, err := NewConnectionManager(config, clockwork.NewRealClock(), lggr)
+	httpServer := gw_net.NewHttpServer(&gwConfig.UserServerConfig, lggr)
+	connMgr, err := NewConnectionManager(gwConfig, clockwork.NewRealClock(), lggr)
 	if err != nil {
 		return nil, err
 	}
 
 	handlerMap := make(map[string]handlers.Handler)
-	for _, donConfig := range config.Dons {
+	dons := make(map[string]config.DONConfig)
+	for _, donConfig := range gwConfig.Dons {
 		donConfig := donConfig
 		_, ok := handlerMap[donConfig.DonId]
 		if ok {
@@ -71,23 +93,48 @@ func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFa
 		if donConnMgr == nil {
 			return nil, fmt.Errorf("connection manager ID %s not found", donConfig.DonId)
 		}
-		for idx, nodeConfig := range donConfig.Members {
-			donConfig.Members[idx].Address = strings.ToLower(nodeConfig.Address)
-			if !common.IsHexAddress(nodeConfig.Address) {
-				return nil, fmt.Errorf("invalid node address %s", nodeConfig.Address)
-			}
+		if err = normalizeDONConfig(&donConfig); err != nil {
+			return nil, err
 		}
 		handler, err := handlerFactory.NewHandler(donConfig.HandlerName, donConfig.HandlerConfig, &donConfig, donConnMgr)
 		if err != nil {
 			return nil, err
 		}
 		handlerMap[donConfig.DonId] = handler
+		dons[donConfig.DonId] = donConfig
 		donConnMgr.SetHandler(handler)
 	}
-	return NewGateway(codec, httpServer, handlerMap, connMgr, lggr), nil
+	gw := newGateway(codec, httpServer, handlerMap, connMgr, lggr)
+	gw.config = &originalConfig
+	gw.dons = dons
+	gw.handlerFactory = handlerFactory
+	return gw, nil
+}
+
+// normalizeDONConfig lowercases the node addresses of donConfig and validates them.
+func normalizeDONConfig(donConfig *config.DONConfig) error {
+	if donConfig.DonId == "" {
+		return errors.New("empty DON ID")
+	}
+	addresses := make(map[string]struct{})
+	for idx, nodeConfig := range donConfig.Members {
+		donConfig.Members[idx].Address = strings.ToLower(nodeConfig.Address)
+		if !common.IsHexAddress(nodeConfig.Address) {
+			return fmt.Errorf("invalid node address %s", nodeConfig.Address)
+		}
+		if _, ok := addresses[donConfig.Members[idx].Address]; ok {
+			return fmt.Errorf("duplicate node address %s in DON %s", donConfig.Members[idx].Address, donConfig.DonId)
+		}
+		addresses[donConfig.Members[idx].Address] = struct{}{}
+	}
+	return nil
 }
 
 func NewGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[string]handlers.Handler, connMgr ConnectionManager, lggr logger.Logger) Gateway {
+	return newGateway(codec, httpServer, handlers, connMgr, lggr)
+}
+
+func newGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[string]handlers.Handler, connMgr ConnectionManager, lggr logger.Logger) *gateway {
 	gw := &gateway{
 		codec:      codec,
 		httpServer: httpServer,
@@ -102,6 +149,8 @@ func NewGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[stri
 func (g *gateway) Start(ctx context.Context) error {
 	return g.StartOnce("Gateway", func() error {
 		g.lggr.Info("starting gateway")
+		g.handlersMu.RLock()
+		defer g.handlersMu.RUnlock()
 		for _, handler := range g.handlers {
 			if err := handler.Start(ctx); err != nil {
 				return err
@@ -119,6 +168,8 @@ func (g *gateway) Close() error {
 		g.lggr.Info("closing gateway")
 		err = multierr.Combine(err, g.httpServer.Close())
 		err = multierr.Combine(err, g.connMgr.Close())
+		g.handlersMu.RLock()
+		defer g.handlersMu.RUnlock()
 		for _, handler := range g.handlers {
 			err = multierr.Combine(err, handler.Close())
 		}
@@ -128,25 +179,13 @@ func (g *gateway) Close() error {
 
 // Called by the server
 func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawResponse []byte, httpStatusCode int) {
-	// decode
-	msg, err := g.codec.DecodeRequest(rawRequest)
-	if err != nil {
-		return newError(g.codec, "", api.UserMessageParseError, err.Error())
-	}
+	msg, handler, rawResponse, httpStatusCode := g.decodeRequest(rawRequest)
 	if msg == nil {
-		return newError(g.codec, "", api.UserMessageParseError, "nil message")
-	}
-	if err = msg.Validate(); err != nil {
-		return newError(g.codec, msg.Body.MessageId, api.UserMessageParseError, err.Error())
-	}
-	// find correct handler
-	handler, ok := g.handlers[msg.Body.DonId]
-	if !ok {
-		return newError(g.codec, msg.Body.MessageId, api.UnsupportedDONIdError, "unsupported DON ID")
+		return rawResponse, httpStatusCode
 	}
 	// send to the handler
 	responseCh := make(chan handlers.UserCallbackPayload, 1)
-	err = handler.HandleUserMessage(ctx, msg, responseCh)
+	err := handler.HandleUserMessage(ctx, msg, responseCh)
 	if err != nil {
 		return newError(g.codec, msg.Body.MessageId, api.HandlerError, err.Error())
 	}
@@ -158,11 +197,76 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawRes
 	case response = <-responseCh:
 		break
 	}
+	return g.encodeResponse(msg, response)
+}
+
+// Called by the server for streamed requests
+func (g *gateway) ProcessStreamingRequest(ctx context.Context, rawRequest []byte, send func(gw_net.StreamEvent) error) (rawResponse []byte, httpStatusCode int) {
+	msg, handler, rawResponse, httpStatusCode := g.decodeRequest(rawRequest)
+	if msg == nil {
+		return rawResponse, httpStatusCode
+	}
+	streamingHandler, ok := handler.(handlers.StreamingHandler)
+	if !ok {
+		return newError(g.codec, msg.Body.MessageId, api.HandlerError, "streaming is not supported by the handler")
+	}
+	// send to the handler
+	progressCh := make(chan handlers.UserProgressPayload, progressBufferSize)
+	responseCh := make(chan handlers.UserCallbackPayload, 1)
+	err := streamingHandler.HandleStreamingUserMessage(ctx, msg, progressCh, responseCh)
+	if err != nil {
+		return newError(g.codec, msg.Body.MessageId, api.HandlerError, err.Error())
+	}
+	// stream progress until the response
+	for {
+		select {
+		case <-ctx.Done():
+			return newError(g.codec, msg.Body.MessageId, api.RequestTimeoutError, "handler timeout")
+		case progress := <-progressCh:
+			g.sendProgress(msg, progress, send)
+		case response := <-responseCh:
+			// progress sent before the response is streamed first
+			for len(progressCh) > 0 {
+				g.sendProgress(msg, <-progressCh, send)
+			}
+			return g.encodeResponse(msg, response)
+		}
+	}
+}
+
+// decodeRequest returns the validated request and its handler, or a nil request and the error response.
+func (g *gateway) decodeRequest(rawRequest []byte) (msg *api.Message, handler handlers.Handler, rawResponse []byte, httpStatusCode int) {
+	// decode
+	msg, err := g.codec.DecodeRequest(rawRequest)
+	if err != nil {
+		rawResponse, httpStatusCode = newError(g.codec, "", api.UserMessageParseError, err.Error())
+		return nil, nil, rawResponse, httpStatusCode
+	}
+	if msg == nil {
+		rawResponse, httpStatusCode = newError(g.codec, "", api.UserMessageParseError, "nil message")
+		return nil, nil, rawResponse, httpStatusCode
+	}
+	if err = msg.Validate(); err != nil {
+		rawResponse, httpStatusCode = newError(g.codec, msg.Body.MessageId, api.UserMessageParseError, err.Error())
+		return nil, nil, rawResponse, httpStatusCode
+	}
+	// find correct handler
+	g.handlersMu.RLock()
+	handler, ok := g.handlers[msg.Body.DonId]
+	g.handlersMu.RUnlock()
+	if !ok {
+		rawResponse, httpStatusCode = newError(g.codec, msg.Body.MessageId, api.UnsupportedDONIdError, "unsupported DON ID")
+		return nil, nil, rawResponse, httpStatusCode
+	}
+	return msg, handler, nil, 0
+}
+
+func (g *gateway) encodeResponse(msg *api.Message, response handlers.UserCallbackPayload) (rawResponse []byte, httpStatusCode int) {
 	if response.ErrCode != api.NoError {
 		return newError(g.codec, msg.Body.MessageId, response.ErrCode, response.ErrMsg)
 	}
 	// encode
-	rawResponse, err = g.codec.EncodeResponse(response.Msg)
+	rawResponse, err := g.codec.EncodeResponse(response.Msg)
 	if err != nil {
 		return newError(g.codec, msg.Body.MessageId, api.NodeReponseEncodingError, "")
 	}
@@ -170,6 +274,34 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawRes
 	return rawResponse, api.ToHttpErrorCode(api.NoError)
 }
 
+// streamProgress is the data of the progress events of streamed requests.
+type streamProgress struct {
+	ID       string `json:"id"`
+	Received int    `json:"received"`
+	Required int    `json:"required,omitempty"`
+}
+
+// sendProgress streams the partial response of the progress, if any, followed by the progress itself.
+// Errors are only logged, as the request is canceled if the user disconnects.
+func (g *gateway) sendProgress(msg *api.Message, progress handlers.UserProgressPayload, send func(gw_net.StreamEvent) error) {
+	if progress.Msg != nil {
+		rawPartial, err := g.codec.EncodeResponse(progress.Msg)
+		if err != nil {
+			g.lggr.Debugw("failed to encode partial response", "messageId", msg.Body.MessageId, "err", err)
+		} else if err = send(gw_net.StreamEvent{Name: gw_net.StreamEventPartial, Data: rawPartial}); err != nil {
+			g.lggr.Debugw("failed to send partial response", "messageId", msg.Body.MessageId, "err", err)
+		}
+	}
+	rawProgress, err := json.Marshal(streamProgress{ID: msg.Body.MessageId, Received: progress.Received, Required: progress.Required})
+	if err != nil {
+		g.lggr.Debugw("failed to encode progress", "messageId", msg.Body.MessageId, "err", err)
+		return
+	}
+	if err = send(gw_net.StreamEvent{Name: gw_net.StreamEventProgress, Data: rawProgress}); err != nil {
+		g.lggr.Debugw("failed to send progress", "messageId", msg.Body.MessageId, "err", err)
+	}
+}
+
 func newError(codec api.Codec, id string, errCode api.ErrorCode, errMsg string) ([]byte, int) {
 	rawResponse, err := codec.EncodeNewErrorResponse(id, api.ToJsonRPCErrorCode(errCode), errMsg, nil)
 	if err != nil {
@@ -181,6 +313,106 @@ func newError(codec api.Codec, id string, errCode api.ErrorCode, errMsg string)
 	return rawResponse, api.ToHttpErrorCode(errCode)
 }
 
+// Reload updates the gateway with the config of next, a gateway created for the updated job.
+func (g *gateway) Reload(ctx context.Context, next job.ServiceCtx) error {
+	nextGateway, ok := next.(*gateway)
+	if !ok || nextGateway.config == nil {
+		return ErrRestartRequired
+	}
+	return g.UpdateConfig(ctx, nextGateway.config)
+}
+
+func (g *gateway) UpdateConfig(ctx context.Context, cfg *config.GatewayConfig) error {
+	if err := g.Ready(); err != nil {
+		return err
+	}
+	g.updateMu.Lock()
+	defer g.updateMu.Unlock()
+	if g.config == nil {
+		return ErrRestartRequired
+	}
+	current, updated := *g.config, *cfg
+	current.Dons, updated.Dons = nil, nil
+	if !reflect.DeepEqual(current, updated) {
+		return ErrRestartRequired
+	}
+
+	dons := make(map[string]config.DONConfig)
+	for _, donConfig := range cfg.Dons {
+		donConfig := donConfig
+		if _, ok := dons[donConfig.DonId]; ok {
+			return fmt.Errorf("duplicate DON ID %s", donConfig.DonId)
+		}
+		if err := normalizeDONConfig(&donConfig); err != nil {
+			return err
+		}
+		dons[donConfig.DonId] = donConfig
+	}
+	// g.dons tracks the DONs applied so far, so that an update failing midway can be retried
+	for donId := range g.dons {
+		if _, ok := dons[donId]; !ok {
+			if err := g.removeDON(donId); err != nil {
+				return fmt.Errorf("failed to remove DON %s: %w", donId, err)
+			}
+		}
+	}
+	for _, donConfig := range cfg.Dons {
+		donConfig := dons[donConfig.DonId]
+		if current, ok := g.dons[donConfig.DonId]; ok && reflect.DeepEqual(current, donConfig) {
+			continue
+		}
+		if err := g.updateDON(ctx, &donConfig); err != nil {
+			return fmt.Errorf("failed to update DON %s: %w", donConfig.DonId, err)
+		}
+	}
+	g.config = cfg
+	return nil
+}
+
+// updateDON applies the membership of donConfig and replaces the handler of the DON.
+func (g *gateway) updateDON(ctx context.Context, donConfig *config.DONConfig) error {
+	donConnMgr, err := g.connMgr.UpdateDON(ctx, donConfig)
+	if err != nil {
+		return err
+	}
+	handler, err := g.handlerFactory.NewHandler(donConfig.HandlerName, donConfig.HandlerConfig, donConfig, donConnMgr)
+	if err != nil {
+		return err
+	}
+	if err = handler.Start(ctx); err != nil {
+		return err
+	}
+	g.handlersMu.Lock()
+	oldHandler := g.handlers[donConfig.DonId]
+	g.handlers[donConfig.DonId] = handler
+	g.handlersMu.Unlock()
+	donConnMgr.SetHandler(handler)
+	g.dons[donConfig.DonId] = *donConfig
+	g.lggr.Infow("updated DON", "donID", donConfig.DonId, "nodes", len(donConfig.Members))
+
+	// pending requests of the old handler are dropped
+	if oldHandler != nil {
+		if err = oldHandler.Close(); err != nil {
+			g.lggr.Warnw("failed to close the previous handler", "donID", donConfig.DonId, "err", err)
+		}
+	}
+	return nil
+}
+
+func (g *gateway) removeDON(donId string) error {
+	g.handlersMu.Lock()
+	handler := g.handlers[donId]
+	delete(g.handlers, donId)
+	g.handlersMu.Unlock()
+	delete(g.dons, donId)
+	err := g.connMgr.RemoveDON(donId)
+	if handler != nil {
+		err = multierr.Combine(err, handler.Close())
+	}
+	g.lggr.Infow("removed DON", "donID", donId)
+	return err
+}
+
 func (g *gateway) GetUserPort() int {
 	return g.httpServer.GetPort()
 }
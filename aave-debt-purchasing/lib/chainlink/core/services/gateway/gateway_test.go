@@ -20,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	handler_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
 	net_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network/mocks"
 )
 
@@ -134,6 +135,69 @@ func TestGateway_CleanStartAndClose(t *testing.T) {
 	servicetest.Run(t, gateway)
 }
 
+func TestGateway_UpdateConfig(t *testing.T) {
+	t.Parallel()
+
+	tomlConfig := buildConfig(`
+[[dons]]
+DonId = "my_don_1"
+HandlerName = "dummy"
+
+[[dons]]
+DonId = "my_don_2"
+HandlerName = "dummy"
+`)
+
+	ctx := testutils.Context(t)
+	lggr := logger.TestLogger(t)
+	gw, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), lggr)
+	require.NoError(t, err)
+	// only running gateways can be updated
+	require.Error(t, gw.UpdateConfig(ctx, parseTOMLConfig(t, tomlConfig)))
+	servicetest.Run(t, gw)
+
+	// remove my_don_2 and add my_don_3
+	updatedConfig := parseTOMLConfig(t, buildConfig(`
+[[dons]]
+DonId = "my_don_1"
+HandlerName = "dummy"
+
+[[dons]]
+DonId = "my_don_3"
+HandlerName = "dummy"
+`))
+	require.NoError(t, gw.UpdateConfig(ctx, updatedConfig))
+
+	response, statusCode := gw.ProcessRequest(ctx, newSignedRequest(t, "abcd", "request", "my_don_2", []byte{}))
+	requireJsonRPCError(t, response, "abcd", -32602, "unsupported DON ID")
+	require.Equal(t, 400, statusCode)
+
+	// the dummy handler of my_don_3 waits for a node response
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Millisecond*10)
+	defer cancel()
+	response, statusCode = gw.ProcessRequest(timeoutCtx, newSignedRequest(t, "abcd", "request", "my_don_3", []byte{}))
+	requireJsonRPCError(t, response, "abcd", -32000, "handler timeout")
+	require.Equal(t, 504, statusCode)
+
+	// invalid DONs are rejected
+	invalidConfig := parseTOMLConfig(t, buildConfig(`
+[[dons]]
+DonId = "my_don_1"
+HandlerName = "dummy"
+
+[[dons.Members]]
+Name = "node one"
+Address = "0xnot_an_address"
+`))
+	require.Error(t, gw.UpdateConfig(ctx, invalidConfig))
+
+	// other changes require a restart
+	updatedConfig = parseTOMLConfig(t, buildConfig(""))
+	updatedConfig.UserServerConfig.Path = "/other_user"
+	require.ErrorIs(t, gw.UpdateConfig(ctx, updatedConfig), gateway.ErrRestartRequired)
+	require.ErrorIs(t, gw.Reload(ctx, handler_mocks.NewHandler(t)), gateway.ErrRestartRequired)
+}
+
 func requireJsonRPCResult(t *testing.T, response []byte, expectedId string, expectedResult string) {
 	require.JSONEq(t, fmt.Sprintf(`{"jsonrpc":"2.0","id":"%s","result":%s}`, expectedId, expectedResult), string(response))
 }
@@ -245,3 +309,76 @@ func TestGateway_ProcessRequest_HandlerError(t *testing.T) {
 	requireJsonRPCError(t, response, "abcd", -32600, "failure")
 	require.Equal(t, 400, statusCode)
 }
+
+func newGatewayWithMockStreamingHandler(t *testing.T) (gateway.Gateway, *handler_mocks.StreamingHandler) {
+	httpServer := net_mocks.NewHttpServer(t)
+	httpServer.On("SetHTTPRequestHandler", mock.Anything).Return(nil)
+	handler := handler_mocks.NewStreamingHandler(t)
+	handlers := map[string]handlers.Handler{
+		"testDON": handler,
+	}
+	gw := gateway.NewGateway(&api.JsonRPCCodec{}, httpServer, handlers, nil, logger.TestLogger(t))
+	return gw, handler
+}
+
+func TestGateway_ProcessStreamingRequest_HandlerResponse(t *testing.T) {
+	t.Parallel()
+
+	gw, handler := newGatewayWithMockStreamingHandler(t)
+	handler.On("HandleStreamingUserMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		msg := args.Get(1).(*api.Message)
+		progressCh := args.Get(2).(chan<- handlers.UserProgressPayload)
+		callbackCh := args.Get(3).(chan<- handlers.UserCallbackPayload)
+		// stream a partial response before the final one
+		partial := *msg
+		partial.Body.Payload = []byte(`{"success":true}`)
+		partial.Signature = ""
+		progressCh <- handlers.UserProgressPayload{Msg: &partial, Received: 1, Required: 2}
+		msg.Body.Payload = []byte(`{"result":"OK"}`)
+		msg.Signature = ""
+		callbackCh <- handlers.UserCallbackPayload{Msg: msg, ErrCode: api.NoError, ErrMsg: ""}
+	})
+
+	var events []gw_net.StreamEvent
+	send := func(event gw_net.StreamEvent) error {
+		events = append(events, event)
+		return nil
+	}
+	req := newSignedRequest(t, "abcd", "request", "testDON", []byte{})
+	response, statusCode := gw.ProcessStreamingRequest(testutils.Context(t), req, send)
+	requireJsonRPCResult(t, response, "abcd",
+		`{"signature":"","body":{"message_id":"abcd","method":"request","don_id":"testDON","receiver":"","payload":{"result":"OK"}}}`)
+	require.Equal(t, 200, statusCode)
+
+	require.Len(t, events, 2)
+	require.Equal(t, gw_net.StreamEventPartial, events[0].Name)
+	requireJsonRPCResult(t, events[0].Data, "abcd",
+		`{"signature":"","body":{"message_id":"abcd","method":"request","don_id":"testDON","receiver":"","payload":{"success":true}}}`)
+	require.Equal(t, gw_net.StreamEventProgress, events[1].Name)
+	require.JSONEq(t, `{"id":"abcd","received":1,"required":2}`, string(events[1].Data))
+}
+
+func TestGateway_ProcessStreamingRequest_HandlerTimeout(t *testing.T) {
+	t.Parallel()
+
+	gw, handler := newGatewayWithMockStreamingHandler(t)
+	handler.On("HandleStreamingUserMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	timeoutCtx, cancel := context.WithTimeout(testutils.Context(t), time.Millisecond*10)
+	defer cancel()
+
+	req := newSignedRequest(t, "abcd", "request", "testDON", []byte{})
+	response, statusCode := gw.ProcessStreamingRequest(timeoutCtx, req, func(gw_net.StreamEvent) error { return nil })
+	requireJsonRPCError(t, response, "abcd", -32000, "handler timeout")
+	require.Equal(t, 504, statusCode)
+}
+
+func TestGateway_ProcessStreamingRequest_NotSupported(t *testing.T) {
+	t.Parallel()
+
+	gw, _ := newGatewayWithMockHandler(t)
+
+	req := newSignedRequest(t, "abcd", "request", "testDON", []byte{})
+	response, statusCode := gw.ProcessStreamingRequest(testutils.Context(t), req, func(gw_net.StreamEvent) error { return nil })
+	requireJsonRPCError(t, response, "abcd", -32600, "streaming is not supported by the handler")
+	require.Equal(t, 400, statusCode)
+}
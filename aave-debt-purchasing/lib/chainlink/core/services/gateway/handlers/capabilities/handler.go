@@ -46,9 +46,11 @@ type HandlerConfig struct {
 type savedCallback struct {
 	id         string
 	callbackCh chan<- handlers.UserCallbackPayload
+	// progressCh is nil unless the request is streamed
+	progressCh chan<- handlers.UserProgressPayload
 }
 
-var _ handlers.Handler = (*handler)(nil)
+var _ handlers.StreamingHandler = (*handler)(nil)
 
 func NewHandler(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, httpClient network.HTTPClient, lggr logger.Logger) (*handler, error) {
 	var cfg HandlerConfig
@@ -112,6 +114,7 @@ func (h *handler) handleWebAPITriggerMessage(ctx context.Context, msg *api.Messa
 		// Send first response from a node back to the user, ignore any other ones.
 		// TODO: in practice, we should wait for at least 2F+1 nodes to respond and then return an aggregated response
 		// back to the user.
+		handlers.SendProgress(savedCb.progressCh, handlers.UserProgressPayload{Msg: msg, Received: 1, Required: 1})
 		savedCb.callbackCh <- handlers.UserCallbackPayload{Msg: msg, ErrCode: api.NoError, ErrMsg: ""}
 		close(savedCb.callbackCh)
 	}
@@ -212,8 +215,18 @@ func (h *handler) Close() error {
 }
 
 func (h *handler) HandleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
+	return h.handleUserMessage(ctx, msg, nil, callbackCh)
+}
+
+// HandleStreamingUserMessage sends the node response to the user as soon as it is received. As
+// only the first node response is returned, it is the only progress of the request.
+func (h *handler) HandleStreamingUserMessage(ctx context.Context, msg *api.Message, progressCh chan<- handlers.UserProgressPayload, callbackCh chan<- handlers.UserCallbackPayload) error {
+	return h.handleUserMessage(ctx, msg, progressCh, callbackCh)
+}
+
+func (h *handler) handleUserMessage(ctx context.Context, msg *api.Message, progressCh chan<- handlers.UserProgressPayload, callbackCh chan<- handlers.UserCallbackPayload) error {
 	h.mu.Lock()
-	h.savedCallbacks[msg.Body.MessageId] = &savedCallback{msg.Body.MessageId, callbackCh}
+	h.savedCallbacks[msg.Body.MessageId] = &savedCallback{msg.Body.MessageId, callbackCh, progressCh}
 	don := h.don
 	h.mu.Unlock()
 	body := msg.Body
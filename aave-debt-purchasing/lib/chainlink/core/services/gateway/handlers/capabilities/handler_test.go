@@ -312,6 +312,30 @@ func TestHandlerReceiveHTTPMessageFromClient(t *testing.T) {
 	// TODO: Validate Senders and rate limit chck, pending question in trigger about where senders and rate limits are validated
 }
 
+func TestHandlerReceiveStreamedHTTPMessageFromClient(t *testing.T) {
+	handler, _, don, _ := setupHandler(t)
+	ctx := testutils.Context(t)
+	msg := triggerRequest(t, privateKey1, `["daily_price_update"]`, "", "", "")
+
+	ch := make(chan handlers.UserCallbackPayload, defaultSendChannelBufferSize)
+	progressCh := make(chan handlers.UserProgressPayload, defaultSendChannelBufferSize)
+	don.On("SendToNode", mock.Anything, mock.Anything, msg).Return(nil).Twice()
+
+	err := handler.HandleStreamingUserMessage(ctx, msg, progressCh, ch)
+	require.NoError(t, err)
+	requireNoChanMsg(t, ch)
+	require.Empty(t, progressCh)
+
+	err = handler.HandleNodeMessage(ctx, msg, "")
+	require.NoError(t, err)
+
+	require.Equal(t, handlers.UserProgressPayload{Msg: msg, Received: 1, Required: 1}, <-progressCh)
+	resp := <-ch
+	require.Equal(t, handlers.UserCallbackPayload{Msg: msg, ErrCode: api.NoError, ErrMsg: ""}, resp)
+	_, open := <-ch
+	require.False(t, open)
+}
+
 func TestHandleComputeActionMessage(t *testing.T) {
 	handler, httpClient, don, nodes := setupHandler(t)
 	ctx := testutils.Context(t)
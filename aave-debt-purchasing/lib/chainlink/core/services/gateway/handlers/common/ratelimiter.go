@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"errors"
 	"sync"
 
@@ -13,6 +14,9 @@ type RateLimiter struct {
 	perSender map[string]*rate.Limiter
 	config    RateLimiterConfig
 	mu        sync.Mutex
+	// backend keeps the buckets, prefixed with name, if set
+	backend StateBackend
+	name    string
 }
 
 type RateLimiterConfig struct {
@@ -37,9 +41,29 @@ func NewRateLimiter(config RateLimiterConfig) (*RateLimiter, error) {
 	}, nil
 }
 
+// NewSharedRateLimiter returns a RateLimiter keeping its buckets in backend, to rate limit all
+// gateways sharing it. name identifies the buckets of the RateLimiter in backend.
+// The buckets of the RateLimiter itself are used whenever backend fails.
+func NewSharedRateLimiter(config RateLimiterConfig, backend StateBackend, name string) (*RateLimiter, error) {
+	rl, err := NewRateLimiter(config)
+	if err != nil {
+		return nil, err
+	}
+	rl.backend = backend
+	rl.name = name
+	return rl, nil
+}
+
 // Allow checks that the sender is not rate limited,
 // and that there is not a global rate limit.
 func (rl *RateLimiter) Allow(sender string) bool {
+	if rl.backend != nil {
+		senderAllow, globalAllow, err := rl.allowShared(sender, true)
+		if err == nil {
+			return senderAllow && globalAllow
+		}
+	}
+
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
@@ -56,6 +80,13 @@ func (rl *RateLimiter) Allow(sender string) bool {
 // and that there is not a global rate limit.
 // Returns if allowed as separate outputs.
 func (rl *RateLimiter) AllowVerbose(sender string) (senderAllow bool, globalAllow bool) {
+	if rl.backend != nil {
+		senderAllow, globalAllow, err := rl.allowShared(sender, false)
+		if err == nil {
+			return senderAllow, globalAllow
+		}
+	}
+
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
@@ -67,3 +98,16 @@ func (rl *RateLimiter) AllowVerbose(sender string) (senderAllow bool, globalAllo
 
 	return senderLimiter.Allow(), rl.global.Allow()
 }
+
+// allowShared takes tokens from the buckets in the backend. If skipGlobalIfLimited is true, no token
+// is taken from the global bucket when the sender is rate limited.
+func (rl *RateLimiter) allowShared(sender string, skipGlobalIfLimited bool) (senderAllow bool, globalAllow bool, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), stateBackendTimeout)
+	defer cancel()
+	senderAllow, err = rl.backend.TakeToken(ctx, rl.name+"/sender/"+sender, rl.config.PerSenderRPS, rl.config.PerSenderBurst)
+	if err != nil || (!senderAllow && skipGlobalIfLimited) {
+		return senderAllow, false, err
+	}
+	globalAllow, err = rl.backend.TakeToken(ctx, rl.name+"/global", rl.config.GlobalRPS, rl.config.GlobalBurst)
+	return senderAllow, globalAllow, err
+}
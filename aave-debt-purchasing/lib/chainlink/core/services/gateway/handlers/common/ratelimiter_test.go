@@ -1,7 +1,10 @@
 package common_test
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -25,3 +28,65 @@ func TestRateLimiter_PerSender(t *testing.T) {
 	require.False(t, rl.Allow("user1"))
 	require.False(t, rl.Allow("user3"))
 }
+
+func TestRateLimiter_Shared(t *testing.T) {
+	t.Parallel()
+
+	config := common.RateLimiterConfig{
+		GlobalRPS:      3.0,
+		GlobalBurst:    3,
+		PerSenderRPS:   1.0,
+		PerSenderBurst: 2,
+	}
+	backend := common.NewMemoryStateBackend()
+	rl1, err := common.NewSharedRateLimiter(config, backend, "limiter")
+	require.NoError(t, err)
+	rl2, err := common.NewSharedRateLimiter(config, backend, "limiter")
+	require.NoError(t, err)
+	other, err := common.NewSharedRateLimiter(config, backend, "other_limiter")
+	require.NoError(t, err)
+
+	// the buckets are shared by limiters with the same name
+	require.True(t, rl1.Allow("user1"))
+	require.True(t, rl2.Allow("user2"))
+	require.True(t, rl2.Allow("user1"))
+	require.False(t, rl1.Allow("user1"))
+	require.False(t, rl2.Allow("user3"))
+	require.True(t, other.Allow("user1"))
+}
+
+func TestRateLimiter_SharedBackendFailure(t *testing.T) {
+	t.Parallel()
+
+	config := common.RateLimiterConfig{
+		GlobalRPS:      3.0,
+		GlobalBurst:    3,
+		PerSenderRPS:   1.0,
+		PerSenderBurst: 2,
+	}
+	rl, err := common.NewSharedRateLimiter(config, failingStateBackend{}, "limiter")
+	require.NoError(t, err)
+
+	// the limiter falls back to its own buckets
+	require.True(t, rl.Allow("user1"))
+	require.True(t, rl.Allow("user1"))
+	require.False(t, rl.Allow("user1"))
+}
+
+type failingStateBackend struct{}
+
+func (failingStateBackend) TakeToken(context.Context, string, float64, int) (bool, error) {
+	return false, errors.New("backend failure")
+}
+
+func (failingStateBackend) ClaimRequest(context.Context, string, string, time.Time) (bool, error) {
+	return false, errors.New("backend failure")
+}
+
+func (failingStateBackend) ReleaseRequest(context.Context, string) error {
+	return errors.New("backend failure")
+}
+
+func (failingStateBackend) RequestOwner(context.Context, string) (string, error) {
+	return "", errors.New("backend failure")
+}
@@ -1,10 +1,14 @@
 package common
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 )
@@ -28,6 +32,10 @@ type requestCache[T any] struct {
 	maxCacheSize uint32
 	timeout      time.Duration
 	mu           sync.Mutex
+	// backend keeps the requests in flight, prefixed with namespace, if set
+	backend   StateBackend
+	namespace string
+	owner     string
 }
 
 type globalId struct {
@@ -46,6 +54,21 @@ func NewRequestCache[T any](timeout time.Duration, maxCacheSize uint32) RequestC
 	return &requestCache[T]{cache: make(map[globalId]*pendingRequest[T]), timeout: timeout, maxCacheSize: maxCacheSize}
 }
 
+// NewSharedRequestCache returns a RequestCache claiming its requests in backend, so that a request is
+// only in flight on one of the gateways sharing it. namespace identifies the requests of the cache in backend.
+// Requests are only checked against the cache itself whenever backend fails.
+// Responses are not forwarded between gateways, see ProcessResponse.
+func NewSharedRequestCache[T any](timeout time.Duration, maxCacheSize uint32, backend StateBackend, namespace string) RequestCache[T] {
+	return &requestCache[T]{
+		cache:        make(map[globalId]*pendingRequest[T]),
+		timeout:      timeout,
+		maxCacheSize: maxCacheSize,
+		backend:      backend,
+		namespace:    namespace,
+		owner:        uuid.NewString(),
+	}
+}
+
 func (c *requestCache[T]) NewRequest(request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error {
 	if request == nil {
 		return errors.New("request is nil")
@@ -54,6 +77,10 @@ func (c *requestCache[T]) NewRequest(request *api.Message, callbackCh chan<- han
 		return errors.New("responseData is nil")
 	}
 	key := globalId{request.Body.Sender, request.Body.MessageId}
+	claimed, err := c.claim(key)
+	if err != nil {
+		return err
+	}
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	_, ok := c.cache[key]
@@ -61,6 +88,9 @@ func (c *requestCache[T]) NewRequest(request *api.Message, callbackCh chan<- han
 		return errors.New("request already exists")
 	}
 	if len(c.cache) >= int(c.maxCacheSize) {
+		if claimed {
+			c.release(key)
+		}
 		return errors.New("request cache is full")
 	}
 	timer := time.AfterFunc(c.timeout, func() {
@@ -75,6 +105,9 @@ func (c *requestCache[T]) NewRequest(request *api.Message, callbackCh chan<- han
 //
 //	(a) remove request from cache and send aggregated response to the user
 //	(b) update request's responseData and keep it in cache, awaiting more responses from nodes
+//
+// Responses to a request in flight on another gateway sharing the cache are rejected rather than
+// forwarded, as nodes only send them to the gateway which sent the request.
 func (c *requestCache[T]) ProcessResponse(response *api.Message, process ResponseProcessor[T]) error {
 	if response == nil {
 		return errors.New("response is nil")
@@ -85,6 +118,9 @@ func (c *requestCache[T]) ProcessResponse(response *api.Message, process Respons
 	entry, ok := c.cache[key]
 	c.mu.Unlock()
 	if !ok {
+		if owner := c.getOwner(key); owner != "" && owner != c.owner {
+			return fmt.Errorf("request is in flight on another gateway: %s", owner)
+		}
 		return errors.New("request not found")
 	}
 	// process under per-entry lock
@@ -109,8 +145,55 @@ func (c *requestCache[T]) deleteAndSendOnce(key globalId, callbackResponse handl
 	delete(c.cache, key)
 	c.mu.Unlock()
 	if deleted {
+		c.release(key)
 		entry.timeoutTimer.Stop()
 		entry.callbackCh <- callbackResponse
 		close(entry.callbackCh)
 	}
 }
+
+func (c *requestCache[T]) backendKey(key globalId) string {
+	return c.namespace + "/" + key.sender + "/" + key.id
+}
+
+// claim claims the request in the backend, and returns true if it did.
+func (c *requestCache[T]) claim(key globalId) (bool, error) {
+	if c.backend == nil {
+		return false, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), stateBackendTimeout)
+	defer cancel()
+	claimed, err := c.backend.ClaimRequest(ctx, c.backendKey(key), c.owner, time.Now().Add(c.timeout))
+	if err != nil {
+		// only checked against the cache itself
+		return false, nil
+	}
+	if !claimed {
+		return false, errors.New("request already exists")
+	}
+	return true, nil
+}
+
+func (c *requestCache[T]) release(key globalId) {
+	if c.backend == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), stateBackendTimeout)
+	defer cancel()
+	// requests which fail to be released expire
+	_ = c.backend.ReleaseRequest(ctx, c.backendKey(key))
+}
+
+// getOwner returns the gateway the request is in flight on, if known.
+func (c *requestCache[T]) getOwner(key globalId) string {
+	if c.backend == nil {
+		return ""
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), stateBackendTimeout)
+	defer cancel()
+	owner, err := c.backend.RequestOwner(ctx, c.backendKey(key))
+	if err != nil {
+		return ""
+	}
+	return owner
+}
@@ -111,3 +111,30 @@ func TestRequestCache_MaxSize(t *testing.T) {
 	req.Body.MessageId = "cc"
 	require.Error(t, cache.NewRequest(req, callbackCh, initialState))
 }
+
+func TestRequestCache_Shared(t *testing.T) {
+	t.Parallel()
+
+	backend := common.NewMemoryStateBackend()
+	cache1 := common.NewSharedRequestCache[requestState](time.Hour, 1000, backend, "don")
+	cache2 := common.NewSharedRequestCache[requestState](time.Hour, 1000, backend, "don")
+	otherCache := common.NewSharedRequestCache[requestState](time.Hour, 1000, backend, "other_don")
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+
+	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
+	require.NoError(t, cache1.NewRequest(req, callbackCh, &requestState{}))
+	// the request can only be in flight on one of the caches sharing the backend
+	require.Error(t, cache2.NewRequest(req, make(chan handlers.UserCallbackPayload, 1), &requestState{}))
+	require.NoError(t, otherCache.NewRequest(req, make(chan handlers.UserCallbackPayload, 1), &requestState{}))
+
+	process := func(response *api.Message, responseData *requestState) (aggregated *handlers.UserCallbackPayload, newResponseData *requestState, err error) {
+		return &handlers.UserCallbackPayload{Msg: response}, nil, nil
+	}
+	nodeResp := &api.Message{Body: api.MessageBody{MessageId: "aa", Receiver: "0x1234"}}
+	require.ErrorContains(t, cache2.ProcessResponse(nodeResp, process), "in flight on another gateway")
+	require.NoError(t, cache1.ProcessResponse(nodeResp, process))
+	require.Equal(t, "aa", (<-callbackCh).Msg.Body.MessageId)
+
+	// completed requests are released
+	require.NoError(t, cache2.NewRequest(req, make(chan handlers.UserCallbackPayload, 1), &requestState{}))
+}
@@ -0,0 +1,119 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+const (
+	// MemoryStateBackendType keeps the state in process memory, for a single gateway.
+	MemoryStateBackendType = "memory"
+	// DatabaseStateBackendType keeps the state in the database, shared by all gateways using it.
+	DatabaseStateBackendType = "database"
+)
+
+// stateBackendTimeout bounds the calls to a StateBackend made by methods without a context.
+const stateBackendTimeout = time.Second
+
+// StateBackend stores the state of handlers which must be consistent across the replicas of a gateway
+// serving the same DON: rate limit buckets, and the requests in flight with the gateway they were sent to.
+// It does not route responses between gateways: nodes answer a request on their connection to the
+// gateway which sent it, so its responses always land on that gateway.
+// All methods are thread-safe.
+type StateBackend interface {
+	// TakeToken takes a token from the bucket key, refilled with rps tokens per second up to burst
+	// tokens, and returns false if the bucket is empty.
+	TakeToken(ctx context.Context, key string, rps float64, burst int) (bool, error)
+	// ClaimRequest marks the request key as in flight on owner until it is released or expires, and
+	// returns false if it already is in flight.
+	ClaimRequest(ctx context.Context, key string, owner string, expiry time.Time) (bool, error)
+	// ReleaseRequest marks the request key as no longer in flight.
+	ReleaseRequest(ctx context.Context, key string) error
+	// RequestOwner returns the owner of the request key, or an empty string if it is not in flight.
+	RequestOwner(ctx context.Context, key string) (string, error)
+}
+
+// NewStateBackend returns the StateBackend of backendType, which defaults to MemoryStateBackendType.
+func NewStateBackend(backendType string, ds sqlutil.DataSource) (StateBackend, error) {
+	switch backendType {
+	case "", MemoryStateBackendType:
+		return NewMemoryStateBackend(), nil
+	case DatabaseStateBackendType:
+		if ds == nil {
+			return nil, fmt.Errorf("state backend %s requires a database", backendType)
+		}
+		return NewORMStateBackend(ds), nil
+	default:
+		return nil, fmt.Errorf("unsupported state backend %s", backendType)
+	}
+}
+
+type memoryStateBackend struct {
+	buckets  map[string]*rate.Limiter
+	requests map[string]inFlightRequest
+	mu       sync.Mutex
+}
+
+type inFlightRequest struct {
+	owner  string
+	expiry time.Time
+}
+
+var _ StateBackend = (*memoryStateBackend)(nil)
+
+func NewMemoryStateBackend() StateBackend {
+	return &memoryStateBackend{
+		buckets:  make(map[string]*rate.Limiter),
+		requests: make(map[string]inFlightRequest),
+	}
+}
+
+func (b *memoryStateBackend) TakeToken(_ context.Context, key string, rps float64, burst int) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	limiter, ok := b.buckets[key]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(rps), burst)
+		b.buckets[key] = limiter
+	}
+	if limiter.Limit() != rate.Limit(rps) {
+		limiter.SetLimit(rate.Limit(rps))
+	}
+	if limiter.Burst() != burst {
+		limiter.SetBurst(burst)
+	}
+	return limiter.Allow(), nil
+}
+
+func (b *memoryStateBackend) ClaimRequest(_ context.Context, key string, owner string, expiry time.Time) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if request, ok := b.requests[key]; ok && time.Now().Before(request.expiry) {
+		return false, nil
+	}
+	b.requests[key] = inFlightRequest{owner: owner, expiry: expiry}
+	return true, nil
+}
+
+func (b *memoryStateBackend) ReleaseRequest(_ context.Context, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.requests, key)
+	return nil
+}
+
+func (b *memoryStateBackend) RequestOwner(_ context.Context, key string) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	request, ok := b.requests[key]
+	if !ok || !time.Now().Before(request.expiry) {
+		return "", nil
+	}
+	return request.owner, nil
+}
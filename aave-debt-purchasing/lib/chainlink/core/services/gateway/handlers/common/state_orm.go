@@ -0,0 +1,99 @@
+package common
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// statePruneInterval is how often each ormStateBackend deletes the rows it no longer needs.
+const statePruneInterval = time.Minute
+
+type ormStateBackend struct {
+	ds sqlutil.DataSource
+	// lastPruned is the time of the last pruning, in Unix nanoseconds
+	lastPruned atomic.Int64
+}
+
+var _ StateBackend = (*ormStateBackend)(nil)
+
+// NewORMStateBackend returns a StateBackend keeping the state in the database, to be shared by gateways using it.
+func NewORMStateBackend(ds sqlutil.DataSource) StateBackend {
+	return &ormStateBackend{ds: ds}
+}
+
+func (b *ormStateBackend) TakeToken(ctx context.Context, key string, rps float64, burst int) (bool, error) {
+	b.prune(ctx)
+	// The bucket is refilled for the time elapsed since it was last updated, and only updated if it
+	// has a token to take. A bucket without a row is full, so the row is pruned once full_at, the time
+	// at which the bucket is full again, has passed.
+	stmt := `
+		INSERT INTO gateway_rate_limit_buckets AS b (key, tokens, updated_at, full_at)
+		VALUES ($1, $3::double precision - 1, NOW(), NOW() + INTERVAL '1 second' / $2::double precision)
+		ON CONFLICT (key) DO UPDATE SET
+			tokens = LEAST($3, b.tokens + EXTRACT(EPOCH FROM NOW() - b.updated_at) * $2::double precision) - 1,
+			updated_at = NOW(),
+			full_at = NOW() + INTERVAL '1 second' * ($3 - LEAST($3, b.tokens + EXTRACT(EPOCH FROM NOW() - b.updated_at) * $2::double precision) + 1) / $2::double precision
+		WHERE LEAST($3, b.tokens + EXTRACT(EPOCH FROM NOW() - b.updated_at) * $2::double precision) >= 1
+		RETURNING tokens;`
+	var tokens float64
+	err := b.ds.GetContext(ctx, &tokens, stmt, key, rps, burst)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (b *ormStateBackend) ClaimRequest(ctx context.Context, key string, owner string, expiry time.Time) (bool, error) {
+	b.prune(ctx)
+	// Expired requests are claimed again
+	stmt := `
+		INSERT INTO gateway_requests AS r (key, owner, expires_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO UPDATE SET owner = EXCLUDED.owner, expires_at = EXCLUDED.expires_at
+		WHERE r.expires_at <= NOW()
+		RETURNING key;`
+	var claimed string
+	err := b.ds.GetContext(ctx, &claimed, stmt, key, owner, expiry)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (b *ormStateBackend) ReleaseRequest(ctx context.Context, key string) error {
+	_, err := b.ds.ExecContext(ctx, `DELETE FROM gateway_requests WHERE key = $1;`, key)
+	return err
+}
+
+func (b *ormStateBackend) RequestOwner(ctx context.Context, key string) (string, error) {
+	var owner string
+	err := b.ds.GetContext(ctx, &owner, `SELECT owner FROM gateway_requests WHERE key = $1 AND expires_at > NOW();`, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return owner, err
+}
+
+// prune deletes the full buckets and the expired requests, at most once per statePruneInterval across
+// the callers of the ormStateBackend. Rows which fail to be deleted are deleted by the next pruning,
+// possibly by another gateway.
+func (b *ormStateBackend) prune(ctx context.Context) {
+	last := b.lastPruned.Load()
+	now := time.Now().UnixNano()
+	if now-last < int64(statePruneInterval) || !b.lastPruned.CompareAndSwap(last, now) {
+		return
+	}
+	_, _ = b.ds.ExecContext(ctx, `DELETE FROM gateway_rate_limit_buckets WHERE full_at <= NOW();`)
+	_, _ = b.ds.ExecContext(ctx, `DELETE FROM gateway_requests WHERE expires_at <= NOW();`)
+}
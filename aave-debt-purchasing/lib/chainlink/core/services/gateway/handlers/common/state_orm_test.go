@@ -0,0 +1,109 @@
+package common_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+)
+
+func TestORMStateBackend_TakeToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	backend := common.NewORMStateBackend(pgtest.NewSqlxDB(t))
+
+	// the bucket starts full
+	for i := 0; i < 2; i++ {
+		allowed, err := backend.TakeToken(ctx, "bucket", 0.1, 2)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+	allowed, err := backend.TakeToken(ctx, "bucket", 0.1, 2)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	allowed, err = backend.TakeToken(ctx, "other_bucket", 0.1, 2)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	// the bucket is refilled over time
+	allowed, err = backend.TakeToken(ctx, "fast_bucket", 100, 1)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	require.Eventually(t, func() bool {
+		allowed, err = backend.TakeToken(ctx, "fast_bucket", 100, 1)
+		return err == nil && allowed
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestORMStateBackend_Requests(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	backend := common.NewORMStateBackend(pgtest.NewSqlxDB(t))
+
+	claimed, err := backend.ClaimRequest(ctx, "request", "gateway_1", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.True(t, claimed)
+	claimed, err = backend.ClaimRequest(ctx, "request", "gateway_2", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.False(t, claimed)
+
+	owner, err := backend.RequestOwner(ctx, "request")
+	require.NoError(t, err)
+	require.Equal(t, "gateway_1", owner)
+
+	require.NoError(t, backend.ReleaseRequest(ctx, "request"))
+	owner, err = backend.RequestOwner(ctx, "request")
+	require.NoError(t, err)
+	require.Empty(t, owner)
+
+	// expired requests can be claimed again
+	claimed, err = backend.ClaimRequest(ctx, "expired_request", "gateway_1", time.Now().Add(-time.Second))
+	require.NoError(t, err)
+	require.True(t, claimed)
+	claimed, err = backend.ClaimRequest(ctx, "expired_request", "gateway_2", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.True(t, claimed)
+	owner, err = backend.RequestOwner(ctx, "expired_request")
+	require.NoError(t, err)
+	require.Equal(t, "gateway_2", owner)
+}
+
+func TestORMStateBackend_Prune(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	backend := common.NewORMStateBackend(db)
+
+	claimed, err := backend.ClaimRequest(ctx, "expired_request", "gateway_1", time.Now().Add(-time.Second))
+	require.NoError(t, err)
+	require.True(t, claimed)
+	claimed, err = backend.ClaimRequest(ctx, "request", "gateway_1", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.True(t, claimed)
+	allowed, err := backend.TakeToken(ctx, "full_bucket", 100, 1)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowed, err = backend.TakeToken(ctx, "bucket", 0.1, 1)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	time.Sleep(100 * time.Millisecond)
+
+	// a new backend prunes on its first call
+	_, err = common.NewORMStateBackend(db).TakeToken(ctx, "other_bucket", 0.1, 1)
+	require.NoError(t, err)
+
+	var requests []string
+	require.NoError(t, db.SelectContext(ctx, &requests, `SELECT key FROM gateway_requests ORDER BY key;`))
+	require.Equal(t, []string{"request"}, requests)
+	var buckets []string
+	require.NoError(t, db.SelectContext(ctx, &buckets, `SELECT key FROM gateway_rate_limit_buckets ORDER BY key;`))
+	require.Equal(t, []string{"bucket", "other_bucket"}, buckets)
+}
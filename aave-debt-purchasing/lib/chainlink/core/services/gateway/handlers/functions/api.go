@@ -6,6 +6,8 @@ const (
 	MethodSecretsSet  = "secrets_set"
 	MethodSecretsList = "secrets_list"
 	MethodHeartbeat   = "heartbeat"
+	// MethodSecretsWatch is only supported by streamed requests
+	MethodSecretsWatch = "secrets_watch"
 )
 
 type SecretsSetRequest struct {
@@ -38,6 +40,29 @@ type SecretsListRow struct {
 	Expiration int64  `json:"expiration"`
 }
 
+type SecretsWatchRequest struct {
+	// Empty SlotIDs watch all slots
+	SlotIDs []uint `json:"slot_ids,omitempty"`
+	// DurationMillis needs to be shorter than the request timeout of the gateway
+	DurationMillis int64 `json:"duration_millis"`
+}
+
+// SecretsWatchResponse is sent by nodes for each batch of events, and once with Done set
+// when the watch ends.
+type SecretsWatchResponse struct {
+	ResponseBase
+	Events []SecretsWatchEvent `json:"events,omitempty"`
+	Done   bool                `json:"done,omitempty"`
+}
+
+type SecretsWatchEvent struct {
+	// Type is "updated" for new versions and "confirmed" once they are confirmed by the DON
+	Type       string `json:"type"`
+	SlotID     uint   `json:"slot_id"`
+	Version    uint64 `json:"version"`
+	Expiration int64  `json:"expiration"`
+}
+
 // Gateway -> User response, which combines responses from several nodes
 type CombinedResponse struct {
 	ResponseBase
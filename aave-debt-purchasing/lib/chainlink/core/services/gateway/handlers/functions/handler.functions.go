@@ -31,6 +31,7 @@ var (
 	ErrNotAllowlisted    = errors.New("sender not allowlisted")
 	ErrRateLimited       = errors.New("rate-limited")
 	ErrUnsupportedMethod = errors.New("unsupported method")
+	ErrStreamingRequired = errors.New("method requires a streamed request")
 
 	promHandlerError = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "gateway_functions_handler_error",
@@ -71,6 +72,12 @@ type FunctionsHandlerConfig struct {
 	MaxPendingRequests         uint32                `json:"maxPendingRequests"`
 	RequestTimeoutMillis       int64                 `json:"requestTimeoutMillis"`
 	AllowedHeartbeatInitiators []string              `json:"allowedHeartbeatInitiators"`
+	// StateBackend keeps the user rate limits and pending requests shared by all gateways serving the DON:
+	// "memory" (default) for a single gateway, or "database". Node rate limits are always per gateway,
+	// as nodes connect to each gateway, and responses are handled by the gateway which sent the request.
+	// With "database", each user request costs up to two database round trips for the rate limits and
+	// two for claiming and releasing the request, and each gateway prunes stale rows once a minute.
+	StateBackend string `json:"stateBackend"`
 }
 
 type functionsHandler struct {
@@ -95,9 +102,11 @@ type PendingRequest struct {
 	responses  map[string]*api.Message
 	successful []*api.Message
 	errors     []*api.Message
+	// progressCh is nil for requests which are not streamed
+	progressCh chan<- handlers.UserProgressPayload
 }
 
-var _ handlers.Handler = (*functionsHandler)(nil)
+var _ handlers.StreamingHandler = (*functionsHandler)(nil)
 
 func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, legacyChains legacyevm.LegacyChainContainer, ds sqlutil.DataSource, lggr logger.Logger) (handlers.Handler, error) {
 	var cfg FunctionsHandlerConfig
@@ -122,9 +131,13 @@ func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *con
 			return nil, err2
 		}
 	}
+	stateBackend, err := hc.NewStateBackend(cfg.StateBackend, ds)
+	if err != nil {
+		return nil, err
+	}
 	var userRateLimiter, nodeRateLimiter *hc.RateLimiter
 	if cfg.UserRateLimiter != nil {
-		userRateLimiter, err = hc.NewRateLimiter(*cfg.UserRateLimiter)
+		userRateLimiter, err = hc.NewSharedRateLimiter(*cfg.UserRateLimiter, stateBackend, "functions/"+donConfig.DonId+"/user")
 		if err != nil {
 			return nil, err
 		}
@@ -156,7 +169,7 @@ func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *con
 	for _, initiator := range cfg.AllowedHeartbeatInitiators {
 		allowedHeartbeatInitiators[strings.ToLower(initiator)] = struct{}{}
 	}
-	pendingRequestsCache := hc.NewRequestCache[PendingRequest](time.Millisecond*time.Duration(cfg.RequestTimeoutMillis), cfg.MaxPendingRequests)
+	pendingRequestsCache := hc.NewSharedRequestCache[PendingRequest](time.Millisecond*time.Duration(cfg.RequestTimeoutMillis), cfg.MaxPendingRequests, stateBackend, "functions/"+donConfig.DonId)
 	return NewFunctionsHandler(cfg, donConfig, don, pendingRequestsCache, allowlist, subscriptions, cfg.MinimumSubscriptionBalance, userRateLimiter, nodeRateLimiter, allowedHeartbeatInitiators, lggr), nil
 }
 
@@ -189,6 +202,15 @@ func NewFunctionsHandler(
 }
 
 func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
+	return h.handleUserMessage(ctx, msg, nil, callbackCh)
+}
+
+// HandleStreamingUserMessage sends each node response to the user as soon as it is received.
+func (h *functionsHandler) HandleStreamingUserMessage(ctx context.Context, msg *api.Message, progressCh chan<- handlers.UserProgressPayload, callbackCh chan<- handlers.UserCallbackPayload) error {
+	return h.handleUserMessage(ctx, msg, progressCh, callbackCh)
+}
+
+func (h *functionsHandler) handleUserMessage(ctx context.Context, msg *api.Message, progressCh chan<- handlers.UserProgressPayload, callbackCh chan<- handlers.UserCallbackPayload) error {
 	sender := common.HexToAddress(msg.Body.Sender)
 	if h.allowlist != nil && !h.allowlist.Allow(sender) {
 		h.lggr.Debugw("received a message from a non-allowlisted address", "sender", msg.Body.Sender)
@@ -215,14 +237,26 @@ func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *api.Messa
 	}
 	switch msg.Body.Method {
 	case MethodSecretsSet, MethodSecretsList:
-		return h.handleRequest(ctx, msg, callbackCh)
+		return h.handleRequest(ctx, msg, progressCh, callbackCh)
 	case MethodHeartbeat:
 		if _, ok := h.allowedHeartbeatInitiators[msg.Body.Sender]; !ok {
 			h.lggr.Debugw("received heartbeat request from a non-allowed sender", "sender", msg.Body.Sender)
 			promHandlerError.WithLabelValues(h.donConfig.DonId, ErrNotAllowlisted.Error()).Inc()
 			return ErrUnsupportedMethod
 		}
-		return h.handleRequest(ctx, msg, callbackCh)
+		return h.handleRequest(ctx, msg, progressCh, callbackCh)
+	case MethodSecretsWatch:
+		// events are only sent as progress
+		if progressCh == nil {
+			h.lggr.Debugw("received a secrets_watch request which is not streamed", "sender", msg.Body.Sender)
+			promHandlerError.WithLabelValues(h.donConfig.DonId, ErrStreamingRequired.Error()).Inc()
+			return ErrStreamingRequired
+		}
+		if err := h.validateSecretsWatchRequest(msg); err != nil {
+			h.lggr.Debugw("received an invalid secrets_watch request", "sender", msg.Body.Sender, "err", err)
+			return err
+		}
+		return h.handleRequest(ctx, msg, progressCh, callbackCh)
 	default:
 		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
 		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrUnsupportedMethod.Error()).Inc()
@@ -230,9 +264,24 @@ func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *api.Messa
 	}
 }
 
-func (h *functionsHandler) handleRequest(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
+func (h *functionsHandler) validateSecretsWatchRequest(msg *api.Message) error {
+	var request SecretsWatchRequest
+	if err := json.Unmarshal(msg.Body.Payload, &request); err != nil {
+		return fmt.Errorf("invalid secrets_watch request: %w", err)
+	}
+	if request.DurationMillis <= 0 {
+		return errors.New("watch duration must be positive")
+	}
+	// nodes need to end the watch before the request times out
+	if h.handlerConfig.RequestTimeoutMillis > 0 && request.DurationMillis >= h.handlerConfig.RequestTimeoutMillis {
+		return fmt.Errorf("watch duration must be shorter than the request timeout of %d ms", h.handlerConfig.RequestTimeoutMillis)
+	}
+	return nil
+}
+
+func (h *functionsHandler) handleRequest(ctx context.Context, msg *api.Message, progressCh chan<- handlers.UserProgressPayload, callbackCh chan<- handlers.UserCallbackPayload) error {
 	h.lggr.Debugw("handleRequest: processing message", "sender", msg.Body.Sender, "messageId", msg.Body.MessageId)
-	err := h.pendingRequests.NewRequest(msg, callbackCh, &PendingRequest{request: msg, responses: make(map[string]*api.Message)})
+	err := h.pendingRequests.NewRequest(msg, callbackCh, &PendingRequest{request: msg, responses: make(map[string]*api.Message), progressCh: progressCh})
 	if err != nil {
 		h.lggr.Warnw("handleRequest: error adding new request", "sender", msg.Body.Sender, "err", err)
 		promHandlerError.WithLabelValues(h.donConfig.DonId, err.Error()).Inc()
@@ -259,6 +308,8 @@ func (h *functionsHandler) HandleNodeMessage(ctx context.Context, msg *api.Messa
 		return h.pendingRequests.ProcessResponse(msg, h.processSecretsResponse)
 	case MethodHeartbeat:
 		return h.pendingRequests.ProcessResponse(msg, h.processHeartbeatResponse)
+	case MethodSecretsWatch:
+		return h.pendingRequests.ProcessResponse(msg, h.processSecretsWatchResponse)
 	default:
 		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
 		return ErrUnsupportedMethod
@@ -274,6 +325,7 @@ func (h *functionsHandler) processSecretsResponse(response *api.Message, respons
 		return nil, responseData, errors.New("invalid method")
 	}
 	responseData.responses[response.Body.Sender] = response
+	h.sendProgress(responseData, response)
 	var responsePayload ResponseBase
 	err := json.Unmarshal(response.Body.Payload, &responsePayload)
 	if err != nil {
@@ -300,6 +352,23 @@ func (h *functionsHandler) processSecretsResponse(response *api.Message, respons
 	return nil, responseData, nil
 }
 
+// Conforms to ResponseProcessor[*PendingRequest]
+func (h *functionsHandler) processSecretsWatchResponse(response *api.Message, responseData *PendingRequest) (*handlers.UserCallbackPayload, *PendingRequest, error) {
+	if response.Body.Method != responseData.request.Body.Method {
+		return nil, responseData, errors.New("invalid method")
+	}
+	var responsePayload SecretsWatchResponse
+	if err := json.Unmarshal(response.Body.Payload, &responsePayload); err == nil && !responsePayload.Done {
+		if _, exists := responseData.responses[response.Body.Sender]; exists {
+			return nil, responseData, errors.New("events received after the end of the watch")
+		}
+		// events are streamed as they are received, until the watch ended on F+1 nodes
+		h.sendProgress(responseData, response)
+		return nil, responseData, nil
+	}
+	return h.processSecretsResponse(response, responseData)
+}
+
 func newSecretsResponse(request *api.Message, success bool, responses []*api.Message) (*handlers.UserCallbackPayload, error) {
 	payload := CombinedResponse{ResponseBase: ResponseBase{Success: success}, NodeResponses: responses}
 	payloadJson, err := json.Marshal(payload)
@@ -336,6 +405,7 @@ func (h *functionsHandler) processHeartbeatResponse(response *api.Message, respo
 		return nil, responseData, errors.New("invalid method")
 	}
 	responseData.responses[response.Body.Sender] = response
+	h.sendProgress(responseData, response)
 
 	// user response is ready with F+1 node responses
 	if len(responseData.responses) >= h.donConfig.F+1 {
@@ -359,6 +429,15 @@ func (h *functionsHandler) processHeartbeatResponse(response *api.Message, respo
 	return nil, responseData, nil
 }
 
+// sendProgress sends the response of a node to streamed requests.
+func (h *functionsHandler) sendProgress(responseData *PendingRequest, response *api.Message) {
+	handlers.SendProgress(responseData.progressCh, handlers.UserProgressPayload{
+		Msg:      response,
+		Received: len(responseData.responses),
+		Required: h.donConfig.F + 1,
+	})
+}
+
 func (h *functionsHandler) Start(ctx context.Context) error {
 	return h.StartOnce("FunctionsHandler", func() error {
 		h.lggr.Info("starting FunctionsHandler")
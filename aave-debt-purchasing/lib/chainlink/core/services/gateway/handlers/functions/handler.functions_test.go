@@ -189,6 +189,114 @@ func TestFunctionsHandler_HandleUserMessage_Heartbeat(t *testing.T) {
 	}
 }
 
+func TestFunctionsHandler_HandleStreamingUserMessage(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, don, allowlist, _ := newFunctionsHandlerForATestDON(t, nodes, time.Hour*24, user.Address)
+	userRequestMsg := newSignedMessage(t, "1234", "heartbeat", "don_id", user.PrivateKey)
+
+	progressCh := make(chan handlers.UserProgressPayload, 10)
+	callbachCh := make(chan handlers.UserCallbackPayload)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// wait on a response from Gateway to the user
+		response := <-callbachCh
+		require.Equal(t, api.NoError, response.ErrCode)
+		require.Equal(t, userRequestMsg.Body.MessageId, response.Msg.Body.MessageId)
+	}()
+
+	allowlist.On("Allow", common.HexToAddress(user.Address)).Return(true, nil)
+	don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	streamingHandler, ok := handler.(handlers.StreamingHandler)
+	require.True(t, ok)
+	require.NoError(t, streamingHandler.HandleStreamingUserMessage(testutils.Context(t), &userRequestMsg, progressCh, callbachCh))
+	sendNodeReponses(t, handler, userRequestMsg, nodes, []bool{true, false, true, true})
+	<-done
+
+	// each node response is streamed until the final response is ready
+	require.Len(t, progressCh, 2)
+	for id := range 2 {
+		progress := <-progressCh
+		require.Equal(t, id+1, progress.Received)
+		require.Equal(t, 2, progress.Required)
+		require.Equal(t, nodes[id].Address, progress.Msg.Body.Sender)
+	}
+}
+
+func TestFunctionsHandler_HandleStreamingUserMessage_SecretsWatch(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, don, allowlist, _ := newFunctionsHandlerForATestDON(t, nodes, time.Hour*24, user.Address)
+	streamingHandler, ok := handler.(handlers.StreamingHandler)
+	require.True(t, ok)
+	allowlist.On("Allow", common.HexToAddress(user.Address)).Return(true, nil)
+	newWatchMessage := func(payload string) api.Message {
+		msg := api.Message{
+			Body: api.MessageBody{
+				MessageId: "1234",
+				Method:    functions.MethodSecretsWatch,
+				DonId:     "don_id",
+				Payload:   json.RawMessage(payload),
+			},
+		}
+		require.NoError(t, msg.Sign(user.PrivateKey))
+		return msg
+	}
+
+	t.Run("not streamed", func(t *testing.T) {
+		userRequestMsg := newWatchMessage(`{"duration_millis":1000}`)
+		err := handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, make(chan handlers.UserCallbackPayload))
+		require.ErrorIs(t, err, functions.ErrStreamingRequired)
+	})
+
+	t.Run("invalid duration", func(t *testing.T) {
+		userRequestMsg := newWatchMessage(`{"duration_millis":0}`)
+		err := streamingHandler.HandleStreamingUserMessage(testutils.Context(t), &userRequestMsg, make(chan handlers.UserProgressPayload), make(chan handlers.UserCallbackPayload))
+		require.Error(t, err)
+	})
+
+	t.Run("events", func(t *testing.T) {
+		userRequestMsg := newWatchMessage(`{"slot_ids":[1],"duration_millis":1000}`)
+		progressCh := make(chan handlers.UserProgressPayload, 10)
+		callbachCh := make(chan handlers.UserCallbackPayload, 1)
+		don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		require.NoError(t, streamingHandler.HandleStreamingUserMessage(testutils.Context(t), &userRequestMsg, progressCh, callbachCh))
+
+		sendNodeResponse := func(node gc.TestNode, payload string) error {
+			nodeResponseMsg := userRequestMsg
+			nodeResponseMsg.Body.Receiver = userRequestMsg.Body.Sender
+			nodeResponseMsg.Body.Payload = json.RawMessage(payload)
+			require.NoError(t, nodeResponseMsg.Sign(node.PrivateKey))
+			return handler.HandleNodeMessage(testutils.Context(t), &nodeResponseMsg, node.Address)
+		}
+		events := `{"success":true,"events":[{"type":"confirmed","slot_id":1,"version":2,"expiration":3}]}`
+		require.NoError(t, sendNodeResponse(nodes[0], events))
+		require.NoError(t, sendNodeResponse(nodes[1], events))
+		require.NoError(t, sendNodeResponse(nodes[0], `{"success":true,"done":true}`))
+		require.Error(t, sendNodeResponse(nodes[0], events))
+		require.NoError(t, sendNodeResponse(nodes[1], `{"success":true,"done":true}`))
+
+		// the watch ends once it ended on F+1 nodes
+		response := <-callbachCh
+		require.Equal(t, api.NoError, response.ErrCode)
+		var payload functions.CombinedResponse
+		require.NoError(t, json.Unmarshal(response.Msg.Body.Payload, &payload))
+		require.True(t, payload.Success)
+		require.Len(t, payload.NodeResponses, 2)
+
+		// events and ends of the watch are streamed
+		require.Len(t, progressCh, 4)
+		for id := range 2 {
+			progress := <-progressCh
+			require.Equal(t, nodes[id].Address, progress.Msg.Body.Sender)
+			require.JSONEq(t, events, string(progress.Msg.Body.Payload))
+		}
+	})
+}
+
 func TestFunctionsHandler_HandleUserMessage_InvalidMethod(t *testing.T) {
 	t.Parallel()
 
@@ -15,6 +15,17 @@ type UserCallbackPayload struct {
 	ErrMsg  string
 }
 
+// UserProgressPayload reports the progress of a user request sent to HandleStreamingUserMessage(),
+// before its UserCallbackPayload.
+type UserProgressPayload struct {
+	// Msg is a partial response received from a node, if any.
+	Msg *api.Message
+	// Received is the number of node responses received so far, out of the Required ones
+	// for the final response. Required is 0 if unknown.
+	Received int
+	Required int
+}
+
 // Handler implements service-specific logic for managing messages from users and nodes.
 // There is one Handler object created for each DON.
 //
@@ -36,6 +47,28 @@ type Handler interface {
 	HandleNodeMessage(ctx context.Context, msg *api.Message, nodeAddr string) error
 }
 
+// StreamingHandler is a Handler reporting the progress of user requests.
+type StreamingHandler interface {
+	Handler
+
+	// HandleStreamingUserMessage is like HandleUserMessage, and also sends the progress of the
+	// request on progressCh until its response is sent on callbackCh.
+	// Sends on progressCh must not block, see SendProgress.
+	HandleStreamingUserMessage(ctx context.Context, msg *api.Message, progressCh chan<- UserProgressPayload, callbackCh chan<- UserCallbackPayload) error
+}
+
+// SendProgress sends progress on progressCh without blocking. Progress is dropped if progressCh
+// is nil or full.
+func SendProgress(progressCh chan<- UserProgressPayload, progress UserProgressPayload) {
+	if progressCh == nil {
+		return
+	}
+	select {
+	case progressCh <- progress:
+	default:
+	}
+}
+
 // Representation of a DON from a Handler's perspective.
 type DON interface {
 	// Thread-safe
@@ -0,0 +1,104 @@
+package network
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Names of the Server-Sent Events sent to streamed requests.
+const (
+	// StreamEventPartial carries a partial response received from a node.
+	StreamEventPartial = "partial"
+	// StreamEventProgress carries the progress of the request.
+	StreamEventProgress = "progress"
+	// StreamEventResult carries the final response and ends the stream.
+	StreamEventResult = "result"
+)
+
+// streamHeartbeatInterval is the interval of the comments keeping idle streams open through proxies.
+const streamHeartbeatInterval = 15 * time.Second
+
+// StreamEvent is a Server-Sent Event sent to a streamed request.
+type StreamEvent struct {
+	Name string
+	Data []byte
+}
+
+// eventStream writes Server-Sent Events to a response. All methods are thread-safe.
+type eventStream struct {
+	mu           sync.Mutex
+	w            http.ResponseWriter
+	rc           *http.ResponseController
+	writeTimeout time.Duration
+}
+
+func newEventStream(w http.ResponseWriter, writeTimeout time.Duration) *eventStream {
+	return &eventStream{w: w, rc: http.NewResponseController(w), writeTimeout: writeTimeout}
+}
+
+// open sends the headers of the stream.
+func (e *eventStream) open() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.w.Header().Set("Content-Type", "text/event-stream")
+	e.w.Header().Set("Cache-Control", "no-cache")
+	e.w.Header().Set("X-Accel-Buffering", "no")
+	e.w.WriteHeader(http.StatusOK)
+	return e.rc.Flush()
+}
+
+func (e *eventStream) send(event StreamEvent) error {
+	var b bytes.Buffer
+	b.WriteString("event: " + event.Name + "\n")
+	// Each line of the data needs its own field
+	for _, line := range bytes.Split(event.Data, []byte("\n")) {
+		b.WriteString("data: ")
+		b.Write(line)
+		b.WriteByte('\n')
+	}
+	b.WriteByte('\n')
+	return e.write(b.Bytes())
+}
+
+// startHeartbeat sends a comment every interval until the returned function is called.
+func (e *eventStream) startHeartbeat(interval time.Duration) (stop func()) {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := e.write([]byte(": heartbeat\n\n")); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
+
+func (e *eventStream) write(b []byte) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	// The write timeout of the server applies to each event instead of the whole stream
+	if e.writeTimeout > 0 {
+		if err := e.rc.SetWriteDeadline(time.Now().Add(e.writeTimeout)); err != nil {
+			return err
+		}
+	}
+	if _, err := e.w.Write(b); err != nil {
+		return err
+	}
+	return e.rc.Flush()
+}
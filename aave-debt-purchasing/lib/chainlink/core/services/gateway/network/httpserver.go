@@ -27,6 +27,16 @@ type HTTPRequestHandler interface {
 	ProcessRequest(ctx context.Context, rawRequest []byte) (rawResponse []byte, httpStatusCode int)
 }
 
+// StreamingHTTPRequestHandler is an HTTPRequestHandler which can also stream the progress of requests
+// sent to HTTPServerConfig.StreamPath.
+type StreamingHTTPRequestHandler interface {
+	HTTPRequestHandler
+
+	// ProcessStreamingRequest calls send for each progress event of the request, and returns its
+	// final response. httpStatusCode is only used if the response is not streamed.
+	ProcessStreamingRequest(ctx context.Context, rawRequest []byte, send func(StreamEvent) error) (rawResponse []byte, httpStatusCode int)
+}
+
 type HTTPServerConfig struct {
 	Host                 string
 	Port                 uint16
@@ -39,6 +49,11 @@ type HTTPServerConfig struct {
 	WriteTimeoutMillis   uint32
 	RequestTimeoutMillis uint32
 	MaxRequestBytes      int64
+	// StreamPath serves the requests whose progress is streamed back as Server-Sent Events, if the
+	// handler supports it. Disabled if empty.
+	StreamPath string
+	// StreamRequestTimeoutMillis is the timeout of streamed requests. Defaults to RequestTimeoutMillis.
+	StreamRequestTimeoutMillis uint32
 }
 
 type httpServer struct {
@@ -68,6 +83,9 @@ func NewHttpServer(config *HTTPServerConfig, lggr logger.Logger) HttpServer {
 	mux := http.NewServeMux()
 	mux.Handle(config.Path, http.HandlerFunc(server.handleRequest))
 	mux.Handle(HealthCheckPath, http.HandlerFunc(server.handleHealthCheck))
+	if config.StreamPath != "" {
+		mux.Handle(config.StreamPath, http.HandlerFunc(server.handleStreamRequest))
+	}
 	server.server = &http.Server{
 		Addr:              fmt.Sprintf("%s:%d", config.Host, config.Port),
 		Handler:           mux,
@@ -112,6 +130,45 @@ func (s *httpServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (s *httpServer) handleStreamRequest(w http.ResponseWriter, r *http.Request) {
+	handler, ok := s.handler.(StreamingHTTPRequestHandler)
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	source := http.MaxBytesReader(nil, r.Body, s.config.MaxRequestBytes)
+	rawMessage, err := io.ReadAll(source)
+	if err != nil {
+		s.lggr.Error("error reading request", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	requestCtx := r.Context()
+	timeoutMillis := s.config.StreamRequestTimeoutMillis
+	if timeoutMillis == 0 {
+		timeoutMillis = s.config.RequestTimeoutMillis
+	}
+	if timeoutMillis > 0 {
+		var cancel context.CancelFunc
+		requestCtx, cancel = context.WithTimeout(requestCtx, time.Duration(timeoutMillis)*time.Millisecond)
+		defer cancel()
+	}
+
+	stream := newEventStream(w, time.Duration(s.config.WriteTimeoutMillis)*time.Millisecond)
+	if err = stream.open(); err != nil {
+		s.lggr.Error("error when opening event stream", err)
+		return
+	}
+	stopHeartbeat := stream.startHeartbeat(streamHeartbeatInterval)
+	rawResponse, _ := handler.ProcessStreamingRequest(requestCtx, rawMessage, stream.send)
+	stopHeartbeat()
+
+	if err = stream.send(StreamEvent{Name: StreamEventResult, Data: rawResponse}); err != nil {
+		s.lggr.Error("error when writing response", err)
+	}
+}
+
 func (s *httpServer) SetHTTPRequestHandler(handler HTTPRequestHandler) {
 	s.handler = handler
 }
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -18,8 +19,9 @@ import (
 )
 
 const (
-	HTTPTestHost = "localhost"
-	HTTPTestPath = "/test_path"
+	HTTPTestHost       = "localhost"
+	HTTPTestPath       = "/test_path"
+	HTTPTestStreamPath = "/test_stream_path"
 )
 
 func startNewServer(t *testing.T, maxRequestBytes int64, readTimeoutMillis uint32) (server network.HttpServer, handler *mocks.HTTPRequestHandler, url string) {
@@ -90,3 +92,53 @@ func TestHTTPServer_HandleHealthCheck(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 	require.Equal(t, []byte(network.HealthCheckResponse), respBytes)
 }
+
+func TestHTTPServer_HandleStreamRequest(t *testing.T) {
+	t.Parallel()
+
+	config := &network.HTTPServerConfig{
+		Host:                 HTTPTestHost,
+		Port:                 0,
+		Path:                 HTTPTestPath,
+		StreamPath:           HTTPTestStreamPath,
+		ContentTypeHeader:    "application/jsonrpc",
+		ReadTimeoutMillis:    100_000,
+		WriteTimeoutMillis:   10_000,
+		RequestTimeoutMillis: 10_000,
+		MaxRequestBytes:      100_000,
+	}
+	handler := mocks.NewStreamingHTTPRequestHandler(t)
+	server := network.NewHttpServer(config, logger.TestLogger(t))
+	server.SetHTTPRequestHandler(handler)
+	require.NoError(t, server.Start(testutils.Context(t)))
+	defer server.Close()
+
+	handler.On("ProcessStreamingRequest", mock.Anything, []byte("0123456789"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			send := args.Get(2).(func(network.StreamEvent) error)
+			assert.NoError(t, send(network.StreamEvent{Name: network.StreamEventProgress, Data: []byte(`{"received":1}`)}))
+			assert.NoError(t, send(network.StreamEvent{Name: network.StreamEventPartial, Data: []byte("line1\nline2")}))
+		}).
+		Return([]byte("response"), 200)
+
+	url := fmt.Sprintf("http://%s:%d%s", HTTPTestHost, server.GetPort(), HTTPTestStreamPath)
+	resp := sendRequest(t, url, []byte("0123456789"))
+	respBytes, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	require.Equal(t, "event: progress\ndata: {\"received\":1}\n\n"+
+		"event: partial\ndata: line1\ndata: line2\n\n"+
+		"event: result\ndata: response\n\n", string(respBytes))
+}
+
+func TestHTTPServer_HandleStreamRequest_Disabled(t *testing.T) {
+	t.Parallel()
+	server, _, url := startNewServer(t, 100_000, 100_000)
+	defer server.Close()
+
+	// the stream path is not served unless configured
+	url = strings.Replace(url, HTTPTestPath, HTTPTestStreamPath, 1)
+	resp := sendRequest(t, url, []byte("0123456789"))
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
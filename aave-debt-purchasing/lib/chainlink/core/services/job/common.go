@@ -16,6 +16,14 @@ type ServiceCtx interface {
 	Close() error
 }
 
+// ReloadableServiceCtx is a ServiceCtx able to apply the spec of its updated job while running.
+type ReloadableServiceCtx interface {
+	ServiceCtx
+	// Reload applies the configuration of next, the unstarted service created for the updated job.
+	// If it returns an error, the service is restarted by replacing it with next instead.
+	Reload(ctx context.Context, next ServiceCtx) error
+}
+
 type Config interface {
 	URL() url.URL
 }
@@ -1844,6 +1844,48 @@ func Test_FindSpecErrorsByJobIDs(t *testing.T) {
 	assert.Len(t, specErrs, 2)
 }
 
+func Test_SpecVersions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	config := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db)
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config.JobPipeline().MaxSuccessfulRuns())
+	bridgesORM := bridges.NewORM(db)
+	orm := NewTestORM(t, db, pipelineORM, bridgesORM, keyStore)
+
+	jb, err := directrequest.ValidatedDirectRequestSpec(testspecs.GetDirectRequestSpec())
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateJob(ctx, &jb))
+
+	v1, err := orm.InsertSpecVersion(ctx, jb.ID, "spec 1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), v1.Version)
+	v2, err := orm.InsertSpecVersion(ctx, jb.ID, "spec 2")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), v2.Version)
+
+	versions, err := orm.FindSpecVersions(ctx, jb.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "spec 1", versions[0].Spec)
+	assert.Equal(t, "spec 2", versions[1].Spec)
+
+	v, err := orm.FindSpecVersion(ctx, jb.ID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, v2.ID, v.ID)
+	assert.Equal(t, "spec 2", v.Spec)
+	_, err = orm.FindSpecVersion(ctx, jb.ID, 3)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, orm.DeleteSpecVersions(ctx, jb.ID))
+	versions, err = orm.FindSpecVersions(ctx, jb.ID)
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+}
+
 func Test_CountPipelineRunsByJobID(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
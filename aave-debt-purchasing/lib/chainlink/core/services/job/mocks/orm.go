@@ -325,6 +325,53 @@ func (_c *ORM_DeleteJob_Call) RunAndReturn(run func(context.Context, int32, job.
 	return _c
 }
 
+// DeleteSpecVersions provides a mock function with given fields: ctx, jobID
+func (_m *ORM) DeleteSpecVersions(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSpecVersions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_DeleteSpecVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSpecVersions'
+type ORM_DeleteSpecVersions_Call struct {
+	*mock.Call
+}
+
+// DeleteSpecVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *ORM_Expecter) DeleteSpecVersions(ctx interface{}, jobID interface{}) *ORM_DeleteSpecVersions_Call {
+	return &ORM_DeleteSpecVersions_Call{Call: _e.mock.On("DeleteSpecVersions", ctx, jobID)}
+}
+
+func (_c *ORM_DeleteSpecVersions_Call) Run(run func(ctx context.Context, jobID int32)) *ORM_DeleteSpecVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_DeleteSpecVersions_Call) Return(_a0 error) *ORM_DeleteSpecVersions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_DeleteSpecVersions_Call) RunAndReturn(run func(context.Context, int32) error) *ORM_DeleteSpecVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DismissError provides a mock function with given fields: ctx, errorID
 func (_m *ORM) DismissError(ctx context.Context, errorID int64) error {
 	ret := _m.Called(ctx, errorID)
@@ -1365,6 +1412,123 @@ func (_c *ORM_FindSpecErrorsByJobIDs_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// FindSpecVersion provides a mock function with given fields: ctx, jobID, version
+func (_m *ORM) FindSpecVersion(ctx context.Context, jobID int32, version int32) (job.SpecVersion, error) {
+	ret := _m.Called(ctx, jobID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSpecVersion")
+	}
+
+	var r0 job.SpecVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) (job.SpecVersion, error)); ok {
+		return rf(ctx, jobID, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) job.SpecVersion); ok {
+		r0 = rf(ctx, jobID, version)
+	} else {
+		r0 = ret.Get(0).(job.SpecVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int32) error); ok {
+		r1 = rf(ctx, jobID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindSpecVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSpecVersion'
+type ORM_FindSpecVersion_Call struct {
+	*mock.Call
+}
+
+// FindSpecVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - version int32
+func (_e *ORM_Expecter) FindSpecVersion(ctx interface{}, jobID interface{}, version interface{}) *ORM_FindSpecVersion_Call {
+	return &ORM_FindSpecVersion_Call{Call: _e.mock.On("FindSpecVersion", ctx, jobID, version)}
+}
+
+func (_c *ORM_FindSpecVersion_Call) Run(run func(ctx context.Context, jobID int32, version int32)) *ORM_FindSpecVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_FindSpecVersion_Call) Return(_a0 job.SpecVersion, _a1 error) *ORM_FindSpecVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindSpecVersion_Call) RunAndReturn(run func(context.Context, int32, int32) (job.SpecVersion, error)) *ORM_FindSpecVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSpecVersions provides a mock function with given fields: ctx, jobID
+func (_m *ORM) FindSpecVersions(ctx context.Context, jobID int32) ([]job.SpecVersion, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSpecVersions")
+	}
+
+	var r0 []job.SpecVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]job.SpecVersion, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []job.SpecVersion); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.SpecVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindSpecVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSpecVersions'
+type ORM_FindSpecVersions_Call struct {
+	*mock.Call
+}
+
+// FindSpecVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *ORM_Expecter) FindSpecVersions(ctx interface{}, jobID interface{}) *ORM_FindSpecVersions_Call {
+	return &ORM_FindSpecVersions_Call{Call: _e.mock.On("FindSpecVersions", ctx, jobID)}
+}
+
+func (_c *ORM_FindSpecVersions_Call) Run(run func(ctx context.Context, jobID int32)) *ORM_FindSpecVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_FindSpecVersions_Call) Return(_a0 []job.SpecVersion, _a1 error) *ORM_FindSpecVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindSpecVersions_Call) RunAndReturn(run func(context.Context, int32) ([]job.SpecVersion, error)) *ORM_FindSpecVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindStandardCapabilityJobID provides a mock function with given fields: ctx, spec
 func (_m *ORM) FindStandardCapabilityJobID(ctx context.Context, spec job.StandardCapabilitiesSpec) (int32, error) {
 	ret := _m.Called(ctx, spec)
@@ -1529,6 +1693,64 @@ func (_c *ORM_InsertJob_Call) RunAndReturn(run func(context.Context, *job.Job) e
 	return _c
 }
 
+// InsertSpecVersion provides a mock function with given fields: ctx, jobID, spec
+func (_m *ORM) InsertSpecVersion(ctx context.Context, jobID int32, spec string) (job.SpecVersion, error) {
+	ret := _m.Called(ctx, jobID, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertSpecVersion")
+	}
+
+	var r0 job.SpecVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string) (job.SpecVersion, error)); ok {
+		return rf(ctx, jobID, spec)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string) job.SpecVersion); ok {
+		r0 = rf(ctx, jobID, spec)
+	} else {
+		r0 = ret.Get(0).(job.SpecVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, string) error); ok {
+		r1 = rf(ctx, jobID, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_InsertSpecVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertSpecVersion'
+type ORM_InsertSpecVersion_Call struct {
+	*mock.Call
+}
+
+// InsertSpecVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - spec string
+func (_e *ORM_Expecter) InsertSpecVersion(ctx interface{}, jobID interface{}, spec interface{}) *ORM_InsertSpecVersion_Call {
+	return &ORM_InsertSpecVersion_Call{Call: _e.mock.On("InsertSpecVersion", ctx, jobID, spec)}
+}
+
+func (_c *ORM_InsertSpecVersion_Call) Run(run func(ctx context.Context, jobID int32, spec string)) *ORM_InsertSpecVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ORM_InsertSpecVersion_Call) Return(_a0 job.SpecVersion, _a1 error) *ORM_InsertSpecVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_InsertSpecVersion_Call) RunAndReturn(run func(context.Context, int32, string) (job.SpecVersion, error)) *ORM_InsertSpecVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InsertWebhookSpec provides a mock function with given fields: ctx, webhookSpec
 func (_m *ORM) InsertWebhookSpec(ctx context.Context, webhookSpec *job.WebhookSpec) error {
 	ret := _m.Called(ctx, webhookSpec)
@@ -1576,6 +1798,53 @@ func (_c *ORM_InsertWebhookSpec_Call) RunAndReturn(run func(context.Context, *jo
 	return _c
 }
 
+// PauseJob provides a mock function with given fields: ctx, id
+func (_m *ORM) PauseJob(ctx context.Context, id int32) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type ORM_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *ORM_Expecter) PauseJob(ctx interface{}, id interface{}) *ORM_PauseJob_Call {
+	return &ORM_PauseJob_Call{Call: _e.mock.On("PauseJob", ctx, id)}
+}
+
+func (_c *ORM_PauseJob_Call) Run(run func(ctx context.Context, id int32)) *ORM_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_PauseJob_Call) Return(_a0 error) *ORM_PauseJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_PauseJob_Call) RunAndReturn(run func(context.Context, int32) error) *ORM_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PipelineRuns provides a mock function with given fields: ctx, jobID, offset, size
 func (_m *ORM) PipelineRuns(ctx context.Context, jobID *int32, offset int, size int) ([]pipeline.Run, int, error) {
 	ret := _m.Called(ctx, jobID, offset, size)
@@ -1692,6 +1961,53 @@ func (_c *ORM_RecordError_Call) RunAndReturn(run func(context.Context, int32, st
 	return _c
 }
 
+// ResumeJob provides a mock function with given fields: ctx, id
+func (_m *ORM) ResumeJob(ctx context.Context, id int32) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type ORM_ResumeJob_Call struct {
+	*mock.Call
+}
+
+// ResumeJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *ORM_Expecter) ResumeJob(ctx interface{}, id interface{}) *ORM_ResumeJob_Call {
+	return &ORM_ResumeJob_Call{Call: _e.mock.On("ResumeJob", ctx, id)}
+}
+
+func (_c *ORM_ResumeJob_Call) Run(run func(ctx context.Context, id int32)) *ORM_ResumeJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_ResumeJob_Call) Return(_a0 error) *ORM_ResumeJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_ResumeJob_Call) RunAndReturn(run func(context.Context, int32) error) *ORM_ResumeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TryRecordError provides a mock function with given fields: ctx, jobID, description
 func (_m *ORM) TryRecordError(ctx context.Context, jobID int32, description string) {
 	_m.Called(ctx, jobID, description)
@@ -304,6 +304,54 @@ func (_c *Spawner_Name_Call) RunAndReturn(run func() string) *Spawner_Name_Call
 	return _c
 }
 
+// PauseJob provides a mock function with given fields: ctx, ds, jobID
+func (_m *Spawner) PauseJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error {
+	ret := _m.Called(ctx, ds, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlutil.DataSource, int32) error); ok {
+		r0 = rf(ctx, ds, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type Spawner_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ds sqlutil.DataSource
+//   - jobID int32
+func (_e *Spawner_Expecter) PauseJob(ctx interface{}, ds interface{}, jobID interface{}) *Spawner_PauseJob_Call {
+	return &Spawner_PauseJob_Call{Call: _e.mock.On("PauseJob", ctx, ds, jobID)}
+}
+
+func (_c *Spawner_PauseJob_Call) Run(run func(ctx context.Context, ds sqlutil.DataSource, jobID int32)) *Spawner_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlutil.DataSource), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_PauseJob_Call) Return(_a0 error) *Spawner_PauseJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_PauseJob_Call) RunAndReturn(run func(context.Context, sqlutil.DataSource, int32) error) *Spawner_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Ready provides a mock function with no fields
 func (_m *Spawner) Ready() error {
 	ret := _m.Called()
@@ -349,6 +397,54 @@ func (_c *Spawner_Ready_Call) RunAndReturn(run func() error) *Spawner_Ready_Call
 	return _c
 }
 
+// ResumeJob provides a mock function with given fields: ctx, ds, jobID
+func (_m *Spawner) ResumeJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error {
+	ret := _m.Called(ctx, ds, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlutil.DataSource, int32) error); ok {
+		r0 = rf(ctx, ds, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type Spawner_ResumeJob_Call struct {
+	*mock.Call
+}
+
+// ResumeJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ds sqlutil.DataSource
+//   - jobID int32
+func (_e *Spawner_Expecter) ResumeJob(ctx interface{}, ds interface{}, jobID interface{}) *Spawner_ResumeJob_Call {
+	return &Spawner_ResumeJob_Call{Call: _e.mock.On("ResumeJob", ctx, ds, jobID)}
+}
+
+func (_c *Spawner_ResumeJob_Call) Run(run func(ctx context.Context, ds sqlutil.DataSource, jobID int32)) *Spawner_ResumeJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlutil.DataSource), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_ResumeJob_Call) Return(_a0 error) *Spawner_ResumeJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_ResumeJob_Call) RunAndReturn(run func(context.Context, sqlutil.DataSource, int32) error) *Spawner_ResumeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Spawner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -442,6 +538,56 @@ func (_c *Spawner_StartService_Call) RunAndReturn(run func(context.Context, job.
 	return _c
 }
 
+// UpdateJob provides a mock function with given fields: ctx, ds, jobID, jb, spec
+func (_m *Spawner) UpdateJob(ctx context.Context, ds sqlutil.DataSource, jobID int32, jb *job.Job, spec string) error {
+	ret := _m.Called(ctx, ds, jobID, jb, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlutil.DataSource, int32, *job.Job, string) error); ok {
+		r0 = rf(ctx, ds, jobID, jb, spec)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_UpdateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJob'
+type Spawner_UpdateJob_Call struct {
+	*mock.Call
+}
+
+// UpdateJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ds sqlutil.DataSource
+//   - jobID int32
+//   - jb *job.Job
+//   - spec string
+func (_e *Spawner_Expecter) UpdateJob(ctx interface{}, ds interface{}, jobID interface{}, jb interface{}, spec interface{}) *Spawner_UpdateJob_Call {
+	return &Spawner_UpdateJob_Call{Call: _e.mock.On("UpdateJob", ctx, ds, jobID, jb, spec)}
+}
+
+func (_c *Spawner_UpdateJob_Call) Run(run func(ctx context.Context, ds sqlutil.DataSource, jobID int32, jb *job.Job, spec string)) *Spawner_UpdateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlutil.DataSource), args[2].(int32), args[3].(*job.Job), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *Spawner_UpdateJob_Call) Return(_a0 error) *Spawner_UpdateJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_UpdateJob_Call) RunAndReturn(run func(context.Context, sqlutil.DataSource, int32, *job.Job, string) error) *Spawner_UpdateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSpawner creates a new instance of Spawner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSpawner(t interface {
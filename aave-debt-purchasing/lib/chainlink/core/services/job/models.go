@@ -193,6 +193,8 @@ type Job struct {
 	MaxTaskDuration               models.Interval
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
 	CreatedAt                     time.Time
+	// PausedAt is set while the job is paused: it stays in the DB but its services are not running.
+	PausedAt null.Time
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
@@ -216,6 +218,15 @@ func (j Job) ExternalIDEncodeBytesToTopic() common.Hash {
 	return ExternalJobIDEncodeBytesToTopic(j.ExternalJobID)
 }
 
+// SpecVersion is a TOML spec a job was created or updated with.
+type SpecVersion struct {
+	ID        int64
+	JobID     int32
+	Version   int32
+	Spec      string
+	CreatedAt time.Time
+}
+
 // SetID takes the id as a string and attempts to convert it to an int32. If
 // it succeeds, it will set it as the id on the job
 func (j *Job) SetID(value string) error {
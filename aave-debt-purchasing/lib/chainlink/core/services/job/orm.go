@@ -41,6 +41,7 @@ var (
 	ErrNoSuchTransmitterKey = errors.New("no such transmitter key exists")
 	ErrNoSuchSendingKey     = errors.New("no such sending key exists")
 	ErrNoSuchPublicKey      = errors.New("no such public key exists")
+	ErrJobPaused            = errors.New("job is paused")
 )
 
 type ORM interface {
@@ -54,6 +55,12 @@ type ORM interface {
 	FindOCR2JobIDByAddress(ctx context.Context, contractID string, feedID *common.Hash) (int32, error)
 	FindJobIDsWithBridge(ctx context.Context, name string) ([]int32, error)
 	DeleteJob(ctx context.Context, id int32, jobType Type) error
+	InsertSpecVersion(ctx context.Context, jobID int32, spec string) (SpecVersion, error)
+	FindSpecVersions(ctx context.Context, jobID int32) ([]SpecVersion, error)
+	FindSpecVersion(ctx context.Context, jobID int32, version int32) (SpecVersion, error)
+	DeleteSpecVersions(ctx context.Context, jobID int32) error
+	PauseJob(ctx context.Context, id int32) error
+	ResumeJob(ctx context.Context, id int32) error
 	RecordError(ctx context.Context, jobID int32, description string) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
 	TryRecordError(ctx context.Context, jobID int32, description string)
@@ -839,6 +846,58 @@ func (o *orm) DeleteJob(ctx context.Context, id int32, jobType Type) error {
 	return nil
 }
 
+// InsertSpecVersion records spec as the next version of the spec of a job.
+func (o *orm) InsertSpecVersion(ctx context.Context, jobID int32, spec string) (v SpecVersion, err error) {
+	stmt := `INSERT INTO job_spec_versions (job_id, version, spec, created_at)
+	SELECT $1, COALESCE(MAX(version), 0) + 1, $2, NOW() FROM job_spec_versions WHERE job_id = $1
+	RETURNING *;`
+	err = o.ds.GetContext(ctx, &v, stmt, jobID, spec)
+	return v, errors.Wrap(err, "InsertSpecVersion failed")
+}
+
+// FindSpecVersions returns the versions of the spec of a job, oldest first.
+func (o *orm) FindSpecVersions(ctx context.Context, jobID int32) (versions []SpecVersion, err error) {
+	stmt := `SELECT * FROM job_spec_versions WHERE job_id = $1 ORDER BY version ASC;`
+	err = o.ds.SelectContext(ctx, &versions, stmt, jobID)
+	return versions, errors.Wrap(err, "FindSpecVersions failed")
+}
+
+func (o *orm) FindSpecVersion(ctx context.Context, jobID int32, version int32) (v SpecVersion, err error) {
+	stmt := `SELECT * FROM job_spec_versions WHERE job_id = $1 AND version = $2;`
+	err = o.ds.GetContext(ctx, &v, stmt, jobID, version)
+	return v, errors.Wrap(err, "FindSpecVersion failed")
+}
+
+func (o *orm) DeleteSpecVersions(ctx context.Context, jobID int32) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM job_spec_versions WHERE job_id = $1;`, jobID)
+	return errors.Wrap(err, "DeleteSpecVersions failed")
+}
+
+// PauseJob marks a job as paused. Pausing a paused job keeps the time it was first paused at.
+func (o *orm) PauseJob(ctx context.Context, id int32) error {
+	return o.setPausedAt(ctx, id, `UPDATE jobs SET paused_at = COALESCE(paused_at, NOW()) WHERE id = $1`)
+}
+
+// ResumeJob clears the paused state of a job.
+func (o *orm) ResumeJob(ctx context.Context, id int32) error {
+	return o.setPausedAt(ctx, id, `UPDATE jobs SET paused_at = NULL WHERE id = $1`)
+}
+
+func (o *orm) setPausedAt(ctx context.Context, id int32, stmt string) error {
+	res, err := o.ds.ExecContext(ctx, stmt, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to set job paused state")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to set job paused state")
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 func (o *orm) RecordError(ctx context.Context, jobID int32, description string) error {
 	sql := `INSERT INTO job_spec_errors (job_id, description, occurrences, created_at, updated_at)
 	VALUES ($1, $2, 1, $3, $3)
@@ -2,6 +2,7 @@ package job
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"reflect"
@@ -14,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 type (
@@ -28,6 +30,17 @@ type (
 		CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) (err error)
 		// DeleteJob deletes a job and stops any active services.
 		DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
+		// UpdateJob replaces the job jobID with jb, keeping its ID, and records spec as the
+		// next version of its spec. The old job is only stopped once jb is saved and its
+		// services are created. If jb cannot be saved, the old job is left untouched; if its
+		// services cannot be created, the old version is restored and restarted.
+		// Running services implementing ReloadableServiceCtx are reloaded instead of restarted.
+		UpdateJob(ctx context.Context, ds sqlutil.DataSource, jobID int32, jb *Job, spec string) error
+		// PauseJob marks a job as paused and stops its services, leaving other jobs untouched.
+		// Paused jobs are not started on boot.
+		PauseJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
+		// ResumeJob clears the paused state of a job and starts its services.
+		ResumeJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
 
@@ -134,9 +147,19 @@ func (js *spawner) startAllServices(ctx context.Context) {
 		return
 	}
 
-	jobIDs := make([]int32, len(jbs))
-	for i, jb := range jbs {
-		jobIDs[i] = jb.ID
+	var active []Job
+	var jobIDs, pausedJobIDs []int32
+	for _, jb := range jbs {
+		if jb.PausedAt.Valid {
+			pausedJobIDs = append(pausedJobIDs, jb.ID)
+			continue
+		}
+		active = append(active, jb)
+		jobIDs = append(jobIDs, jb.ID)
+	}
+	jbs = active
+	if len(pausedJobIDs) > 0 {
+		js.lggr.Infow("Not starting paused jobs", "jobIDs", pausedJobIDs)
 	}
 	js.lggr.Debugw("Starting jobs...", "jobIDs", jobIDs)
 	wg := sync.WaitGroup{}
@@ -215,15 +238,7 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	// that it was able to start without an error.
 	aj := activeJob{delegate: delegate, spec: jb}
 
-	jb.PipelineSpec.JobName = jb.Name.ValueOrZero()
-	jb.PipelineSpec.JobID = jb.ID
-	jb.PipelineSpec.JobType = string(jb.Type)
-	jb.PipelineSpec.ForwardingAllowed = jb.ForwardingAllowed
-	if jb.GasLimit.Valid {
-		jb.PipelineSpec.GasLimit = &jb.GasLimit.Uint32
-	}
-
-	srvs, err := delegate.ServicesForSpec(ctx, jb)
+	srvs, err := delegate.ServicesForSpec(ctx, withPipelineSpecFields(jb))
 	if err != nil {
 		lggr.Errorw("Error creating services for job", "err", err)
 		cctx, cancel := js.chStop.NewCtx()
@@ -233,9 +248,28 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 		return pkgerrors.Wrapf(err, "failed to create services for job: %d", jb.ID)
 	}
 
+	return js.startServices(ctx, aj, srvs)
+}
+
+// withPipelineSpecFields returns jb with the job fields of its pipeline spec set.
+func withPipelineSpecFields(jb Job) Job {
+	jb.PipelineSpec.JobName = jb.Name.ValueOrZero()
+	jb.PipelineSpec.JobID = jb.ID
+	jb.PipelineSpec.JobType = string(jb.Type)
+	jb.PipelineSpec.ForwardingAllowed = jb.ForwardingAllowed
+	if jb.GasLimit.Valid {
+		jb.PipelineSpec.GasLimit = &jb.GasLimit.Uint32
+	}
+	return jb
+}
+
+// startServices starts srvs and adds aj to the active jobs, with the services that started.
+// The caller must hold activeJobsMu.
+func (js *spawner) startServices(ctx context.Context, aj activeJob, srvs []ServiceCtx) error {
+	lggr := js.lggr.With("jobID", aj.spec.ID)
 	var ms services.MultiStart
 	for _, srv := range srvs {
-		err = ms.Start(ctx, srv)
+		err := ms.Start(ctx, srv)
 		if err != nil {
 			lggr.Criticalw("Error starting service for job", "err", err)
 			return err
@@ -249,7 +283,7 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 		}
 		aj.services = append(aj.services, srv)
 	}
-	js.activeJobs[jb.ID] = aj
+	js.activeJobs[aj.spec.ID] = aj
 	return nil
 }
 
@@ -331,6 +365,10 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 			js.lggr.Errorw("Error deleting job", "jobID", jobID, "err", err)
 			return err
 		}
+		err = tx.DeleteSpecVersions(ctx, jobID)
+		if err != nil {
+			return err
+		}
 		// This comes after calling orm.DeleteJob(), so that any non-db side effects inside it only get executed if
 		// we know the DELETE will succeed.  The DELETE will be finalized only if all db transactions in OnDeleteJob()
 		// succeed.  If either of those fails, the job will not be stopped and everything will be rolled back.
@@ -351,6 +389,241 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 	return err
 }
 
+// Should not get called before Start()
+func (js *spawner) UpdateJob(ctx context.Context, ds sqlutil.DataSource, jobID int32, jb *Job, spec string) error {
+	if ds == nil {
+		ds = js.orm.DataSource()
+	}
+	if jobID == 0 {
+		return pkgerrors.New("will not update job with 0 ID")
+	}
+
+	lggr := js.lggr.With("jobID", jobID)
+	lggr.Debugw("Updating job")
+
+	var old activeJob
+	var running bool
+	var delegate Delegate
+	var exists bool
+	func() {
+		js.activeJobsMu.RLock()
+		defer js.activeJobsMu.RUnlock()
+		old, running = js.activeJobs[jobID]
+		delegate, exists = js.jobTypeDelegates[jb.Type]
+	}()
+	if !exists {
+		lggr.Errorw("Job type has not been registered with job.Spawner", "type", jb.Type)
+		return pkgerrors.Errorf("unregistered type %q for job: %d", jb.Type, jobID)
+	}
+
+	if !running { // inactive, so look up the spec and delegate
+		oldJob, err := js.orm.WithDataSource(ds).FindJob(ctx, jobID)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "job %d not found", jobID)
+		}
+		old.spec = oldJob
+		if !func() (ok bool) {
+			js.activeJobsMu.RLock()
+			defer js.activeJobsMu.RUnlock()
+			old.delegate, ok = js.jobTypeDelegates[oldJob.Type]
+			return ok
+		}() {
+			lggr.Errorw("Job type has not been registered with job.Spawner", "type", oldJob.Type)
+			return pkgerrors.Errorf("unregistered type %q for job: %d", oldJob.Type, jobID)
+		}
+	}
+
+	if old.spec.PausedAt.Valid {
+		return pkgerrors.Wrapf(ErrJobPaused, "job %d must be resumed before it is updated", jobID)
+	}
+
+	jb.ID = jobID
+	delegate.BeforeJobCreated(*jb)
+
+	// The new job replaces the old one in a single transaction, so that a spec failing key or
+	// bridge checks rolls everything back. Its services are only created once it is committed,
+	// as delegates may read the job back.
+	err := sqlutil.Transact(ctx, js.orm.WithDataSource, ds, nil, func(tx ORM) error {
+		err := tx.DeleteJob(ctx, jobID, old.spec.Type)
+		if err != nil {
+			return err
+		}
+		err = old.delegate.OnDeleteJob(ctx, old.spec)
+		if err != nil {
+			return err
+		}
+		err = tx.CreateJob(ctx, jb)
+		if err != nil {
+			return err
+		}
+		_, err = tx.InsertSpecVersion(ctx, jobID, spec)
+		return err
+	})
+	if err != nil {
+		lggr.Errorw("Error updating job, keeping the old job", "err", err)
+		return err
+	}
+
+	srvs, err := delegate.ServicesForSpec(ctx, withPipelineSpecFields(*jb))
+	if err != nil {
+		err = pkgerrors.Wrapf(err, "failed to create services for job: %d", jobID)
+		lggr.Errorw("Error creating services for updated job, restoring the old job", "err", err)
+		if restoreErr := js.restoreJob(ctx, ds, old, running, delegate, *jb); restoreErr != nil {
+			lggr.Criticalw("Failed to restore the old job", "err", restoreErr)
+			return errors.Join(err, pkgerrors.Wrap(restoreErr, "failed to restore the old job"))
+		}
+		return err
+	}
+
+	old.delegate.BeforeJobDeleted(old.spec)
+	if running && old.spec.Type == jb.Type && js.reloadServices(ctx, lggr, old.services, srvs) {
+		func() {
+			js.activeJobsMu.Lock()
+			defer js.activeJobsMu.Unlock()
+			js.activeJobs[jobID] = activeJob{delegate: delegate, spec: *jb, services: old.services}
+		}()
+		lggr.Infow("Updated job and reloaded its services", "type", jb.Type)
+		delegate.AfterJobCreated(*jb)
+		return nil
+	}
+	if running {
+		js.stopService(jobID)
+	}
+
+	err = func() error {
+		js.activeJobsMu.Lock()
+		defer js.activeJobsMu.Unlock()
+		return js.startServices(ctx, activeJob{delegate: delegate, spec: *jb}, srvs)
+	}()
+	if err != nil {
+		lggr.Errorw("Error starting updated job services", "type", jb.Type, "err", err)
+	} else {
+		lggr.Infow("Updated job and started its services", "type", jb.Type)
+	}
+
+	delegate.AfterJobCreated(*jb)
+
+	return err
+}
+
+// restoreJob replaces the job jb, whose services could not be created, with the version old
+// it was updated from, and records the spec of that version as the latest one again. The
+// services of old are restarted if it was running, as its pipeline spec is recreated.
+func (js *spawner) restoreJob(ctx context.Context, ds sqlutil.DataSource, old activeJob, running bool, delegate Delegate, jb Job) error {
+	restored := old.spec
+	if restored.Pipeline.Source == "" && restored.PipelineSpec != nil {
+		p, err := pipeline.Parse(restored.PipelineSpec.DotDagSource)
+		if err != nil {
+			return pkgerrors.Wrap(err, "failed to parse the pipeline of the old job")
+		}
+		restored.Pipeline = *p
+	}
+
+	err := sqlutil.Transact(ctx, js.orm.WithDataSource, ds, nil, func(tx ORM) error {
+		err := tx.DeleteJob(ctx, jb.ID, jb.Type)
+		if err != nil {
+			return err
+		}
+		err = delegate.OnDeleteJob(ctx, jb)
+		if err != nil {
+			return err
+		}
+		err = tx.CreateJob(ctx, &restored)
+		if err != nil {
+			return err
+		}
+		versions, err := tx.FindSpecVersions(ctx, jb.ID)
+		if err != nil {
+			return err
+		}
+		if len(versions) < 2 {
+			// The job had no recorded version before the update.
+			return tx.DeleteSpecVersions(ctx, jb.ID)
+		}
+		_, err = tx.InsertSpecVersion(ctx, jb.ID, versions[len(versions)-2].Spec)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if running {
+		js.stopService(jb.ID)
+		return js.StartService(ctx, restored)
+	}
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) PauseJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error {
+	orm := js.orm
+	if ds != nil {
+		orm = orm.WithDataSource(ds)
+	}
+	// The paused state is saved first, so that the job is not started again on boot even if
+	// stopping its services fails.
+	if err := orm.PauseJob(ctx, jobID); err != nil {
+		return pkgerrors.Wrapf(err, "failed to pause job %d", jobID)
+	}
+	js.stopService(jobID)
+	js.lggr.Infow("Paused job", "jobID", jobID)
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) ResumeJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error {
+	orm := js.orm
+	if ds != nil {
+		orm = orm.WithDataSource(ds)
+	}
+	if err := orm.ResumeJob(ctx, jobID); err != nil {
+		return pkgerrors.Wrapf(err, "failed to resume job %d", jobID)
+	}
+
+	js.activeJobsMu.RLock()
+	_, active := js.activeJobs[jobID]
+	js.activeJobsMu.RUnlock()
+	if active {
+		// The job was not paused.
+		return nil
+	}
+
+	jb, err := orm.FindJob(ctx, jobID)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "job %d not found", jobID)
+	}
+	if err = js.StartService(ctx, jb); err != nil {
+		js.lggr.Errorw("Error starting resumed job services", "type", jb.Type, "jobID", jobID, "err", err)
+		return err
+	}
+	js.lggr.Infow("Resumed job", "type", jb.Type, "jobID", jobID)
+	return nil
+}
+
+// reloadServices reloads each running service with the matching new one, and returns false if
+// the services have to be restarted instead.
+func (js *spawner) reloadServices(ctx context.Context, lggr logger.Logger, running []ServiceCtx, next []ServiceCtx) bool {
+	if len(running) != len(next) {
+		return false
+	}
+	for i, srv := range running {
+		if _, ok := srv.(ReloadableServiceCtx); !ok {
+			return false
+		}
+		if reflect.TypeOf(srv) != reflect.TypeOf(next[i]) {
+			return false
+		}
+	}
+	for i, srv := range running {
+		// A partially reloaded job is restarted with the new services, so it ends up with the new spec either way
+		if err := srv.(ReloadableServiceCtx).Reload(ctx, next[i]); err != nil {
+			lggr.Infow("Failed to reload job service, restarting the job", "subservice", i, "serviceType", reflect.TypeOf(srv), "err", err)
+			return false
+		}
+	}
+	return true
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
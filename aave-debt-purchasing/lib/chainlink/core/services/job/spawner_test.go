@@ -2,6 +2,8 @@ package job_test
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"testing"
 	"time"
 
@@ -60,6 +62,20 @@ func (d delegate) ServicesForSpec(ctx context.Context, js job.Job) ([]job.Servic
 	return d.services, nil
 }
 
+// failingDelegate fails to create the services of failingJob.
+type failingDelegate struct {
+	delegate
+	failingJob *job.Job
+}
+
+// ServicesForSpec satisfies the job.Delegate interface.
+func (d failingDelegate) ServicesForSpec(ctx context.Context, js job.Job) ([]job.ServiceCtx, error) {
+	if js.ExternalJobID == d.failingJob.ExternalJobID {
+		return nil, errors.New("failed to create services")
+	}
+	return d.delegate.ServicesForSpec(ctx, js)
+}
+
 func clearDB(t *testing.T, db *sqlx.DB) {
 	cltest.ClearDBTables(t, db, "jobs", "pipeline_runs", "pipeline_specs", "pipeline_task_runs")
 }
@@ -280,6 +296,145 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		clearDB(t, db)
 	})
 
+	t.Run("stops and starts job services on 'PauseJob()'/'ResumeJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once()
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config, mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		delegates := map[job.Type]job.Delegate{jobA.Type: delegateA}
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, delegates, lggr, nil)
+
+		ctx := testutils.Context(t)
+		require.NoError(t, orm.CreateJob(ctx, jobA))
+		delegateA.jobID = jobA.ID
+		require.NoError(t, spawner.Start(ctx))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.PauseJob(ctx, nil, jobA.ID))
+		assert.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+		jb, err := orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, jb.PausedAt.Valid)
+
+		// Paused jobs are not started on boot.
+		rebooted := job.NewSpawner(orm, config.Database(), noopChecker{}, delegates, lggr, nil)
+		require.NoError(t, rebooted.Start(ctx))
+		assert.Empty(t, rebooted.ActiveJobs())
+		require.NoError(t, rebooted.Close())
+
+		// Paused jobs can not be updated.
+		jobB := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		require.ErrorIs(t, spawner.UpdateJob(ctx, nil, jobA.ID, jobB, "spec"), job.ErrJobPaused)
+
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once()
+		require.NoError(t, spawner.ResumeJob(ctx, nil, jobA.ID))
+		assert.Contains(t, spawner.ActiveJobs(), jobA.ID)
+		jb, err = orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.False(t, jb.PausedAt.Valid)
+
+		// Resuming a running job is a no-op.
+		require.NoError(t, spawner.ResumeJob(ctx, nil, jobA.ID))
+
+		require.ErrorIs(t, spawner.PauseJob(ctx, nil, 999999), sql.ErrNoRows)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.Close())
+
+		clearDB(t, db)
+	})
+
+	t.Run("reloads job services on 'UpdateJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA := mocks.NewReloadableServiceCtx(t)
+		serviceA.On("Start", mock.Anything).Return(nil).Once()
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config, mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{jobA.Type: delegateA}, lggr, nil)
+
+		ctx := testutils.Context(t)
+		require.NoError(t, orm.CreateJob(ctx, jobA))
+		delegateA.jobID = jobA.ID
+		require.NoError(t, spawner.Start(ctx))
+
+		// The running service is reloaded with the one created for the updated job instead of restarted.
+		serviceA.On("Reload", mock.Anything, serviceA).Return(nil).Once()
+		jobB := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		require.NoError(t, spawner.UpdateJob(ctx, nil, jobA.ID, jobB, "spec"))
+		assert.Equal(t, jobB.ExternalJobID, spawner.ActiveJobs()[jobA.ID].ExternalJobID)
+
+		// A failed reload restarts the job.
+		serviceA.On("Reload", mock.Anything, serviceA).Return(errors.New("restart required")).Once()
+		serviceA.On("Close").Return(nil).Once()
+		serviceA.On("Start", mock.Anything).Return(nil).Once()
+		jobC := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		require.NoError(t, spawner.UpdateJob(ctx, nil, jobA.ID, jobC, "spec"))
+		assert.Equal(t, jobC.ExternalJobID, spawner.ActiveJobs()[jobA.ID].ExternalJobID)
+
+		serviceA.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.Close())
+
+		clearDB(t, db)
+	})
+
+	t.Run("restores the old job when the services of the updated job can not be created", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		jobB := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA := mocks.NewServiceCtx(t)
+		serviceA.On("Start", mock.Anything).Return(nil).Once()
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config, mailMon)
+		delegateA := &failingDelegate{delegate{jobA.Type, []job.ServiceCtx{serviceA}, 0, nil, d}, jobB}
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{jobA.Type: delegateA}, lggr, nil)
+
+		ctx := testutils.Context(t)
+		require.NoError(t, orm.CreateJob(ctx, jobA))
+		_, err := orm.InsertSpecVersion(ctx, jobA.ID, "specA")
+		require.NoError(t, err)
+		require.NoError(t, spawner.Start(ctx))
+
+		// The old job is restarted, as its pipeline spec is recreated.
+		serviceA.On("Close").Return(nil).Once()
+		serviceA.On("Start", mock.Anything).Return(nil).Once()
+		require.ErrorContains(t, spawner.UpdateJob(ctx, nil, jobA.ID, jobB, "specB"), "failed to create services")
+		assert.Equal(t, jobA.ExternalJobID, spawner.ActiveJobs()[jobA.ID].ExternalJobID)
+
+		jb, err := orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.Equal(t, jobA.ExternalJobID, jb.ExternalJobID)
+
+		versions, err := orm.FindSpecVersions(ctx, jobA.ID)
+		require.NoError(t, err)
+		require.Len(t, versions, 3)
+		assert.Equal(t, "specA", versions[2].Spec)
+
+		serviceA.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.Close())
+
+		clearDB(t, db)
+	})
+
 	t.Run("Unregisters filters on 'DeleteJob()'", func(t *testing.T) {
 		config = configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 			c.Feature.LogPoller = func(b bool) *bool { return &b }(true)
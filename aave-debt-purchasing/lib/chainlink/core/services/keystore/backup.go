@@ -0,0 +1,184 @@
+package keystore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+// BackupVersion is the version of the bundle format written by Backup.
+const BackupVersion = 1
+
+// BackupBundle holds every key of the keystore encrypted under the backup password, along
+// with the states of the EVM keys. Nonces are not part of the bundle since they are not kept
+// by the keystore: they are synced from the chain once the keys are used again.
+type BackupBundle struct {
+	Version   int              `json:"version"`
+	CreatedAt time.Time        `json:"createdAt"`
+	KeyStates []BackupKeyState `json:"keyStates"`
+	Keys      json.RawMessage  `json:"keys"`
+}
+
+// BackupKeyState is the state of an EVM key on a chain.
+type BackupKeyState struct {
+	Address    common.Address `json:"address"`
+	EVMChainID ubig.Big       `json:"evmChainID"`
+	Disabled   bool           `json:"disabled"`
+}
+
+// RestoredKey identifies a key restored from a bundle.
+type RestoredKey struct {
+	KeyType string `json:"keyType"`
+	ID      string `json:"id"`
+}
+
+// RestoreConflict is a key or key state of a bundle which was skipped by a restore.
+type RestoreConflict struct {
+	KeyType string `json:"keyType"`
+	ID      string `json:"id"`
+	Reason  string `json:"reason"`
+}
+
+// RestoreReport describes what a restore did, or would do in case of a dry run.
+type RestoreReport struct {
+	DryRun    bool
+	Keys      []RestoredKey
+	KeyStates []BackupKeyState
+	Conflicts []RestoreConflict
+}
+
+// Backup returns a bundle of every key and EVM key state, with the keys encrypted under password.
+func (ks *master) Backup(ctx context.Context, password string) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	ekr, err := ks.keyRing.Encrypt(password, ks.scryptParams)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to encrypt keyRing")
+	}
+	bundle := BackupBundle{
+		Version:   BackupVersion,
+		CreatedAt: time.Now(),
+		KeyStates: make([]BackupKeyState, 0, len(ks.keyStates.All)),
+		Keys:      ekr.EncryptedKeys,
+	}
+	for _, state := range ks.keyStates.All {
+		bundle.KeyStates = append(bundle.KeyStates, BackupKeyState{
+			Address:    state.Address.Address(),
+			EVMChainID: state.EVMChainID,
+			Disabled:   state.Disabled,
+		})
+	}
+	return json.Marshal(bundle)
+}
+
+// Restore adds the keys and EVM key states of bundle which are missing from the keystore, in
+// a single transaction. Keys and key states which already exist are left untouched, and
+// reported as conflicts. Legacy keys of the bundle are not restored.
+func (ks *master) Restore(ctx context.Context, bundleJSON []byte, password string, dryRun bool) (report RestoreReport, err error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return report, ErrLocked
+	}
+	var bundle BackupBundle
+	if err = json.Unmarshal(bundleJSON, &bundle); err != nil {
+		return report, errors.Wrap(err, "unable to decode backup bundle")
+	}
+	if bundle.Version != BackupVersion {
+		return report, fmt.Errorf("unsupported backup bundle version %d, expected %d", bundle.Version, BackupVersion)
+	}
+	if len(bundle.Keys) == 0 {
+		return report, errors.New("backup bundle has no keys")
+	}
+	kr, err := encryptedKeyRing{EncryptedKeys: bundle.Keys}.Decrypt(password)
+	if err != nil {
+		return report, errors.Wrap(err, "unable to decrypt backup bundle")
+	}
+
+	report.DryRun = dryRun
+	src, dst := reflect.ValueOf(kr).Elem(), reflect.ValueOf(ks.keyRing).Elem()
+	var added []func()
+	var restoredCSA bool
+	for i := 0; i < src.NumField(); i++ {
+		if src.Field(i).Kind() != reflect.Map {
+			continue
+		}
+		keyType := src.Type().Field(i).Name
+		srcKeys, dstKeys := src.Field(i), dst.Field(i)
+		ids := srcKeys.MapKeys()
+		sort.Slice(ids, func(a, b int) bool { return ids[a].String() < ids[b].String() })
+		for _, id := range ids {
+			if dstKeys.MapIndex(id).IsValid() {
+				report.Conflicts = append(report.Conflicts, RestoreConflict{KeyType: keyType, ID: id.String(), Reason: "key already exists"})
+				continue
+			}
+			if keyType == "CSA" && (len(ks.keyRing.CSA) > 0 || restoredCSA) {
+				report.Conflicts = append(report.Conflicts, RestoreConflict{KeyType: keyType, ID: id.String(), Reason: ErrCSAKeyExists.Error()})
+				continue
+			}
+			restoredCSA = restoredCSA || keyType == "CSA"
+			report.Keys = append(report.Keys, RestoredKey{KeyType: keyType, ID: id.String()})
+			if !dryRun {
+				dstKeys.SetMapIndex(id, srcKeys.MapIndex(id))
+				added = append(added, func() { dstKeys.SetMapIndex(id, reflect.Value{}) })
+			}
+		}
+	}
+
+	for _, state := range bundle.KeyStates {
+		if _, ok := kr.Eth[state.Address.Hex()]; !ok {
+			report.Conflicts = append(report.Conflicts, RestoreConflict{KeyType: "Eth", ID: state.Address.Hex(), Reason: fmt.Sprintf("no key in the bundle for the state on chain %s", state.EVMChainID.String())})
+			continue
+		}
+		if existing := ks.keyStates.get(state.Address, state.EVMChainID.ToInt()); existing != nil {
+			if existing.Disabled != state.Disabled {
+				report.Conflicts = append(report.Conflicts, RestoreConflict{KeyType: "Eth", ID: state.Address.Hex(), Reason: fmt.Sprintf("state on chain %s already exists with disabled=%t", state.EVMChainID.String(), existing.Disabled)})
+			}
+			continue
+		}
+		report.KeyStates = append(report.KeyStates, state)
+	}
+
+	if dryRun || len(report.Keys)+len(report.KeyStates) == 0 {
+		return report, nil
+	}
+
+	var states []*ethkey.State
+	err = ks.save(ctx, func(tx sqlutil.DataSource) error {
+		for _, state := range report.KeyStates {
+			s := new(ethkey.State)
+			sql := `INSERT INTO evm.key_states (address, disabled, evm_chain_id, created_at, updated_at)
+			VALUES ($1, $2, $3, NOW(), NOW())
+			RETURNING *;`
+			if err2 := tx.GetContext(ctx, s, sql, state.Address, state.Disabled, state.EVMChainID.String()); err2 != nil {
+				return errors.Wrap(err2, "failed to insert key_state")
+			}
+			states = append(states, s)
+		}
+		return nil
+	})
+	if err != nil {
+		for _, remove := range added {
+			remove()
+		}
+		return RestoreReport{}, err
+	}
+	for _, state := range states {
+		ks.keyStates.add(state)
+	}
+	ks.logger.Infow("Restored keystore backup", "keys", len(report.Keys), "keyStates", len(report.KeyStates), "conflicts", len(report.Conflicts))
+	return report, nil
+}
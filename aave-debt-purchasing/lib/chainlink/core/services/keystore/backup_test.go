@@ -0,0 +1,118 @@
+package keystore_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+func TestMasterKeystore_BackupRestore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	const backupPassword = "backup password"
+
+	source := keystore.ExposedNewMaster(t, pgtest.NewSqlxDB(t))
+	_, err := source.Backup(ctx, backupPassword)
+	require.ErrorIs(t, err, keystore.ErrLocked)
+	require.NoError(t, source.Unlock(ctx, cltest.Password))
+	ethKey, err := source.Eth().Create(ctx, testutils.FixtureChainID)
+	require.NoError(t, err)
+	require.NoError(t, source.Eth().Disable(ctx, ethKey.Address, testutils.FixtureChainID))
+	p2pKey, err := source.P2P().Create(ctx)
+	require.NoError(t, err)
+	csaKey, err := source.CSA().Create(ctx)
+	require.NoError(t, err)
+
+	bundle, err := source.Backup(ctx, backupPassword)
+	require.NoError(t, err)
+
+	var decoded keystore.BackupBundle
+	require.NoError(t, json.Unmarshal(bundle, &decoded))
+	assert.Equal(t, keystore.BackupVersion, decoded.Version)
+	require.Len(t, decoded.KeyStates, 1)
+	assert.True(t, decoded.KeyStates[0].Disabled)
+
+	db := pgtest.NewSqlxDB(t)
+	target := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, target.Unlock(ctx, cltest.Password))
+	existing, err := target.CSA().Create(ctx)
+	require.NoError(t, err)
+
+	t.Run("rejects a wrong password", func(t *testing.T) {
+		_, err := target.Restore(ctx, bundle, "wrong password", true)
+		require.Error(t, err)
+	})
+
+	t.Run("rejects an unknown version", func(t *testing.T) {
+		unknown := decoded
+		unknown.Version = keystore.BackupVersion + 1
+		b, err := json.Marshal(unknown)
+		require.NoError(t, err)
+		_, err = target.Restore(ctx, b, backupPassword, true)
+		require.ErrorContains(t, err, "unsupported backup bundle version")
+	})
+
+	t.Run("dry run leaves the keystore untouched", func(t *testing.T) {
+		report, err := target.Restore(ctx, bundle, backupPassword, true)
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Len(t, report.Keys, 2)
+		assert.Len(t, report.KeyStates, 1)
+		assert.Equal(t, []keystore.RestoreConflict{{KeyType: "CSA", ID: csaKey.ID(), Reason: keystore.ErrCSAKeyExists.Error()}}, report.Conflicts)
+
+		keys, err := target.Eth().GetAll(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+		cltest.AssertCount(t, db, "evm.key_states", 0)
+	})
+
+	t.Run("restores the keys and key states", func(t *testing.T) {
+		report, err := target.Restore(ctx, bundle, backupPassword, false)
+		require.NoError(t, err)
+		assert.False(t, report.DryRun)
+		assert.Equal(t, []keystore.RestoredKey{
+			{KeyType: "Eth", ID: ethKey.ID()},
+			{KeyType: "P2P", ID: p2pKey.ID()},
+		}, report.Keys)
+
+		_, err = target.Eth().Get(ctx, ethKey.ID())
+		require.NoError(t, err)
+		_, err = target.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+		csaKeys, err := target.CSA().GetAll()
+		require.NoError(t, err)
+		require.Len(t, csaKeys, 1)
+		assert.Equal(t, existing.ID(), csaKeys[0].ID())
+		state, err := target.Eth().GetState(ctx, ethKey.ID(), testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.True(t, state.Disabled)
+
+		target.ResetXXXTestOnly()
+		require.NoError(t, target.Unlock(ctx, cltest.Password))
+		_, err = target.Eth().Get(ctx, ethKey.ID())
+		require.NoError(t, err)
+	})
+
+	t.Run("reports existing keys as conflicts", func(t *testing.T) {
+		require.NoError(t, target.Eth().Enable(ctx, ethKey.Address, testutils.FixtureChainID))
+
+		report, err := target.Restore(ctx, bundle, backupPassword, false)
+		require.NoError(t, err)
+		assert.Empty(t, report.Keys)
+		assert.Empty(t, report.KeyStates)
+		assert.Equal(t, []keystore.RestoreConflict{
+			{KeyType: "CSA", ID: csaKey.ID(), Reason: keystore.ErrCSAKeyExists.Error()},
+			{KeyType: "Eth", ID: ethKey.ID(), Reason: "key already exists"},
+			{KeyType: "P2P", ID: p2pKey.ID(), Reason: "key already exists"},
+			{KeyType: "Eth", ID: ethKey.ID(), Reason: "state on chain 0 already exists with disabled=false"},
+		}, report.Conflicts)
+	})
+}
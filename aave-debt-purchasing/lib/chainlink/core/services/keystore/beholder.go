@@ -2,21 +2,23 @@ package keystore
 
 import (
 	"context"
+	"crypto/ed25519"
 	"encoding/hex"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/beholder"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 )
 
-func BuildBeholderAuth(ctx context.Context, keyStore CSA) (authHeaders map[string]string, pubKeyHex string, err error) {
-	csaKey, err := GetDefault(ctx, keyStore)
+func BuildBeholderAuth(ctx context.Context, csaSigner core.Keystore) (authHeaders map[string]string, pubKeyHex string, err error) {
+	signer, err := NewCSAEd25519Signer(ctx, csaSigner)
 	if err != nil {
 		return nil, "", err
 	}
 
-	authHeaders, err = beholder.NewAuthHeaders(csaKey.Signer())
+	authHeaders, err = beholder.NewAuthHeaders(signer)
 	if err != nil {
 		return
 	}
-	pubKeyHex = hex.EncodeToString(csaKey.PublicKey)
+	pubKeyHex = hex.EncodeToString(signer.Public().(ed25519.PublicKey))
 	return
 }
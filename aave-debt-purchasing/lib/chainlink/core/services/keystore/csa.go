@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
 )
 
@@ -55,6 +56,24 @@ func (c CSASigner) Sign(ctx context.Context, account string, data []byte) (signe
 	return k.Signer().Sign(rand.Reader, data, crypto.Hash(0))
 }
 
+// NewCSAEd25519Signer returns a signer for the first account of the CSA signer ks, which is
+// either a CSASigner, whose key is created if missing, or a remote signer.
+func NewCSAEd25519Signer(ctx context.Context, ks core.Keystore) (*core.Ed25519Signer, error) {
+	if ensurer, ok := ks.(interface{ EnsureKey(context.Context) error }); ok {
+		if err := ensurer.EnsureKey(ctx); err != nil {
+			return nil, fmt.Errorf("failed to ensure CSA key: %w", err)
+		}
+	}
+	accounts, err := ks.Accounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(accounts) < 1 {
+		return nil, errors.New("no CSA keys available")
+	}
+	return core.NewEd25519Signer(accounts[0], ks.Sign)
+}
+
 type csa struct {
 	*keyManager
 }
@@ -34,10 +34,10 @@ func (ekr *evmKeyring) PublicKey() ocrtypes.OnchainPublicKey {
 }
 
 func (ekr *evmKeyring) Sign(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) ([]byte, error) {
-	return ekr.SignBlob(ekr.reportToSigData(reportCtx, report))
+	return ekr.SignBlob(ReportToSigData(reportCtx, report))
 }
 
-func (ekr *evmKeyring) reportToSigData(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) []byte {
+func ReportToSigData(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) []byte {
 	rawReportContext := evmutil.RawReportContext(reportCtx)
 	sigData := crypto.Keccak256(report)
 	sigData = append(sigData, rawReportContext[0][:]...)
@@ -72,7 +72,7 @@ func (ekr *evmKeyring) SignBlob(b []byte) (sig []byte, err error) {
 }
 
 func (ekr *evmKeyring) Verify(publicKey ocrtypes.OnchainPublicKey, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signature []byte) bool {
-	hash := ekr.reportToSigData(reportCtx, report)
+	hash := ReportToSigData(reportCtx, report)
 	return ekr.VerifyBlob(publicKey, hash, signature)
 }
 
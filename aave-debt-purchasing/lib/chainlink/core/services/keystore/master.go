@@ -51,6 +51,13 @@ type Master interface {
 	Workflow() Workflow
 	Unlock(ctx context.Context, password string) error
 	IsEmpty(ctx context.Context) (bool, error)
+	// RotatePassword re-encrypts every key under newPassword and scryptParams.
+	RotatePassword(ctx context.Context, currentPassword, newPassword string, scryptParams utils.ScryptParams) error
+	// Backup returns a bundle of every key and EVM key state, encrypted under password.
+	Backup(ctx context.Context, password string) ([]byte, error)
+	// Restore adds the keys and EVM key states of a bundle made by Backup which are missing
+	// from the keystore. If dryRun is set, the keystore is left untouched.
+	Restore(ctx context.Context, bundle []byte, password string, dryRun bool) (RestoreReport, error)
 }
 type master struct {
 	*keyManager
@@ -203,6 +210,41 @@ func (km *keyManager) Unlock(ctx context.Context, password string) error {
 	return nil
 }
 
+// RotatePassword atomically replaces the encrypted key ring with one encrypted under
+// newPassword and scryptParams. The new key ring is decrypted and checked against the
+// unlocked keys before it is saved, so a failed verification leaves the old password in place.
+func (km *keyManager) RotatePassword(ctx context.Context, currentPassword, newPassword string, scryptParams utils.ScryptParams) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	if currentPassword != km.password {
+		return errors.New("current password does not match the keystore password")
+	}
+	if newPassword == "" {
+		return errors.New("new password must not be empty")
+	}
+	ekr, err := km.keyRing.Encrypt(newPassword, scryptParams)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt keyRing")
+	}
+	kr, err := ekr.Decrypt(newPassword)
+	if err != nil {
+		return errors.Wrap(err, "unable to decrypt key ring with the new password")
+	}
+	if !kr.sameKeys(km.keyRing) {
+		return errors.New("key ring decrypted with the new password does not match the current keys")
+	}
+	if err = km.orm.saveEncryptedKeyRing(ctx, &ekr); err != nil {
+		return errors.Wrap(err, "unable to rotate keystore password")
+	}
+	km.password = newPassword
+	km.scryptParams = scryptParams
+	km.logger.Info("Rotated keystore password")
+	return nil
+}
+
 // caller must hold lock!
 func (km *keyManager) save(ctx context.Context, callbacks ...func(sqlutil.DataSource) error) error {
 	ekb, err := km.keyRing.Encrypt(km.password, km.scryptParams)
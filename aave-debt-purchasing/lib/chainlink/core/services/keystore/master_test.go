@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestMasterKeystore_Unlock_Save(t *testing.T) {
@@ -62,4 +63,27 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		keyStore.ResetXXXTestOnly()
 		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
 	})
+
+	t.Run("rotates the password", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		const newPassword = "p4SsW0rD1!@#_new"
+		require.ErrorIs(t, keyStore.RotatePassword(ctx, cltest.Password, newPassword, utils.FastScryptParams), keystore.ErrLocked)
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+		key, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+		p2pKey, err := keyStore.P2P().Create(ctx)
+		require.NoError(t, err)
+
+		require.Error(t, keyStore.RotatePassword(ctx, "wrong password", newPassword, utils.FastScryptParams))
+		require.Error(t, keyStore.RotatePassword(ctx, cltest.Password, "", utils.FastScryptParams))
+		require.NoError(t, keyStore.RotatePassword(ctx, cltest.Password, newPassword, utils.FastScryptParams))
+
+		keyStore.ResetXXXTestOnly()
+		require.Error(t, keyStore.Unlock(ctx, cltest.Password))
+		require.NoError(t, keyStore.Unlock(ctx, newPassword))
+		_, err = keyStore.Eth().Get(ctx, key.ID())
+		require.NoError(t, err)
+		_, err = keyStore.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+	})
 }
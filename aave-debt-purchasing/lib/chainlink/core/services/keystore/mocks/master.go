@@ -7,6 +7,8 @@ import (
 
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // Master is an autogenerated mock type for the Master type
@@ -69,6 +71,65 @@ func (_c *Master_Aptos_Call) RunAndReturn(run func() keystore.Aptos) *Master_Apt
 	return _c
 }
 
+// Backup provides a mock function with given fields: ctx, password
+func (_m *Master) Backup(ctx context.Context, password string) ([]byte, error) {
+	ret := _m.Called(ctx, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backup")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Master_Backup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backup'
+type Master_Backup_Call struct {
+	*mock.Call
+}
+
+// Backup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - password string
+func (_e *Master_Expecter) Backup(ctx interface{}, password interface{}) *Master_Backup_Call {
+	return &Master_Backup_Call{Call: _e.mock.On("Backup", ctx, password)}
+}
+
+func (_c *Master_Backup_Call) Run(run func(ctx context.Context, password string)) *Master_Backup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Master_Backup_Call) Return(_a0 []byte, _a1 error) *Master_Backup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Master_Backup_Call) RunAndReturn(run func(context.Context, string) ([]byte, error)) *Master_Backup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CSA provides a mock function with no fields
 func (_m *Master) CSA() keystore.CSA {
 	ret := _m.Called()
@@ -407,6 +468,114 @@ func (_c *Master_P2P_Call) RunAndReturn(run func() keystore.P2P) *Master_P2P_Cal
 	return _c
 }
 
+// Restore provides a mock function with given fields: ctx, bundle, password, dryRun
+func (_m *Master) Restore(ctx context.Context, bundle []byte, password string, dryRun bool) (keystore.RestoreReport, error) {
+	ret := _m.Called(ctx, bundle, password, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 keystore.RestoreReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string, bool) (keystore.RestoreReport, error)); ok {
+		return rf(ctx, bundle, password, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string, bool) keystore.RestoreReport); ok {
+		r0 = rf(ctx, bundle, password, dryRun)
+	} else {
+		r0 = ret.Get(0).(keystore.RestoreReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, string, bool) error); ok {
+		r1 = rf(ctx, bundle, password, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Master_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type Master_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bundle []byte
+//   - password string
+//   - dryRun bool
+func (_e *Master_Expecter) Restore(ctx interface{}, bundle interface{}, password interface{}, dryRun interface{}) *Master_Restore_Call {
+	return &Master_Restore_Call{Call: _e.mock.On("Restore", ctx, bundle, password, dryRun)}
+}
+
+func (_c *Master_Restore_Call) Run(run func(ctx context.Context, bundle []byte, password string, dryRun bool)) *Master_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *Master_Restore_Call) Return(_a0 keystore.RestoreReport, _a1 error) *Master_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Master_Restore_Call) RunAndReturn(run func(context.Context, []byte, string, bool) (keystore.RestoreReport, error)) *Master_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotatePassword provides a mock function with given fields: ctx, currentPassword, newPassword, scryptParams
+func (_m *Master) RotatePassword(ctx context.Context, currentPassword string, newPassword string, scryptParams utils.ScryptParams) error {
+	ret := _m.Called(ctx, currentPassword, newPassword, scryptParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, utils.ScryptParams) error); ok {
+		r0 = rf(ctx, currentPassword, newPassword, scryptParams)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Master_RotatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotatePassword'
+type Master_RotatePassword_Call struct {
+	*mock.Call
+}
+
+// RotatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - currentPassword string
+//   - newPassword string
+//   - scryptParams utils.ScryptParams
+func (_e *Master_Expecter) RotatePassword(ctx interface{}, currentPassword interface{}, newPassword interface{}, scryptParams interface{}) *Master_RotatePassword_Call {
+	return &Master_RotatePassword_Call{Call: _e.mock.On("RotatePassword", ctx, currentPassword, newPassword, scryptParams)}
+}
+
+func (_c *Master_RotatePassword_Call) Run(run func(ctx context.Context, currentPassword string, newPassword string, scryptParams utils.ScryptParams)) *Master_RotatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(utils.ScryptParams))
+	})
+	return _c
+}
+
+func (_c *Master_RotatePassword_Call) Return(_a0 error) *Master_RotatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_RotatePassword_Call) RunAndReturn(run func(context.Context, string, string, utils.ScryptParams) error) *Master_RotatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Solana provides a mock function with no fields
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"reflect"
 	"time"
 
 	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
@@ -252,6 +253,25 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	return rawKeys
 }
 
+// sameKeys reports whether both key rings hold keys with the same IDs.
+func (kr *keyRing) sameKeys(other *keyRing) bool {
+	a, b := reflect.ValueOf(kr).Elem(), reflect.ValueOf(other).Elem()
+	for i := 0; i < a.NumField(); i++ {
+		if a.Field(i).Kind() != reflect.Map {
+			continue
+		}
+		if a.Field(i).Len() != b.Field(i).Len() {
+			return false
+		}
+		for _, id := range a.Field(i).MapKeys() {
+			if !b.Field(i).MapIndex(id).IsValid() {
+				return false
+			}
+		}
+	}
+	return kr.LegacyKeys.legacyRawKeys.len() == other.LegacyKeys.legacyRawKeys.len()
+}
+
 func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 	lggr = lggr.Named("KeyRing")
 	var csaIDs []string
@@ -0,0 +1,139 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
+)
+
+var (
+	// ErrAccountNotFound is returned when the signer has no key for an account.
+	ErrAccountNotFound = errors.New("account not found")
+	// ErrUnauthorized is returned when the signer rejects the auth token of the node.
+	ErrUnauthorized = errors.New("unauthorized")
+)
+
+// maxResponseSize caps the size of the responses read from the signer.
+const maxResponseSize = 1 << 20
+
+// Client talks to a remote signer.
+type Client struct {
+	url        *url.URL
+	authToken  string
+	httpClient *http.Client
+}
+
+// NewClient returns a Client for the signer at signerURL, authenticating with authToken.
+// Each request to the signer is aborted after timeout. Plain http URLs are rejected unless
+// allowInsecure is set, as the token and the data to sign would be sent in the clear.
+func NewClient(signerURL, authToken string, timeout time.Duration, allowInsecure bool) (*Client, error) {
+	u, err := url.Parse(signerURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer URL: %w", err)
+	}
+	switch {
+	case u.Scheme == "https":
+	case u.Scheme == "http" && allowInsecure:
+	case u.Scheme == "http":
+		return nil, fmt.Errorf("invalid remote signer URL %q: scheme must be https", u.Redacted())
+	default:
+		return nil, fmt.Errorf("invalid remote signer URL %q: scheme must be http or https", u.Redacted())
+	}
+	if authToken == "" {
+		return nil, errors.New("remote signer auth token is required")
+	}
+	return &Client{url: u, authToken: authToken, httpClient: &http.Client{Timeout: timeout}}, nil
+}
+
+// Keystore returns a loop.Keystore signing with the keys of keyType held by the signer.
+// chainID is only set for chain specific key types, and may be nil.
+func (c *Client) Keystore(keyType KeyType, chainID *big.Int) *Keystore {
+	ks := &Keystore{client: c, keyType: keyType}
+	if chainID != nil {
+		ks.chainID = chainID.String()
+	}
+	return ks
+}
+
+func (c *Client) post(ctx context.Context, path string, req, resp any) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url.JoinPath(path).String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.authToken)
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("remote signer request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(httpResp.Body, maxResponseSize))
+	if err != nil {
+		return fmt.Errorf("failed to read remote signer response: %w", err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		if json.Unmarshal(b, &errResp) != nil || errResp.Error == "" {
+			errResp.Error = http.StatusText(httpResp.StatusCode)
+		}
+		switch httpResp.StatusCode {
+		case http.StatusNotFound:
+			return fmt.Errorf("%w: %s", ErrAccountNotFound, errResp.Error)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("remote signer rejected the auth token: %w", ErrUnauthorized)
+		}
+		return fmt.Errorf("remote signer returned status %d: %s", httpResp.StatusCode, errResp.Error)
+	}
+	if err = json.Unmarshal(b, resp); err != nil {
+		return fmt.Errorf("failed to decode remote signer response: %w", err)
+	}
+	return nil
+}
+
+var _ loop.Keystore = &Keystore{}
+
+// Keystore is a loop.Keystore for the keys of one type held by a remote signer.
+type Keystore struct {
+	client  *Client
+	keyType KeyType
+	chainID string
+}
+
+func (k *Keystore) Accounts(ctx context.Context) ([]string, error) {
+	var resp AccountsResponse
+	if err := k.client.post(ctx, AccountsPath, AccountsRequest{KeyType: k.keyType, ChainID: k.chainID}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Accounts, nil
+}
+
+func (k *Keystore) Sign(ctx context.Context, account string, data []byte) ([]byte, error) {
+	var resp SignResponse
+	req := SignRequest{KeyType: k.keyType, ChainID: k.chainID, Account: account, Data: data}
+	if err := k.client.post(ctx, SignPath, req, &resp); err != nil {
+		return nil, err
+	}
+	// loopp spec requires passing nil hash to check existence of id
+	if data == nil {
+		return nil, nil
+	}
+	if len(resp.Signature) == 0 {
+		return nil, errors.New("remote signer returned an empty signature")
+	}
+	return resp.Signature, nil
+}
@@ -0,0 +1,197 @@
+package remotesigner_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+)
+
+const testAuthToken = "test-auth-token"
+
+func newTestClient(t *testing.T, signer http.Handler) *remotesigner.Client {
+	srv := httptest.NewServer(signer)
+	t.Cleanup(srv.Close)
+	client, err := remotesigner.NewClient(srv.URL, testAuthToken, time.Second, true)
+	require.NoError(t, err)
+	return client
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	_, err := remotesigner.NewClient("ftp://signer", testAuthToken, time.Second, true)
+	require.ErrorContains(t, err, "scheme must be http or https")
+	_, err = remotesigner.NewClient("http://signer:6689", testAuthToken, time.Second, false)
+	require.ErrorContains(t, err, "scheme must be https")
+	_, err = remotesigner.NewClient("https://signer:6689/prefix", "", time.Second, false)
+	require.ErrorContains(t, err, "auth token is required")
+	_, err = remotesigner.NewClient("http://signer:6689", testAuthToken, time.Second, true)
+	require.NoError(t, err)
+	_, err = remotesigner.NewClient("https://signer:6689/prefix", testAuthToken, time.Second, false)
+	require.NoError(t, err)
+}
+
+func TestReferenceSigner_Unauthenticated(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	signer := remotesigner.NewReferenceSigner(testAuthToken)
+	signer.AddEthKey(key)
+	srv := httptest.NewServer(signer)
+	t.Cleanup(srv.Close)
+
+	t.Run("without a token", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+remotesigner.SignPath,
+			strings.NewReader(`{"keyType": "eth", "chainID": "1", "account": "`+key.ID()+`", "data": "AAAA"}`))
+		require.NoError(t, err)
+		resp, err := srv.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("with another token", func(t *testing.T) {
+		client, err := remotesigner.NewClient(srv.URL, "other-token", time.Second, true)
+		require.NoError(t, err)
+		ks := client.Keystore(remotesigner.KeyTypeEth, big.NewInt(1))
+
+		_, err = ks.Accounts(ctx)
+		require.ErrorIs(t, err, remotesigner.ErrUnauthorized)
+		_, err = ks.Sign(ctx, key.ID(), crypto.Keccak256([]byte("data")))
+		require.ErrorIs(t, err, remotesigner.ErrUnauthorized)
+	})
+}
+
+func TestKeystore_Eth(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	signer := remotesigner.NewReferenceSigner(testAuthToken)
+	signer.AddEthKey(key)
+	ks := newTestClient(t, signer).Keystore(remotesigner.KeyTypeEth, big.NewInt(1))
+
+	accounts, err := ks.Accounts(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{key.ID()}, accounts)
+
+	hash := crypto.Keccak256([]byte("data"))
+	sig, err := ks.Sign(ctx, key.ID(), hash)
+	require.NoError(t, err)
+	pub, err := crypto.SigToPub(hash, sig)
+	require.NoError(t, err)
+	assert.Equal(t, key.Address, crypto.PubkeyToAddress(*pub))
+
+	sig, err = ks.Sign(ctx, key.ID(), nil)
+	require.NoError(t, err)
+	assert.Nil(t, sig)
+
+	_, err = ks.Sign(ctx, key.ID(), []byte("not a hash"))
+	require.ErrorContains(t, err, "status 400")
+
+	_, err = ks.Sign(ctx, common.HexToAddress("0x1").Hex(), hash)
+	require.ErrorIs(t, err, remotesigner.ErrAccountNotFound)
+}
+
+func TestKeystore_CSA(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	key := csakey.MustNewV2XXXTestingOnly(big.NewInt(1))
+	signer := remotesigner.NewReferenceSigner(testAuthToken)
+	signer.AddCSAKey(key)
+	ks := newTestClient(t, signer).Keystore(remotesigner.KeyTypeCSA, nil)
+
+	accounts, err := ks.Accounts(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{key.ID()}, accounts)
+
+	sig, err := ks.Sign(ctx, key.ID(), []byte("data"))
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(key.PublicKey, []byte("data"), sig))
+
+	_, err = ks.Sign(ctx, "unknown", []byte("data"))
+	require.ErrorIs(t, err, remotesigner.ErrAccountNotFound)
+}
+
+func TestEVMOnchainKeyring(t *testing.T) {
+	t.Parallel()
+
+	bundle := ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+	signer := remotesigner.NewReferenceSigner(testAuthToken)
+	signer.AddOCR2KeyBundle(bundle)
+	ks := newTestClient(t, signer).Keystore(remotesigner.KeyTypeOCR2, nil)
+	keyring := remotesigner.NewEVMOnchainKeyring(ks, bundle.ID(), common.BytesToAddress(bundle.PublicKey()))
+	assert.Equal(t, bundle.PublicKey(), keyring.PublicKey())
+
+	reportCtx := types.ReportContext{ReportTimestamp: types.ReportTimestamp{Epoch: 1, Round: 2}}
+	report := types.Report("report")
+	sig, err := keyring.Sign(reportCtx, report)
+	require.NoError(t, err)
+	assert.True(t, keyring.Verify(bundle.PublicKey(), reportCtx, report, sig))
+	assert.True(t, bundle.Verify(bundle.PublicKey(), reportCtx, report, sig))
+	assert.False(t, keyring.Verify(bundle.PublicKey(), reportCtx, types.Report("other"), sig))
+
+	sig, err = keyring.Sign3(types.ConfigDigest{1}, 3, report)
+	require.NoError(t, err)
+	assert.True(t, bundle.Verify3(bundle.PublicKey(), types.ConfigDigest{1}, 3, report, sig))
+
+	_, err = remotesigner.NewEVMOnchainKeyring(ks, "unknown", common.Address{}).Sign(reportCtx, report)
+	require.ErrorIs(t, err, remotesigner.ErrAccountNotFound)
+}
+
+func TestNewKeyBundle(t *testing.T) {
+	t.Parallel()
+
+	bundle := ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+	signer := remotesigner.NewReferenceSigner(testAuthToken)
+	ks := newTestClient(t, signer).Keystore(remotesigner.KeyTypeOCR2, nil)
+	kb := remotesigner.NewKeyBundle(ks, bundle)
+	assert.Equal(t, bundle.ID(), kb.ID())
+	assert.Equal(t, bundle.OffchainPublicKey(), kb.OffchainPublicKey())
+
+	reportCtx := types.ReportContext{ReportTimestamp: types.ReportTimestamp{Epoch: 1, Round: 2}}
+	report := types.Report("report")
+	_, err := kb.Sign(reportCtx, report)
+	require.ErrorIs(t, err, remotesigner.ErrAccountNotFound, "onchain signatures must be made by the remote signer")
+
+	signer.AddOCR2KeyBundle(bundle)
+	sig, err := kb.Sign(reportCtx, report)
+	require.NoError(t, err)
+	assert.True(t, bundle.Verify(bundle.PublicKey(), reportCtx, report, sig))
+	sig, err = kb.Sign3(types.ConfigDigest{1}, 3, report)
+	require.NoError(t, err)
+	assert.True(t, bundle.Verify3(bundle.PublicKey(), types.ConfigDigest{1}, 3, report, sig))
+
+	solanaBundle := ocr2key.MustNewInsecure(rand.Reader, chaintype.Solana)
+	assert.Equal(t, solanaBundle, remotesigner.NewKeyBundle(ks, solanaBundle))
+}
+
+func TestReferenceSigner_UnknownKeyType(t *testing.T) {
+	t.Parallel()
+
+	ks := newTestClient(t, remotesigner.NewReferenceSigner(testAuthToken)).Keystore("p2p", nil)
+	_, err := ks.Accounts(testutils.Context(t))
+	require.ErrorContains(t, err, `unknown key type "p2p"`)
+}
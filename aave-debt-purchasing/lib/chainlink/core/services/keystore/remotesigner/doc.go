@@ -0,0 +1,42 @@
+// Package remotesigner delegates keystore signing to an external signer process, so that the
+// private keys never have to be decrypted into the memory of the node.
+//
+// # Protocol
+//
+// The signer is an HTTP server accepting JSON requests. Both endpoints take a POST request,
+// and answer with status 200 and a JSON body on success. Errors are answered with a non-2xx
+// status and a body of the form {"error": "<message>"}; an unknown account must be answered
+// with status 404.
+//
+// Every request carries the auth token of the node, from the RemoteSigner.AuthToken secret,
+// in an "Authorization: Bearer <token>" header. The signer must answer requests without the
+// expected token with status 401 before looking at their body. The node only talks to the
+// signer over https, so that the token and the data to sign are not sent in the clear, except
+// in dev mode.
+//
+// POST /v1/accounts lists the accounts of a key type:
+//
+//	request:  {"keyType": "eth", "chainID": "1"}
+//	response: {"accounts": ["0x9D6d1A8F7D4A8F8E6F2D9B1C3A4E5F60718293A4"]}
+//
+// POST /v1/sign signs data with the key of an account:
+//
+//	request:  {"keyType": "eth", "chainID": "1", "account": "0x9D6d...93A4", "data": "<base64>"}
+//	response: {"signature": "<base64>"}
+//
+// A sign request without data only checks that the account exists, and is answered with a
+// null signature, as required by [github.com/smartcontractkit/chainlink-common/pkg/loop.Keystore].
+//
+// The key types, and what a signature is for each of them, are:
+//
+//   - "eth": accounts are EIP-55 addresses. The data is a 32 bytes hash, and the signature is
+//     its 65 bytes secp256k1 signature in the [R || S || V] format, with V being 0 or 1. The
+//     chainID is set so that the signer can scope the accounts to a chain.
+//   - "csa": accounts are the hex encoded ed25519 public keys. The signature is the ed25519
+//     signature of the data.
+//   - "ocr2": accounts are the IDs of the OCR2 key bundles. The signature is the onchain
+//     signature of the data by the bundle, as made by SignBlob.
+//
+// [ReferenceSigner] implements the protocol with keys held in memory. It is meant for tests
+// and as a reference for signer implementations, and is served by core/scripts/remote-signer.
+package remotesigner
@@ -0,0 +1,103 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+)
+
+var _ ocrtypes.OnchainKeyring = &EVMOnchainKeyring{}
+
+// EVMOnchainKeyring is the onchain keyring of an EVM OCR2 key bundle held by a remote signer.
+// Reports are hashed by the node and only the hashes are sent to the signer, so signatures
+// match the ones of the bundle held in the local keystore.
+type EVMOnchainKeyring struct {
+	ks       *Keystore
+	bundleID string
+	address  common.Address
+}
+
+// NewEVMOnchainKeyring returns the onchain keyring of the bundle bundleID, whose onchain
+// public key is the signing address.
+func NewEVMOnchainKeyring(ks *Keystore, bundleID string, address common.Address) *EVMOnchainKeyring {
+	return &EVMOnchainKeyring{ks: ks, bundleID: bundleID, address: address}
+}
+
+func (k *EVMOnchainKeyring) PublicKey() ocrtypes.OnchainPublicKey {
+	return k.address[:]
+}
+
+func (k *EVMOnchainKeyring) Sign(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) ([]byte, error) {
+	return k.SignBlob(ocr2key.ReportToSigData(reportCtx, report))
+}
+
+func (k *EVMOnchainKeyring) Sign3(digest ocrtypes.ConfigDigest, seqNr uint64, r ocrtypes.Report) ([]byte, error) {
+	return k.SignBlob(ocr2key.ReportToSigData3(digest, seqNr, r))
+}
+
+// SignBlob signs b with the remote bundle. The OCR interfaces are not context aware, so
+// the request is only bounded by the timeout of the Client.
+func (k *EVMOnchainKeyring) SignBlob(b []byte) ([]byte, error) {
+	return k.ks.Sign(context.Background(), k.bundleID, b)
+}
+
+func (k *EVMOnchainKeyring) Verify(publicKey ocrtypes.OnchainPublicKey, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signature []byte) bool {
+	return k.VerifyBlob(publicKey, ocr2key.ReportToSigData(reportCtx, report), signature)
+}
+
+func (k *EVMOnchainKeyring) Verify3(publicKey ocrtypes.OnchainPublicKey, cd ocrtypes.ConfigDigest, seqNr uint64, r ocrtypes.Report, signature []byte) bool {
+	return k.VerifyBlob(publicKey, ocr2key.ReportToSigData3(cd, seqNr, r), signature)
+}
+
+func (k *EVMOnchainKeyring) VerifyBlob(publicKey ocrtypes.OnchainPublicKey, b, signature []byte) bool {
+	authorPubkey, err := crypto.SigToPub(b, signature)
+	if err != nil {
+		return false
+	}
+	authorAddress := crypto.PubkeyToAddress(*authorPubkey)
+	return bytes.Equal(publicKey, authorAddress[:])
+}
+
+func (k *EVMOnchainKeyring) MaxSignatureLength() int {
+	return 65
+}
+
+var _ ocr2key.KeyBundle = &KeyBundle{}
+
+// KeyBundle is an EVM OCR2 key bundle of the local keystore whose onchain signatures are made
+// by the remote signer, which holds the onchain key of the bundle under the same ID. The
+// offchain keys of the bundle are still held by the local keystore.
+type KeyBundle struct {
+	ocr2key.KeyBundle
+	onchain *EVMOnchainKeyring
+}
+
+// NewKeyBundle returns kb signing reports with the remote signer if it is an EVM bundle, and
+// kb itself otherwise.
+func NewKeyBundle(ks *Keystore, kb ocr2key.KeyBundle) ocr2key.KeyBundle {
+	if kb.ChainType() != chaintype.EVM {
+		return kb
+	}
+	return &KeyBundle{
+		KeyBundle: kb,
+		onchain:   NewEVMOnchainKeyring(ks, kb.ID(), common.BytesToAddress(kb.PublicKey())),
+	}
+}
+
+func (kb *KeyBundle) Sign(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) ([]byte, error) {
+	return kb.onchain.Sign(reportCtx, report)
+}
+
+func (kb *KeyBundle) Sign3(digest ocrtypes.ConfigDigest, seqNr uint64, r ocrtypes.Report) ([]byte, error) {
+	return kb.onchain.Sign3(digest, seqNr, r)
+}
+
+func (kb *KeyBundle) SignBlob(b []byte) ([]byte, error) {
+	return kb.onchain.SignBlob(b)
+}
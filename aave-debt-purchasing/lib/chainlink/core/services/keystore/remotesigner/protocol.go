@@ -0,0 +1,44 @@
+package remotesigner
+
+// KeyType is the type of the keys an account belongs to.
+type KeyType string
+
+const (
+	KeyTypeEth  KeyType = "eth"
+	KeyTypeCSA  KeyType = "csa"
+	KeyTypeOCR2 KeyType = "ocr2"
+)
+
+const (
+	AccountsPath = "/v1/accounts"
+	SignPath     = "/v1/sign"
+)
+
+// AccountsRequest is the body of a request to AccountsPath.
+type AccountsRequest struct {
+	KeyType KeyType `json:"keyType"`
+	ChainID string  `json:"chainID,omitempty"`
+}
+
+// AccountsResponse is the body of a successful response from AccountsPath.
+type AccountsResponse struct {
+	Accounts []string `json:"accounts"`
+}
+
+// SignRequest is the body of a request to SignPath.
+type SignRequest struct {
+	KeyType KeyType `json:"keyType"`
+	ChainID string  `json:"chainID,omitempty"`
+	Account string  `json:"account"`
+	Data    []byte  `json:"data"`
+}
+
+// SignResponse is the body of a successful response from SignPath.
+type SignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// ErrorResponse is the body of a failed response.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
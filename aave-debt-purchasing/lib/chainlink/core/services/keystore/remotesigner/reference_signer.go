@@ -0,0 +1,194 @@
+package remotesigner
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+)
+
+// maxRequestSize caps the size of the requests read by the ReferenceSigner.
+const maxRequestSize = 1 << 20
+
+var errBadRequest = errors.New("bad request")
+
+var _ http.Handler = &ReferenceSigner{}
+
+// ReferenceSigner is an http.Handler implementing the signer side of the protocol, with keys
+// held in memory. Eth accounts are served for every chain.
+type ReferenceSigner struct {
+	authToken string
+
+	mu   sync.RWMutex
+	eth  map[string]ethkey.KeyV2
+	csa  map[string]csakey.KeyV2
+	ocr2 map[string]ocr2key.KeyBundle
+	mux  *http.ServeMux
+}
+
+// NewReferenceSigner returns a ReferenceSigner without any key, serving the requests
+// authenticated with authToken.
+func NewReferenceSigner(authToken string) *ReferenceSigner {
+	s := &ReferenceSigner{
+		authToken: authToken,
+		eth:       make(map[string]ethkey.KeyV2),
+		csa:       make(map[string]csakey.KeyV2),
+		ocr2:      make(map[string]ocr2key.KeyBundle),
+		mux:       http.NewServeMux(),
+	}
+	s.mux.HandleFunc("POST "+AccountsPath, s.handleAccounts)
+	s.mux.HandleFunc("POST "+SignPath, s.handleSign)
+	return s
+}
+
+func (s *ReferenceSigner) AddEthKey(key ethkey.KeyV2) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.eth[key.ID()] = key
+}
+
+func (s *ReferenceSigner) AddCSAKey(key csakey.KeyV2) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.csa[key.ID()] = key
+}
+
+func (s *ReferenceSigner) AddOCR2KeyBundle(key ocr2key.KeyBundle) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ocr2[key.ID()] = key
+}
+
+func (s *ReferenceSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	want := "Bearer " + s.authToken
+	if s.authToken == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(want)) != 1 {
+		writeError(w, http.StatusUnauthorized, ErrUnauthorized)
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *ReferenceSigner) handleAccounts(w http.ResponseWriter, r *http.Request) {
+	var req AccountsRequest
+	if err := decodeRequest(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	accounts, err := s.accounts(req.KeyType)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, AccountsResponse{Accounts: accounts})
+}
+
+func (s *ReferenceSigner) handleSign(w http.ResponseWriter, r *http.Request) {
+	var req SignRequest
+	if err := decodeRequest(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	signature, err := s.sign(req)
+	switch {
+	case errors.Is(err, ErrAccountNotFound):
+		writeError(w, http.StatusNotFound, err)
+	case err != nil:
+		writeError(w, http.StatusBadRequest, err)
+	default:
+		writeJSON(w, http.StatusOK, SignResponse{Signature: signature})
+	}
+}
+
+func (s *ReferenceSigner) accounts(keyType KeyType) (accounts []string, err error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	switch keyType {
+	case KeyTypeEth:
+		for id := range s.eth {
+			accounts = append(accounts, id)
+		}
+	case KeyTypeCSA:
+		for id := range s.csa {
+			accounts = append(accounts, id)
+		}
+	case KeyTypeOCR2:
+		for id := range s.ocr2 {
+			accounts = append(accounts, id)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown key type %q", errBadRequest, keyType)
+	}
+	sort.Strings(accounts)
+	return accounts, nil
+}
+
+func (s *ReferenceSigner) sign(req SignRequest) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	switch req.KeyType {
+	case KeyTypeEth:
+		if !common.IsHexAddress(req.Account) {
+			return nil, fmt.Errorf("%w: invalid eth account %q", errBadRequest, req.Account)
+		}
+		key, ok := s.eth[common.HexToAddress(req.Account).Hex()]
+		if !ok {
+			return nil, fmt.Errorf("%w: eth key %s", ErrAccountNotFound, req.Account)
+		}
+		if req.Data == nil {
+			return nil, nil
+		}
+		if len(req.Data) != gethcrypto.DigestLength {
+			return nil, fmt.Errorf("%w: eth data must be a %d bytes hash, got %d bytes", errBadRequest, gethcrypto.DigestLength, len(req.Data))
+		}
+		return gethcrypto.Sign(req.Data, key.ToEcdsaPrivKey())
+	case KeyTypeCSA:
+		key, ok := s.csa[req.Account]
+		if !ok {
+			return nil, fmt.Errorf("%w: csa key %s", ErrAccountNotFound, req.Account)
+		}
+		if req.Data == nil {
+			return nil, nil
+		}
+		return key.Signer().Sign(rand.Reader, req.Data, crypto.Hash(0))
+	case KeyTypeOCR2:
+		key, ok := s.ocr2[req.Account]
+		if !ok {
+			return nil, fmt.Errorf("%w: ocr2 key bundle %s", ErrAccountNotFound, req.Account)
+		}
+		if req.Data == nil {
+			return nil, nil
+		}
+		return key.SignBlob(req.Data)
+	default:
+		return nil, fmt.Errorf("%w: unknown key type %q", errBadRequest, req.KeyType)
+	}
+}
+
+func decodeRequest(w http.ResponseWriter, r *http.Request, req any) error {
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(req); err != nil {
+		return fmt.Errorf("%w: %w", errBadRequest, err)
+	}
+	return nil
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, ErrorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -49,6 +49,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipcommit"
@@ -118,6 +119,7 @@ type Delegate struct {
 	lggr                  logger.Logger
 	ks                    keystore.OCR2
 	ethKs                 keystore.Eth
+	remoteSigner          *remotesigner.Client
 	RelayGetter
 	isNewlyCreatedJob     bool // Set to true if this is a new job freshly added, false if job was present already on node boot.
 	mailMon               *mailbox.Monitor
@@ -228,6 +230,8 @@ type DelegateOpts struct {
 	Lggr                  logger.Logger
 	Ks                    keystore.OCR2
 	EthKs                 keystore.Eth
+	// RemoteSigner makes the Eth and OCR2 onchain signatures instead of the keystore, if set.
+	RemoteSigner          *remotesigner.Client
 	Relayers              RelayGetter
 	MailMon               *mailbox.Monitor
 	CapabilitiesRegistry  core.CapabilitiesRegistry
@@ -252,6 +256,7 @@ func NewDelegate(
 		lggr:                  opts.Lggr.Named("OCR2"),
 		ks:                    opts.Ks,
 		ethKs:                 opts.EthKs,
+		remoteSigner:          opts.RemoteSigner,
 		RelayGetter:           opts.Relayers,
 		isNewlyCreatedJob:     false,
 		mailMon:               opts.MailMon,
@@ -260,6 +265,23 @@ func NewDelegate(
 	}
 }
 
+// keyBundle returns the OCR2 key bundle kbID of the keystore, signing reports with the remote
+// signer if there is one.
+func (d *Delegate) keyBundle(kbID string) (ocr2key.KeyBundle, error) {
+	kb, err := d.ks.Get(kbID)
+	if err != nil {
+		return nil, err
+	}
+	return d.withRemoteSigner(kb), nil
+}
+
+func (d *Delegate) withRemoteSigner(kb ocr2key.KeyBundle) ocr2key.KeyBundle {
+	if d.remoteSigner == nil {
+		return kb
+	}
+	return remotesigner.NewKeyBundle(d.remoteSigner.Keystore(remotesigner.KeyTypeOCR2, nil), kb)
+}
+
 func (d *Delegate) JobType() job.Type {
 	return job.OffchainReporting2
 }
@@ -494,7 +516,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 	} else if kbID, err = d.cfg.OCR2().KeyBundleID(); err != nil {
 		return nil, err
 	}
-	kb, err := d.ks.Get(kbID)
+	kb, err := d.keyBundle(kbID)
 	if err != nil {
 		return nil, err
 	}
@@ -792,7 +814,7 @@ func (d *Delegate) newServicesGenericPlugin(
 				if ostErr != nil {
 					return nil, ostErr
 				}
-				os, ostErr := d.ks.Get(kbID)
+				os, ostErr := d.keyBundle(kbID)
 				if ostErr != nil {
 					return nil, ostErr
 				}
@@ -995,7 +1017,7 @@ func (d *Delegate) newServicesLLO(
 	// Handle key bundle IDs explicitly specified in job spec
 	kbm := make(map[llotypes.ReportFormat]llo.Key)
 	for rfStr, kbid := range pluginCfg.KeyBundleIDs {
-		k, err3 := d.ks.Get(kbid)
+		k, err3 := d.keyBundle(kbid)
 		if err3 != nil {
 			return nil, fmt.Errorf("job %d (%s) specified key bundle ID %q for report format %s, but got error trying to load it: %w", jb.ID, jb.Name.ValueOrZero(), kbid, rfStr, err3)
 		}
@@ -1031,7 +1053,7 @@ func (d *Delegate) newServicesLLO(
 			} else if len(kbs) > 1 {
 				lggr.Debugf("Multiple on-chain signing keys found for report format %s, using the first", rf.String())
 			}
-			kbm[rf] = kbs[0]
+			kbm[rf] = d.withRemoteSigner(kbs[0])
 		}
 	}
 
@@ -1357,7 +1379,7 @@ func (d *Delegate) newServicesOCR2Keepers20(
 	}
 
 	cid := chain.ID()
-	ks := keys.NewChainStore(keystore.NewEthSigner(d.ethKs, cid), cid)
+	ks := keys.NewChainStore(evmrelay.NewEthSigner(d.ethKs, d.remoteSigner, cid), cid)
 	keeperProvider, rgstry, encoder, logProvider, err2 := ocr2keeper.EVMDependencies20(ctx, jb, d.ds, lggr, chain, ks)
 	if err2 != nil {
 		return nil, errors.Wrap(err2, "could not build dependencies for ocr2 keepers")
@@ -1486,7 +1508,7 @@ func (d *Delegate) newServicesOCR2Functions(
 		return nil, fmt.Errorf("functions services: failed to get chain %s: %w", rid.ChainID, err)
 	}
 	cid := chain.ID()
-	ks := keys.NewChainStore(keystore.NewEthSigner(d.ethKs, cid), cid)
+	ks := keys.NewChainStore(evmrelay.NewEthSigner(d.ethKs, d.remoteSigner, cid), cid)
 	createPluginProvider := func(pluginType functionsRelay.FunctionsPluginType, relayerName string) (evmrelaytypes.FunctionsProvider, error) {
 		return evmrelay.NewFunctionsProvider(
 			ctx,
@@ -187,6 +187,7 @@ func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOra
 			Logger:        s4OracleArgs.Logger,
 			ORM:           s4ORM,
 			ConfigDecoder: config.S4ConfigDecoder,
+			Notifier:      s4Storage,
 		}
 		s4ReportingPluginOracle, err := libocr2.NewOracle(*s4OracleArgs)
 		if err != nil {
@@ -17,6 +17,8 @@ type S4ReportingPluginFactory struct {
 	Logger        commontypes.Logger
 	ORM           s4_orm.ORM
 	ConfigDecoder PluginConfigDecoder
+	// Notifier is sent the confirmations of rows, if not nil
+	Notifier s4_orm.Notifier
 }
 
 var _ types.ReportingPluginFactory = (*S4ReportingPluginFactory)(nil)
@@ -35,7 +37,7 @@ func (f S4ReportingPluginFactory) NewReportingPlugin(ctx context.Context, rpConf
 		UniqueReports: false,
 		Limits:        *limits,
 	}
-	plugin, err := NewReportingPlugin(f.Logger, config, f.ORM)
+	plugin, err := NewReportingPlugin(f.Logger, config, f.ORM, f.Notifier)
 	if err != nil {
 		f.Logger.Error("unable to create S4 reporting plugin", commontypes.LogFields{})
 		return nil, types.ReportingPluginInfo{}, err
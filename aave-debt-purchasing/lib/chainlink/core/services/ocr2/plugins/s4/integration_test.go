@@ -56,7 +56,7 @@ func newDON(t *testing.T, size int, config *s4.PluginConfig) *don {
 		orms[i] = orm
 
 		ocrLogger := commonlogger.NewOCRWrapper(logger, true, func(msg string) {})
-		plugin, err := s4.NewReportingPlugin(ocrLogger, config, orm)
+		plugin, err := s4.NewReportingPlugin(ocrLogger, config, orm, nil)
 		require.NoError(t, err)
 		plugins[i] = plugin
 	}
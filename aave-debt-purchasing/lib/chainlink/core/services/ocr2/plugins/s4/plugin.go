@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -31,6 +32,7 @@ type plugin struct {
 	logger       commontypes.Logger
 	config       *PluginConfig
 	orm          s4.ORM
+	notifier     s4.Notifier
 	addressRange *s4.AddressRange
 }
 
@@ -41,7 +43,8 @@ type key struct {
 
 var _ types.ReportingPlugin = (*plugin)(nil)
 
-func NewReportingPlugin(logger commontypes.Logger, config *PluginConfig, orm s4.ORM) (types.ReportingPlugin, error) {
+// NewReportingPlugin creates the S4 reporting plugin. The confirmations of rows are sent to notifier, if not nil.
+func NewReportingPlugin(logger commontypes.Logger, config *PluginConfig, orm s4.ORM, notifier s4.Notifier) (types.ReportingPlugin, error) {
 	if config.MaxObservationEntries == 0 {
 		return nil, errors.New("max number of observation entries cannot be zero")
 	}
@@ -61,6 +64,7 @@ func NewReportingPlugin(logger commontypes.Logger, config *PluginConfig, orm s4.
 		logger:       logger,
 		config:       config,
 		orm:          orm,
+		notifier:     notifier,
 		addressRange: addressRange,
 	}, nil
 }
@@ -116,6 +120,12 @@ func (c *plugin) Observation(ctx context.Context, ts types.ReportTimestamp, quer
 	}
 	promReportingPluginsExpiredRows.WithLabelValues(c.config.ProductName).Add(float64(count))
 
+	orphanedCount, err := c.orm.DeleteOrphanedChunks(ctx, c.config.MaxDeleteExpiredEntries)
+	if err != nil {
+		c.logger.Error("ORM DeleteOrphanedChunks error", commontypes.LogFields{"err": err})
+	}
+	promReportingPluginsOrphanedChunks.WithLabelValues(c.config.ProductName).Add(float64(orphanedCount))
+
 	returnObservation := func(rows []*s4.Row) (types.Observation, error) {
 		promReportingPluginsObservationRowsCount.WithLabelValues(c.config.ProductName).Set(float64(len(rows)))
 		return MarshalRows(convertRows(rows))
@@ -288,6 +298,17 @@ func (c *plugin) ShouldAcceptFinalizedReport(ctx context.Context, ts types.Repor
 			continue
 		}
 		promStoragePluginUpdatesCount.WithLabelValues().Inc()
+		if err == nil && c.notifier != nil {
+			c.notifier.Notify(s4.Event{
+				Type: s4.EventConfirmed,
+				Key: s4.Key{
+					Address: common.BigToAddress(ormRow.Address.ToInt()),
+					SlotId:  ormRow.SlotId,
+					Version: ormRow.Version,
+				},
+				Expiration: ormRow.Expiration,
+			})
+		}
 	}
 
 	c.logger.Debug("S4StorageReporting ShouldAcceptFinalizedReport", commontypes.LogFields{
@@ -129,7 +129,7 @@ func TestPlugin_NewReportingPlugin(t *testing.T) {
 		config := createPluginConfig(1)
 		config.NSnapshotShards = 0
 
-		_, err := s4.NewReportingPlugin(logger, config, orm)
+		_, err := s4.NewReportingPlugin(logger, config, orm, nil)
 		assert.ErrorIs(t, err, s4_svc.ErrInvalidIntervals)
 	})
 
@@ -137,7 +137,7 @@ func TestPlugin_NewReportingPlugin(t *testing.T) {
 		config := createPluginConfig(1)
 		config.MaxObservationEntries = 0
 
-		_, err := s4.NewReportingPlugin(logger, config, orm)
+		_, err := s4.NewReportingPlugin(logger, config, orm, nil)
 		assert.ErrorContains(t, err, "max number of observation entries cannot be zero")
 	})
 
@@ -145,7 +145,7 @@ func TestPlugin_NewReportingPlugin(t *testing.T) {
 		config := createPluginConfig(1)
 		config.MaxReportEntries = 0
 
-		_, err := s4.NewReportingPlugin(logger, config, orm)
+		_, err := s4.NewReportingPlugin(logger, config, orm, nil)
 		assert.ErrorContains(t, err, "max number of report entries cannot be zero")
 	})
 
@@ -153,13 +153,13 @@ func TestPlugin_NewReportingPlugin(t *testing.T) {
 		config := createPluginConfig(1)
 		config.MaxDeleteExpiredEntries = 0
 
-		_, err := s4.NewReportingPlugin(logger, config, orm)
+		_, err := s4.NewReportingPlugin(logger, config, orm, nil)
 		assert.ErrorContains(t, err, "max number of delete expired entries cannot be zero")
 	})
 
 	t.Run("happy", func(t *testing.T) {
 		config := createPluginConfig(1)
-		p, err := s4.NewReportingPlugin(logger, config, orm)
+		p, err := s4.NewReportingPlugin(logger, config, orm, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, p)
 	})
@@ -171,7 +171,7 @@ func TestPlugin_Close(t *testing.T) {
 	logger := commonlogger.NewOCRWrapper(logger.TestLogger(t), true, func(msg string) {})
 	config := createPluginConfig(10)
 	orm := s4_mocks.NewORM(t)
-	plugin, err := s4.NewReportingPlugin(logger, config, orm)
+	plugin, err := s4.NewReportingPlugin(logger, config, orm, nil)
 	assert.NoError(t, err)
 
 	err = plugin.Close()
@@ -184,7 +184,7 @@ func TestPlugin_ShouldTransmitAcceptedReport(t *testing.T) {
 	logger := commonlogger.NewOCRWrapper(logger.TestLogger(t), true, func(msg string) {})
 	config := createPluginConfig(10)
 	orm := s4_mocks.NewORM(t)
-	plugin, err := s4.NewReportingPlugin(logger, config, orm)
+	plugin, err := s4.NewReportingPlugin(logger, config, orm, nil)
 	assert.NoError(t, err)
 
 	should, err := plugin.ShouldTransmitAcceptedReport(testutils.Context(t), types.ReportTimestamp{}, nil)
@@ -198,7 +198,7 @@ func TestPlugin_ShouldAcceptFinalizedReport(t *testing.T) {
 	logger := commonlogger.NewOCRWrapper(logger.TestLogger(t), true, func(msg string) {})
 	config := createPluginConfig(10)
 	orm := s4_mocks.NewORM(t)
-	plugin, err := s4.NewReportingPlugin(logger, config, orm)
+	plugin, err := s4.NewReportingPlugin(logger, config, orm, nil)
 	assert.NoError(t, err)
 
 	t.Run("happy", func(t *testing.T) {
@@ -252,13 +252,46 @@ func TestPlugin_ShouldAcceptFinalizedReport(t *testing.T) {
 	})
 }
 
+func TestPlugin_ShouldAcceptFinalizedReport_Notifier(t *testing.T) {
+	t.Parallel()
+
+	logger := commonlogger.NewOCRWrapper(logger.TestLogger(t), true, func(msg string) {})
+	config := createPluginConfig(10)
+	orm := s4_mocks.NewORM(t)
+	notifier := s4_mocks.NewStorage(t)
+	plugin, err := s4.NewReportingPlugin(logger, config, orm, notifier)
+	assert.NoError(t, err)
+
+	rows := generateTestRows(t, 2, time.Minute)
+	orm.On("Update", mock.Anything, mock.Anything).Return(nil).Once()
+	orm.On("Update", mock.Anything, mock.Anything).Return(s4_svc.ErrVersionTooLow).Once()
+	notifier.On("Notify", s4_svc.Event{
+		Type: s4_svc.EventConfirmed,
+		Key: s4_svc.Key{
+			Address: common.BytesToAddress(rows[0].Address),
+			SlotId:  uint(rows[0].Slotid),
+			Version: rows[0].Version,
+		},
+		Expiration: rows[0].Expiration,
+	}).Once()
+
+	report, err := proto.Marshal(&s4.Rows{
+		Rows: rows,
+	})
+	assert.NoError(t, err)
+
+	should, err := plugin.ShouldAcceptFinalizedReport(testutils.Context(t), types.ReportTimestamp{}, report)
+	assert.NoError(t, err)
+	assert.False(t, should)
+}
+
 func TestPlugin_Query(t *testing.T) {
 	t.Parallel()
 
 	logger := commonlogger.NewOCRWrapper(logger.TestLogger(t), true, func(msg string) {})
 	config := createPluginConfig(10)
 	orm := s4_mocks.NewORM(t)
-	plugin, err := s4.NewReportingPlugin(logger, config, orm)
+	plugin, err := s4.NewReportingPlugin(logger, config, orm, nil)
 	assert.NoError(t, err)
 
 	t.Run("happy", func(t *testing.T) {
@@ -335,7 +368,7 @@ func TestPlugin_Observation(t *testing.T) {
 	logger := commonlogger.NewOCRWrapper(logger.TestLogger(t), true, func(msg string) {})
 	config := createPluginConfig(10)
 	orm := s4_mocks.NewORM(t)
-	plugin, err := s4.NewReportingPlugin(logger, config, orm)
+	plugin, err := s4.NewReportingPlugin(logger, config, orm, nil)
 	assert.NoError(t, err)
 
 	t.Run("all unconfirmed", func(t *testing.T) {
@@ -344,6 +377,7 @@ func TestPlugin_Observation(t *testing.T) {
 			or.Confirmed = false
 		}
 		orm.On("DeleteExpired", mock.Anything, uint(10), mock.Anything, mock.Anything).Return(int64(10), nil).Once()
+		orm.On("DeleteOrphanedChunks", mock.Anything, uint(10)).Return(int64(0), nil).Once()
 		orm.On("GetUnconfirmedRows", mock.Anything, config.MaxObservationEntries).Return(ormRows, nil).Once()
 
 		observation, err := plugin.Observation(testutils.Context(t), types.ReportTimestamp{}, []byte{})
@@ -370,6 +404,7 @@ func TestPlugin_Observation(t *testing.T) {
 			}
 		}
 		orm.On("DeleteExpired", mock.Anything, uint(10), mock.Anything, mock.Anything).Return(int64(10), nil).Once()
+		orm.On("DeleteOrphanedChunks", mock.Anything, uint(10)).Return(int64(0), nil).Once()
 		orm.On("GetUnconfirmedRows", mock.Anything, config.MaxObservationEntries).Return(ormRows[numUnconfirmed:], nil).Once()
 		orm.On("GetSnapshot", mock.Anything, mock.Anything).Return(snapshot, nil).Once()
 
@@ -447,6 +482,7 @@ func TestPlugin_Observation(t *testing.T) {
 		assert.NoError(t, err)
 
 		orm.On("DeleteExpired", mock.Anything, uint(10), mock.Anything, mock.Anything).Return(int64(10), nil).Once()
+		orm.On("DeleteOrphanedChunks", mock.Anything, uint(10)).Return(int64(0), nil).Once()
 		orm.On("GetUnconfirmedRows", mock.Anything, config.MaxObservationEntries).Return([]*s4_svc.Row{}, nil).Once()
 		orm.On("GetSnapshot", mock.Anything, mock.Anything).Return(snapshot, nil).Once()
 		orm.On("Get", mock.Anything, snapshot[1].Address, snapshot[1].SlotId).Return(ormRows[1], nil).Once()
@@ -468,7 +504,7 @@ func TestPlugin_Report(t *testing.T) {
 	logger := commonlogger.NewOCRWrapper(logger.TestLogger(t), true, func(msg string) {})
 	config := createPluginConfig(10)
 	orm := s4_mocks.NewORM(t)
-	plugin, err := s4.NewReportingPlugin(logger, config, orm)
+	plugin, err := s4.NewReportingPlugin(logger, config, orm, nil)
 	assert.NoError(t, err)
 
 	rows := generateTestRows(t, 10, time.Minute)
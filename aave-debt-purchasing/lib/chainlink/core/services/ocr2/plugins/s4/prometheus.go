@@ -55,4 +55,9 @@ var (
 		Name: "s4_reporting_plugin_expired_rows",
 		Help: "Metric to track number of expired rows",
 	}, []string{"product"})
+
+	promReportingPluginsOrphanedChunks = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "s4_reporting_plugin_orphaned_chunks",
+		Help: "Metric to track number of deleted orphaned chunks",
+	}, []string{"product"})
 )
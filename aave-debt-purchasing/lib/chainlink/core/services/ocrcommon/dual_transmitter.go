@@ -61,7 +61,7 @@ func (t *ocr2FeedsDualTransmission) CreateEthTransaction(ctx context.Context, to
 		return err
 	}
 
-	_, err = t.txm.CreateTransaction(ctx, txmgr.TxRequest{
+	_, err = t.txm.CreateTransaction(withTransmitPriority(ctx), txmgr.TxRequest{
 		FromAddress:      roundRobinFromAddress,
 		ToAddress:        toAddress,
 		EncodedPayload:   payload,
@@ -91,7 +91,7 @@ func (t *ocr2FeedsDualTransmission) CreateSecondaryEthTransaction(ctx context.Co
 	txMeta.DualBroadcast = &dualBroadcast
 	txMeta.DualBroadcastParams = &dualBroadcastParams
 
-	_, err = t.txm.CreateTransaction(ctx, txmgr.TxRequest{
+	_, err = t.txm.CreateTransaction(withTransmitPriority(ctx), txmgr.TxRequest{
 		FromAddress:      t.secondaryFromAddress,
 		ToAddress:        t.secondaryContractAddress,
 		EncodedPayload:   payload,
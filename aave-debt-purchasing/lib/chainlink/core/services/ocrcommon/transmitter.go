@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
 	"github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/forwarders"
+	txmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
 )
@@ -141,7 +142,7 @@ func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common
 		return fmt.Errorf("skipped OCR transmission, error getting round-robin address: %w", err)
 	}
 
-	_, err = t.txm.CreateTransaction(ctx, txmgr.TxRequest{
+	_, err = t.txm.CreateTransaction(withTransmitPriority(ctx), txmgr.TxRequest{
 		FromAddress:      roundRobinFromAddress,
 		ToAddress:        toAddress,
 		EncodedPayload:   payload,
@@ -157,6 +158,12 @@ func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common
 	return nil
 }
 
+// withTransmitPriority requests a high priority for OCR transmissions, so that they are not queued behind other
+// transactions of the same key.
+func withTransmitPriority(ctx context.Context) context.Context {
+	return txmtypes.WithPriority(ctx, txmtypes.PriorityHigh)
+}
+
 func (t *transmitter) CreateSecondaryEthTransaction(ctx context.Context, bytes []byte, meta *txmgr.TxMeta) error {
 	return errors.New("trying to send a secondary transmission on a non dual transmitter")
 }
@@ -188,7 +195,7 @@ func (t *ocr2FeedsTransmitter) CreateEthTransaction(ctx context.Context, toAddre
 		return err
 	}
 
-	_, err = t.txm.CreateTransaction(ctx, txmgr.TxRequest{
+	_, err = t.txm.CreateTransaction(withTransmitPriority(ctx), txmgr.TxRequest{
 		FromAddress:      roundRobinFromAddress,
 		ToAddress:        toAddress,
 		EncodedPayload:   payload,
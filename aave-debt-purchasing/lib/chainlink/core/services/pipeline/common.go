@@ -324,6 +324,7 @@ const (
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
 	TaskTypeJSONParse        TaskType = "jsonparse"
+	TaskTypeJSONQuery        TaskType = "jsonquery"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
@@ -384,6 +385,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJSONQuery:
+		task = &JSONQueryTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
@@ -24,6 +24,7 @@ func makeHTTPRequest(
 	requestData MapParam,
 	client *http.Client,
 	httpLimit int64,
+	propagateTrace bool,
 ) (responseBytes []byte, statusCode int, respHeaders http.Header, start, finish time.Time, err error) {
 	var bodyReader io.Reader
 	if requestData != nil {
@@ -57,6 +58,9 @@ func makeHTTPRequest(
 		Logger:  logger.Sugared(lggr).Named("HTTPRequest"),
 	}
 
+	span := startHTTPRequestSpan(request, propagateTrace)
+	defer func() { endHTTPRequestSpan(span, statusCode, err) }()
+
 	start = time.Now()
 	responseBytes, statusCode, respHeaders, err = httpRequest.SendRequest()
 	finish = time.Now()
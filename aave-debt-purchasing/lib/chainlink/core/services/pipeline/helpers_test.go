@@ -3,6 +3,7 @@ package pipeline
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -51,6 +52,21 @@ func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, un
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+func (t *HTTPTask) HelperSetCache(cache *HTTPCache) {
+	t.cache = cache
+}
+
+// HelperAge makes every cached response d older.
+func (c *HTTPCache) HelperAge(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for fingerprint, entry := range c.entries {
+		entry.FetchedAt = entry.FetchedAt.Add(-d)
+		entry.ExpiresAt = entry.ExpiresAt.Add(-d)
+		c.entries[fingerprint] = entry
+	}
+}
+
 func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, config Config, specGasLimit *uint32, jobType string) {
 	t.legacyChains = legacyChains
 	t.config = config
@@ -0,0 +1,225 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+const (
+	HTTPCacheServiceName     = "HTTPCache"
+	DefaultHTTPCacheInterval = 5 * time.Second
+)
+
+var promHTTPCacheResults = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "pipeline_task_http_cache_results_total",
+	Help: "Number of cached HTTP task lookups by result: hit, stale, stale_on_error or miss",
+},
+	[]string{"result"},
+)
+
+// HTTPCacheEntry is an HTTP task response shared by all tasks sending the same request.
+type HTTPCacheEntry struct {
+	Fingerprint string    `db:"fingerprint"`
+	Response    []byte    `db:"response"`
+	FetchedAt   time.Time `db:"fetched_at"`
+	// ExpiresAt is when the entry may no longer be served, even as a stale response.
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+// HTTPCache caches HTTP task responses across runs and jobs, keyed by a fingerprint of
+// the request. Responses are held in memory and periodically saved to the database,
+// from where they are read back on a memory miss, e.g. after a restart.
+type HTTPCache struct {
+	orm      ORM
+	interval time.Duration
+
+	services.Service
+	eng *services.Engine
+
+	mu           sync.RWMutex
+	entries      map[string]HTTPCacheEntry
+	unsaved      map[string]struct{}
+	revalidating map[string]struct{}
+}
+
+// NewHTTPCache returns an HTTPCache saving responses through the data source of orm,
+// every interval. The cache is memory only if orm is nil.
+func NewHTTPCache(orm ORM, lggr logger.Logger, interval time.Duration) *HTTPCache {
+	c := &HTTPCache{
+		orm:          orm,
+		interval:     interval,
+		entries:      make(map[string]HTTPCacheEntry),
+		unsaved:      make(map[string]struct{}),
+		revalidating: make(map[string]struct{}),
+	}
+	c.Service, c.eng = services.Config{
+		Name:  HTTPCacheServiceName,
+		Start: c.start,
+		Close: c.close,
+	}.NewServiceEngine(lggr)
+	return c
+}
+
+// Get returns the cached response for fingerprint, if it has not expired.
+func (c *HTTPCache) Get(ctx context.Context, fingerprint string) (HTTPCacheEntry, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[fingerprint]
+	c.mu.RUnlock()
+	if ok && time.Now().Before(entry.ExpiresAt) {
+		return entry, true
+	}
+	if c.orm == nil {
+		return HTTPCacheEntry{}, false
+	}
+
+	const q = `SELECT * FROM http_task_responses WHERE fingerprint = $1 AND expires_at > now();`
+	if err := c.orm.DataSource().GetContext(ctx, &entry, q, fingerprint); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			c.eng.Warnw("Failed to load cached HTTP response", "fingerprint", fingerprint, "err", err)
+		}
+		return HTTPCacheEntry{}, false
+	}
+
+	c.mu.Lock()
+	if current, exists := c.entries[fingerprint]; !exists || current.FetchedAt.Before(entry.FetchedAt) {
+		c.entries[fingerprint] = entry
+	}
+	c.mu.Unlock()
+	return entry, true
+}
+
+// Set caches response for fingerprint, to be served until retain has passed.
+func (c *HTTPCache) Set(fingerprint string, response []byte, fetchedAt time.Time, retain time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// catch the rare case of a save race
+	if current, exists := c.entries[fingerprint]; exists && current.FetchedAt.After(fetchedAt) {
+		return
+	}
+	c.entries[fingerprint] = HTTPCacheEntry{
+		Fingerprint: fingerprint,
+		Response:    response,
+		FetchedAt:   fetchedAt,
+		ExpiresAt:   fetchedAt.Add(retain),
+	}
+	c.unsaved[fingerprint] = struct{}{}
+}
+
+// Revalidate refreshes the entry for fingerprint in the background, unless it is already
+// being refreshed.
+func (c *HTTPCache) Revalidate(fingerprint string, retain time.Duration, fetch func(ctx context.Context) ([]byte, error)) {
+	c.mu.Lock()
+	if _, ok := c.revalidating[fingerprint]; ok {
+		c.mu.Unlock()
+		return
+	}
+	c.revalidating[fingerprint] = struct{}{}
+	c.mu.Unlock()
+
+	c.eng.Go(func(ctx context.Context) {
+		defer func() {
+			c.mu.Lock()
+			delete(c.revalidating, fingerprint)
+			c.mu.Unlock()
+		}()
+
+		fetchedAt := time.Now()
+		response, err := fetch(ctx)
+		if err != nil {
+			c.eng.Debugw("Failed to revalidate cached HTTP response", "fingerprint", fingerprint, "err", err)
+			return
+		}
+		c.Set(fingerprint, response, fetchedAt, retain)
+	})
+}
+
+func (c *HTTPCache) start(_ context.Context) error {
+	ticker := services.TickerConfig{
+		Initial:   c.interval,
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(c.interval)
+	c.eng.GoTick(ticker, c.save)
+
+	return nil
+}
+
+func (c *HTTPCache) close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.interval)
+	defer cancel()
+	c.save(ctx)
+	return nil
+}
+
+// save writes unsaved entries to the database, and removes expired ones.
+func (c *HTTPCache) save(ctx context.Context) {
+	now := time.Now()
+
+	c.mu.Lock()
+	var entries []HTTPCacheEntry
+	for fingerprint := range c.unsaved {
+		entries = append(entries, c.entries[fingerprint])
+	}
+	c.unsaved = make(map[string]struct{})
+	for fingerprint, entry := range c.entries {
+		if !now.Before(entry.ExpiresAt) {
+			delete(c.entries, fingerprint)
+		}
+	}
+	c.mu.Unlock()
+
+	if c.orm == nil || len(entries) == 0 {
+		return
+	}
+
+	const upsert = `INSERT INTO http_task_responses (fingerprint, response, fetched_at, expires_at)
+		VALUES (:fingerprint, :response, :fetched_at, :expires_at)
+		ON CONFLICT (fingerprint) DO UPDATE SET
+			response = excluded.response, fetched_at = excluded.fetched_at, expires_at = excluded.expires_at
+		WHERE http_task_responses.fetched_at < excluded.fetched_at;`
+	ds := c.orm.DataSource()
+	if _, err := ds.NamedExecContext(ctx, upsert, entries); err != nil {
+		c.eng.Warnw("Failed to save cached HTTP responses", "err", err)
+		return
+	}
+	if _, err := ds.ExecContext(ctx, `DELETE FROM http_task_responses WHERE expires_at <= now();`); err != nil {
+		c.eng.Warnw("Failed to delete expired HTTP responses", "err", err)
+	}
+}
+
+// httpRequestFingerprint identifies an HTTP task request, so that tasks of any job sending
+// the same request share a cached response.
+func httpRequestFingerprint(method StringParam, url URLParam, reqHeaders []string, requestData MapParam, allowUnrestrictedNetworkAccess BoolParam) (string, error) {
+	headers := make([]string, 0, len(reqHeaders)/2)
+	for i := 0; i+1 < len(reqHeaders); i += 2 {
+		headers = append(headers, strings.ToLower(reqHeaders[i])+":"+reqHeaders[i+1])
+	}
+	sort.Strings(headers)
+
+	b, err := json.Marshal(struct {
+		Method                         string
+		URL                            string
+		Headers                        []string
+		RequestData                    MapParam
+		AllowUnrestrictedNetworkAccess bool
+	}{string(method), url.String(), headers, requestData, bool(allowUnrestrictedNetworkAccess)})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -642,6 +642,76 @@ func (_c *Runner_Run_Call) RunAndReturn(run func(context.Context, *pipeline.Run,
 	return _c
 }
 
+// SimulateRun provides a mock function with given fields: ctx, spec, vars, simulated
+func (_m *Runner) SimulateRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, simulated pipeline.SimulatedResults) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, vars, simulated)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulatedResults) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, spec, vars, simulated)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulatedResults) *pipeline.Run); ok {
+		r0 = rf(ctx, spec, vars, simulated)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulatedResults) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, vars, simulated)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulatedResults) error); ok {
+		r2 = rf(ctx, spec, vars, simulated)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Runner_SimulateRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateRun'
+type Runner_SimulateRun_Call struct {
+	*mock.Call
+}
+
+// SimulateRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - vars pipeline.Vars
+//   - simulated pipeline.SimulatedResults
+func (_e *Runner_Expecter) SimulateRun(ctx interface{}, spec interface{}, vars interface{}, simulated interface{}) *Runner_SimulateRun_Call {
+	return &Runner_SimulateRun_Call{Call: _e.mock.On("SimulateRun", ctx, spec, vars, simulated)}
+}
+
+func (_c *Runner_SimulateRun_Call) Run(run func(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, simulated pipeline.SimulatedResults)) *Runner_SimulateRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(pipeline.Vars), args[3].(pipeline.SimulatedResults))
+	})
+	return _c
+}
+
+func (_c *Runner_SimulateRun_Call) Return(run *pipeline.Run, trrs pipeline.TaskRunResults, err error) *Runner_SimulateRun_Call {
+	_c.Call.Return(run, trrs, err)
+	return _c
+}
+
+func (_c *Runner_SimulateRun_Call) RunAndReturn(run func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulatedResults) (*pipeline.Run, pipeline.TaskRunResults, error)) *Runner_SimulateRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Runner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -15,6 +15,8 @@ import (
 	pkgerrors "github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -42,6 +44,9 @@ type Runner interface {
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars) (run *Run, trrs TaskRunResults, err error)
+	// SimulateRun executes a new run in-memory like ExecuteRun, but tasks with a simulated result return it instead
+	// of being executed. Tasks with external side effects fail unless they have a simulated result.
+	SimulateRun(ctx context.Context, spec Spec, vars Vars, simulated SimulatedResults) (run *Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	// ds is an optional override, for example when executing a transaction.
 	InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, run *Run, saveSuccessfulTaskRuns bool) error
@@ -70,6 +75,7 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	httpCache              *HTTPCache
 
 	// test helper
 	runFinished func(*Run)
@@ -135,6 +141,7 @@ func NewRunner(
 		lggr:                   lggr,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		httpCache:              NewHTTPCache(orm, lggr, DefaultHTTPCacheInterval),
 	}
 
 	r.runReaperWorker = commonutils.NewSleeperTask(
@@ -154,6 +161,10 @@ func (r *runner) Start(ctx context.Context) error {
 			go r.runReaperLoop()
 		}
 
+		if err := r.httpCache.Start(ctx); err != nil {
+			return err
+		}
+
 		// the btORM can be a cache service or a static ORM if the constructor changes
 		service, isService := r.btORM.(services.Service)
 		if isService {
@@ -169,12 +180,14 @@ func (r *runner) Close() error {
 		close(r.chStop)
 		r.wgDone.Wait()
 
+		err := r.httpCache.Close()
+
 		// the btORM can be a cache service or a static ORM if the constructor changes
 		if closer, isCloser := r.btORM.(io.Closer); isCloser {
-			return closer.Close()
+			err = multierr.Append(err, closer.Close())
 		}
 
-		return nil
+		return err
 	})
 }
 
@@ -291,6 +304,19 @@ func overtimeContext(ctx context.Context) (context.Context, context.CancelFunc)
 }
 
 func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, TaskRunResults, error) {
+	return r.executeRun(ctx, spec, vars, nil)
+}
+
+func (r *runner) SimulateRun(ctx context.Context, spec Spec, vars Vars, simulated SimulatedResults) (*Run, TaskRunResults, error) {
+	// Always parse a fresh pipeline, so that a pre-initialized one shared with a running job is not touched.
+	spec.Pipeline = nil
+	if simulated == nil {
+		simulated = SimulatedResults{}
+	}
+	return r.executeRun(ctx, spec, vars, simulated)
+}
+
+func (r *runner) executeRun(ctx context.Context, spec Spec, vars Vars, simulated SimulatedResults) (*Run, TaskRunResults, error) {
 	// Pipeline runs may return results after the context is cancelled, so we modify the
 	// deadline to give them time to return before the parent context deadline.
 	var cancel func()
@@ -315,7 +341,7 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 	}
 
 	run := NewRun(spec, vars)
-	taskRunResults := r.run(ctx, pipeline, run, vars)
+	taskRunResults := r.run(ctx, pipeline, run, vars, simulated)
 
 	if run.Pending {
 		return run, nil, fmt.Errorf("unexpected async run for spec ID %v, tried executing via ExecuteRun", spec.ID)
@@ -339,6 +365,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+			task.(*HTTPTask).cache = r.httpCache
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
@@ -377,12 +404,20 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	return pipeline, nil
 }
 
-func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
+func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, simulated SimulatedResults) TaskRunResults {
 	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	if r.config.VerboseLogging() {
 		l.Debug("Initiating tasks for pipeline run of spec")
 	}
 
+	// Simulated runs are kept out of metrics and traces, so that they are not mistaken for production runs.
+	simulating := simulated != nil
+	if !simulating {
+		var span trace.Span
+		ctx, span = startRunSpan(ctx, run)
+		defer endRunSpan(span, run)
+	}
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
@@ -400,9 +435,11 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		taskRun := taskRun
 		// execute
 		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
+			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, simulated, l)
 
-			logTaskRunToPrometheus(result, run.PipelineSpec)
+			if !simulating {
+				logTaskRunToPrometheus(result, run.PipelineSpec)
+			}
 
 			scheduler.report(reportCtx, result)
 		}, func(err interface{}) {
@@ -429,7 +466,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		// NOTE: runTime can be very long now because it'll include suspend
 		runTime = run.FinishedAt.Time.Sub(run.CreatedAt)
-		PromPipelineRunTotalTimeToCompletion.WithLabelValues(strconv.Itoa(int(run.PipelineSpec.JobID)), run.PipelineSpec.JobName).Set(float64(runTime))
+		if !simulating {
+			PromPipelineRunTotalTimeToCompletion.WithLabelValues(strconv.Itoa(int(run.PipelineSpec.JobID)), run.PipelineSpec.JobName).Set(float64(runTime))
+		}
 	}
 
 	// Update run results
@@ -479,7 +518,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
-			PromPipelineRunErrors.WithLabelValues(strconv.Itoa(int(run.PipelineSpec.JobID)), run.PipelineSpec.JobName).Inc()
+			if !simulating {
+				PromPipelineRunErrors.WithLabelValues(strconv.Itoa(int(run.PipelineSpec.JobID)), run.PipelineSpec.JobName).Inc()
+			}
 		} else {
 			run.State = RunStatusCompleted
 		}
@@ -537,7 +578,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	return taskRunResults
 }
 
-func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
+func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, simulated SimulatedResults, l logger.Logger) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
 		"taskType", taskRun.task.Type(),
@@ -564,7 +605,16 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
-	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	var result Result
+	var runInfo RunInfo
+	if simulated != nil {
+		result, runInfo = simulated.run(ctx, l, taskRun)
+	} else {
+		var span trace.Span
+		ctx, span = startTaskRunSpan(ctx, taskRun)
+		result, runInfo = taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+		endTaskRunSpan(span, result, runInfo)
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -688,7 +738,7 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 	}
 
 	for {
-		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})))
+		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), nil)
 
 		if preinsert {
 			// FailSilently = run failed and task was marked failEarly. skip StoreRun and instead delete all trace of it
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +20,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
@@ -1077,3 +1084,160 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	r, _ := newRunner(t, db, nil, cfg)
+
+	var requests int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		_, _ = io.WriteString(w, `{"data": {"result": 1}}`)
+	}))
+	t.Cleanup(s.Close)
+
+	spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+ds    [type=http method=GET url="%s"]
+parse [type=jsonparse path="data,result"]
+mul   [type=multiply times=10]
+
+ds -> parse -> mul
+`, s.URL)}
+	vars := pipeline.NewVarsFrom(nil)
+
+	t.Run("uses canned results for external tasks", func(t *testing.T) {
+		run, trrs, err := r.SimulateRun(testutils.Context(t), spec, vars, pipeline.SimulatedResults{
+			"ds": {Value: `{"data": {"result": 42}}`},
+		})
+		require.NoError(t, err)
+		require.Len(t, trrs, 3)
+		assert.Len(t, run.PipelineTaskRuns, 3)
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		assert.Equal(t, "420", result.Value.(decimal.Decimal).String())
+		assert.Zero(t, requests)
+	})
+
+	t.Run("canned results override internal tasks", func(t *testing.T) {
+		_, trrs, err := r.SimulateRun(testutils.Context(t), spec, vars, pipeline.SimulatedResults{
+			"ds":    {Value: `{"data": {"result": 42}}`},
+			"parse": {Error: "boom"},
+		})
+		require.NoError(t, err)
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		require.Error(t, result.Error)
+		assert.Zero(t, requests)
+	})
+
+	t.Run("fails external tasks without a canned result", func(t *testing.T) {
+		_, trrs, err := r.SimulateRun(testutils.Context(t), spec, vars, nil)
+		require.NoError(t, err)
+
+		for _, trr := range trrs {
+			if trr.Task.DotID() == "ds" {
+				require.ErrorIs(t, trr.Result.Error, pipeline.ErrNoSimulatedResult)
+			}
+		}
+		assert.Zero(t, requests)
+	})
+
+	t.Run("replays a recorded run", func(t *testing.T) {
+		recorded, _, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+		require.NoError(t, err)
+		require.Equal(t, 1, requests)
+
+		simulated := pipeline.NewSimulatedResultsFromRun(*recorded)
+		assert.Len(t, simulated, 1)
+		assert.Contains(t, simulated, "ds")
+
+		changed := pipeline.Spec{DotDagSource: strings.Replace(spec.DotDagSource, "times=10", "times=100", 1)}
+		_, trrs, err := r.SimulateRun(testutils.Context(t), changed, vars, simulated)
+		require.NoError(t, err)
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		assert.Equal(t, "100", result.Value.(decimal.Decimal).String())
+		assert.Equal(t, 1, requests)
+	})
+}
+
+func Test_PipelineRunner_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	r, _ := newRunner(t, db, nil, cfg)
+
+	var traceparent string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		traceparent = req.Header.Get("traceparent")
+		_, _ = io.WriteString(w, `{"data": {"result": 1}}`)
+	}))
+	t.Cleanup(s.Close)
+
+	spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+ds    [type=http method=GET url="%s" allowUnrestrictedNetworkAccess="true" propagateTrace="true"]
+parse [type=jsonparse path="data,missing"]
+
+ds -> parse
+`, s.URL)}
+	_, _, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil))
+	require.NoError(t, err)
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	require.Contains(t, spans, "pipeline.run")
+	require.Contains(t, spans, "pipeline.task.http")
+	require.Contains(t, spans, "pipeline.task.jsonparse")
+	require.Contains(t, spans, "pipeline.http_request")
+
+	run := spans["pipeline.run"]
+	assert.False(t, run.Parent().IsValid())
+	assert.Equal(t, codes.Error, run.Status().Code)
+
+	ds := spans["pipeline.task.http"]
+	assert.Equal(t, run.SpanContext().SpanID(), ds.Parent().SpanID())
+	assert.Contains(t, ds.Attributes(), attribute.String("pipeline.task.dot_id", "ds"))
+	assert.Equal(t, codes.Unset, ds.Status().Code)
+
+	parse := spans["pipeline.task.jsonparse"]
+	assert.Equal(t, run.SpanContext().SpanID(), parse.Parent().SpanID())
+	assert.Equal(t, codes.Error, parse.Status().Code)
+
+	request := spans["pipeline.http_request"]
+	assert.Equal(t, ds.SpanContext().SpanID(), request.Parent().SpanID())
+	assert.Contains(t, request.Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+	assert.Contains(t, traceparent, request.SpanContext().TraceID().String())
+	assert.Contains(t, traceparent, request.SpanContext().SpanID().String())
+
+	t.Run("trace context is only propagated if enabled", func(t *testing.T) {
+		traceparent = ""
+		unpropagated := pipeline.Spec{DotDagSource: strings.Replace(spec.DotDagSource, ` propagateTrace="true"`, "", 1)}
+		_, _, err := r.ExecuteRun(testutils.Context(t), unpropagated, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		assert.Empty(t, traceparent)
+	})
+
+	t.Run("simulated runs are not traced", func(t *testing.T) {
+		ended := len(recorder.Ended())
+		_, _, err := r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), pipeline.SimulatedResults{
+			"ds": {Value: `{"data": {"result": 1}}`},
+		})
+		require.NoError(t, err)
+		assert.Len(t, recorder.Ended(), ended)
+	})
+}
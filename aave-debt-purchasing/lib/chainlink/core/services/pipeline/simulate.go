@@ -0,0 +1,71 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ErrNoSimulatedResult is returned by tasks with external side effects that have no
+// simulated result during a simulated run.
+var ErrNoSimulatedResult = errors.New("no simulated result for task")
+
+// externalTaskTypes are the task types that call out of the node, and therefore never
+// run during a simulation.
+var externalTaskTypes = map[TaskType]bool{
+	TaskTypeHTTP:             true,
+	TaskTypeBridge:           true,
+	TaskTypeETHCall:          true,
+	TaskTypeETHTx:            true,
+	TaskTypeEstimateGasLimit: true,
+}
+
+// SimulatedTaskResult is a canned result that a task returns during a simulated run.
+type SimulatedTaskResult struct {
+	Value interface{} `json:"value"`
+	Error string      `json:"error,omitempty"`
+}
+
+// Result returns the SimulatedTaskResult as a task Result.
+func (s SimulatedTaskResult) Result() Result {
+	if s.Error != "" {
+		return Result{Error: errors.New(s.Error)}
+	}
+	return Result{Value: s.Value}
+}
+
+// SimulatedResults maps task dot IDs to the result the task returns during a simulated run.
+type SimulatedResults map[string]SimulatedTaskResult
+
+// NewSimulatedResultsFromRun returns the results recorded for the external task runs of
+// the given run, so that it can be replayed as a simulation. Other tasks are run again.
+func NewSimulatedResultsFromRun(run Run) SimulatedResults {
+	results := SimulatedResults{}
+	for _, taskRun := range run.PipelineTaskRuns {
+		if !taskRun.FinishedAt.Valid || !externalTaskTypes[taskRun.Type] {
+			continue
+		}
+
+		result := SimulatedTaskResult{Error: taskRun.Error.ValueOrZero()}
+		if taskRun.Output.Valid {
+			result.Value = taskRun.Output.Val
+		}
+		results[taskRun.DotID] = result
+	}
+	return results
+}
+
+func (s SimulatedResults) run(ctx context.Context, l logger.Logger, taskRun *memoryTaskRun) (Result, RunInfo) {
+	task := taskRun.task
+	if result, ok := s[task.DotID()]; ok {
+		return result.Result(), RunInfo{}
+	}
+
+	if externalTaskTypes[task.Type()] {
+		return Result{Error: fmt.Errorf("%w %s (%s)", ErrNoSimulatedResult, task.DotID(), task.Type())}, RunInfo{}
+	}
+
+	return task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+}
@@ -64,6 +64,8 @@ type BridgeTask struct {
 	Async             string `json:"async"`
 	CacheTTL          string `json:"cacheTTL"`
 	Headers           string `json:"headers"`
+	// PropagateTrace sends the trace context of the request in its headers, for adapters that take part in tracing.
+	PropagateTrace string `json:"propagateTrace"`
 
 	specId       int32
 	orm          bridges.ORM
@@ -108,6 +110,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		includeInputAtKey StringParam
 		cacheTTL          Uint64Param
 		reqHeaders        StringSliceParam
+		propagateTrace    BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&name, From(NonemptyString(t.Name))), "name"),
@@ -115,6 +118,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		errors.Wrap(ResolveParam(&includeInputAtKey, From(t.IncludeInputAtKey)), "includeInputAtKey"),
 		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), t.bridgeConfig.BridgeCacheTTL().Seconds())), "cacheTTL"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&propagateTrace, From(NonemptyString(t.PropagateTrace), false)), "propagateTrace"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -185,7 +189,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	}
 
 	var cachedResponse bool
-	responseBytes, statusCode, headers, start, finish, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	responseBytes, statusCode, headers, start, finish, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit(), bool(propagateTrace))
 	elapsed := finish.Sub(start)
 	promBridgeLatency.WithLabelValues(t.Name, statusCodeGroup(statusCode)).Set(elapsed.Seconds())
 
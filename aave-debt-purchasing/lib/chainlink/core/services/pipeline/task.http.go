@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -25,10 +26,22 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	// CacheTTL enables caching of the response for the given duration, shared by all HTTP
+	// tasks sending the same request.
+	CacheTTL string `json:"cacheTTL"`
+	// CacheStaleWhileRevalidate is how long after CacheTTL an expired response is still
+	// returned, while it is refreshed in the background.
+	CacheStaleWhileRevalidate string `json:"cacheStaleWhileRevalidate"`
+	// CacheStaleIfError is how long after CacheTTL an expired response is returned if the
+	// request fails.
+	CacheStaleIfError string `json:"cacheStaleIfError"`
+	// PropagateTrace sends the trace context of the request in its headers, for APIs that take part in tracing.
+	PropagateTrace string `json:"propagateTrace"`
 
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	cache                  *HTTPCache
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -64,6 +77,10 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		requestData                    MapParam
 		allowUnrestrictedNetworkAccess BoolParam
 		reqHeaders                     StringSliceParam
+		cacheTTL                       Uint64Param
+		cacheStaleWhileRevalidate      Uint64Param
+		cacheStaleIfError              Uint64Param
+		propagateTrace                 BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
@@ -74,6 +91,10 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		// You must set allowUnrestrictedNetworkAccess=true on the task to enable variable-interpolated URLs to make restricted network requests
 		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), 0)), "cacheTTL"),
+		errors.Wrap(ResolveParam(&cacheStaleWhileRevalidate, From(ValidDurationInSeconds(t.CacheStaleWhileRevalidate), 0)), "cacheStaleWhileRevalidate"),
+		errors.Wrap(ResolveParam(&cacheStaleIfError, From(ValidDurationInSeconds(t.CacheStaleIfError), 0)), "cacheStaleIfError"),
+		errors.Wrap(ResolveParam(&propagateTrace, From(NonemptyString(t.PropagateTrace), false)), "propagateTrace"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -87,6 +108,47 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+
+	var client *http.Client
+	if allowUnrestrictedNetworkAccess {
+		client = t.unrestrictedHTTPClient
+	} else {
+		client = t.httpClient
+	}
+
+	var (
+		fingerprint string
+		cached      HTTPCacheEntry
+		inCache     bool
+		ttl         = time.Duration(cacheTTL) * time.Second
+		swr         = time.Duration(cacheStaleWhileRevalidate) * time.Second
+		staleIfErr  = time.Duration(cacheStaleIfError) * time.Second
+		retain      = ttl + max(swr, staleIfErr)
+	)
+	if ttl > 0 && t.cache != nil {
+		fingerprint, err = httpRequestFingerprint(method, url, reqHeaders, requestData, allowUnrestrictedNetworkAccess)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+		cached, inCache = t.cache.Get(ctx, fingerprint)
+		age := time.Since(cached.FetchedAt)
+		switch {
+		case inCache && age < ttl:
+			promHTTPCacheResults.WithLabelValues("hit").Inc()
+			return Result{Value: string(cached.Response)}, runInfo
+		case inCache && age < ttl+swr:
+			promHTTPCacheResults.WithLabelValues("stale").Inc()
+			t.cache.Revalidate(fingerprint, retain, func(ctx context.Context) ([]byte, error) {
+				requestCtx, cancel := t.backgroundRequestCtx(ctx)
+				defer cancel()
+				responseBytes, _, _, _, _, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit(), bool(propagateTrace))
+				return responseBytes, err
+			})
+			return Result{Value: string(cached.Response)}, runInfo
+		}
+		promHTTPCacheResults.WithLabelValues("miss").Inc()
+	}
+
 	lggr.Debugw("HTTP task: sending request",
 		"requestData", string(requestDataJSON),
 		"url", url.String(),
@@ -98,21 +160,24 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
-	var client *http.Client
-	if allowUnrestrictedNetworkAccess {
-		client = t.unrestrictedHTTPClient
-	} else {
-		client = t.httpClient
-	}
-	responseBytes, statusCode, respHeaders, start, finish, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+	responseBytes, statusCode, respHeaders, start, finish, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit(), bool(propagateTrace))
 	elapsed := finish.Sub(start).Milliseconds()
 	if err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
 		}
+		if inCache && time.Since(cached.FetchedAt) < ttl+staleIfErr {
+			promHTTPCacheResults.WithLabelValues("stale_on_error").Inc()
+			lggr.Warnw("HTTP task: request failed, using cached response", "url", url.String(), "fetchedAt", cached.FetchedAt, "err", err)
+			return Result{Value: string(cached.Response)}, runInfo
+		}
 		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
 	}
 
+	if fingerprint != "" {
+		t.cache.Set(fingerprint, responseBytes, start, retain)
+	}
+
 	lggr.Debugw("HTTP task got response",
 		"response", string(responseBytes),
 		"respHeaders", respHeaders,
@@ -129,3 +194,16 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	// value instead.
 	return Result{Value: string(responseBytes)}, runInfo
 }
+
+// backgroundRequestCtx bounds a request made outside of a run, such as a cache
+// revalidation, by the task timeout or else the default HTTP timeout.
+func (t *HTTPTask) backgroundRequestCtx(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout, isSet := t.TaskTimeout()
+	if !isSet || timeout == 0 {
+		timeout = t.config.DefaultHTTPTimeout().Duration()
+	}
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
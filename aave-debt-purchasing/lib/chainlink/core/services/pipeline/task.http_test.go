@@ -2,10 +2,12 @@ package pipeline_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
@@ -412,3 +415,110 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestHTTPTask_Cache(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+
+	var (
+		requests atomic.Int32
+		failing  atomic.Bool
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, err := fmt.Fprintf(w, `{"request": %d}`, n)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	newTask := func(cache *pipeline.HTTPCache, url, swr, staleIfError string) *pipeline.HTTPTask {
+		task := &pipeline.HTTPTask{
+			BaseTask:                  pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:                    "GET",
+			URL:                       url,
+			CacheTTL:                  "10s",
+			CacheStaleWhileRevalidate: swr,
+			CacheStaleIfError:         staleIfError,
+		}
+		task.HelperSetDependencies(config.JobPipeline(), c, c)
+		task.HelperSetCache(cache)
+		return task
+	}
+	run := func(t *testing.T, task *pipeline.HTTPTask) pipeline.Result {
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+	newCache := func(t *testing.T) *pipeline.HTTPCache {
+		requests.Store(0)
+		failing.Store(false)
+		cache := pipeline.NewHTTPCache(nil, logger.TestLogger(t), time.Hour)
+		servicetest.Run(t, cache)
+		return cache
+	}
+
+	t.Run("fresh responses are shared by tasks sending the same request", func(t *testing.T) {
+		cache := newCache(t)
+
+		require.Equal(t, `{"request": 1}`, run(t, newTask(cache, server.URL, "", "")).Value)
+		require.Equal(t, `{"request": 1}`, run(t, newTask(cache, server.URL, "", "")).Value)
+		require.Equal(t, int32(1), requests.Load())
+
+		require.Equal(t, `{"request": 2}`, run(t, newTask(cache, server.URL+"?other", "", "")).Value)
+
+		cache.HelperAge(11 * time.Second)
+		require.Equal(t, `{"request": 3}`, run(t, newTask(cache, server.URL, "", "")).Value)
+	})
+
+	t.Run("stale responses are served while revalidating", func(t *testing.T) {
+		cache := newCache(t)
+		task := newTask(cache, server.URL, "1m", "")
+
+		require.Equal(t, `{"request": 1}`, run(t, task).Value)
+		cache.HelperAge(15 * time.Second)
+		require.Equal(t, `{"request": 1}`, run(t, task).Value)
+		require.Eventually(t, func() bool {
+			return run(t, task).Value == `{"request": 2}`
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+		require.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("stale responses are served on error", func(t *testing.T) {
+		cache := newCache(t)
+		task := newTask(cache, server.URL, "", "1m")
+
+		require.Equal(t, `{"request": 1}`, run(t, task).Value)
+		failing.Store(true)
+		cache.HelperAge(15 * time.Second)
+		result := run(t, task)
+		require.NoError(t, result.Error)
+		require.Equal(t, `{"request": 1}`, result.Value)
+
+		cache.HelperAge(time.Minute)
+		result = run(t, task)
+		require.Error(t, result.Error)
+		require.Nil(t, result.Value)
+	})
+
+	t.Run("responses are loaded from the database", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		orm := pipeline.NewORM(db, logger.TestLogger(t), config.JobPipeline().MaxSuccessfulRuns())
+		requests.Store(0)
+		failing.Store(false)
+
+		cache := pipeline.NewHTTPCache(orm, logger.TestLogger(t), time.Hour)
+		require.NoError(t, cache.Start(testutils.Context(t)))
+		require.Equal(t, `{"request": 1}`, run(t, newTask(cache, server.URL, "", "")).Value)
+		require.NoError(t, cache.Close())
+
+		cache = pipeline.NewHTTPCache(orm, logger.TestLogger(t), time.Hour)
+		servicetest.Run(t, cache)
+		require.Equal(t, `{"request": 1}`, run(t, newTask(cache, server.URL, "", "")).Value)
+		require.Equal(t, int32(1), requests.Load())
+	})
+}
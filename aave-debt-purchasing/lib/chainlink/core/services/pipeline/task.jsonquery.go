@@ -0,0 +1,207 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/tidwall/gjson"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+)
+
+// JSONQueryTask evaluates a gjson path over JSON data, see https://github.com/tidwall/gjson/blob/master/SYNTAX.md.
+// Filters (`#(...)#`) and array projection (`#.key`) are part of the gjson syntax, and the task adds
+// the following modifiers for arithmetic, which treat numeric strings as numbers:
+//
+//	@sum @avg @min @max           aggregate an array of numbers
+//	@add:x @sub:x @mul:x @div:x   apply an operation with x to a number
+//
+// Variables in the query are interpolated as JSON values, so that they can be used in filters
+// and as modifier arguments.
+//
+// Example:
+//
+//	query [type=jsonquery query="quotes.#(venue!=\"dex\")#.price|@avg|@mul:$(decimals)"]
+//
+// Return types:
+//
+//	float64
+//	int64
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type JSONQueryTask struct {
+	BaseTask `mapstructure:",squash"`
+	Query    string `json:"query"`
+	Data     string `json:"data"`
+	// Lax when disabled will return an error if the query does not resolve to a value
+	// Lax when enabled will return nil with no error if the query does not resolve to a value
+	Lax string
+}
+
+var _ Task = (*JSONQueryTask)(nil)
+
+func init() {
+	gjson.AddModifier("sum", jsonQueryAggregate(func(ds []decimal.Decimal) decimal.Decimal {
+		return decimal.Sum(decimal.Zero, ds...)
+	}))
+	gjson.AddModifier("avg", jsonQueryAggregate(func(ds []decimal.Decimal) decimal.Decimal {
+		return decimal.Avg(ds[0], ds[1:]...)
+	}))
+	gjson.AddModifier("min", jsonQueryAggregate(func(ds []decimal.Decimal) decimal.Decimal {
+		return decimal.Min(ds[0], ds[1:]...)
+	}))
+	gjson.AddModifier("max", jsonQueryAggregate(func(ds []decimal.Decimal) decimal.Decimal {
+		return decimal.Max(ds[0], ds[1:]...)
+	}))
+	gjson.AddModifier("add", jsonQueryArithmetic(func(d, x decimal.Decimal) (decimal.Decimal, bool) {
+		return d.Add(x), true
+	}))
+	gjson.AddModifier("sub", jsonQueryArithmetic(func(d, x decimal.Decimal) (decimal.Decimal, bool) {
+		return d.Sub(x), true
+	}))
+	gjson.AddModifier("mul", jsonQueryArithmetic(func(d, x decimal.Decimal) (decimal.Decimal, bool) {
+		return d.Mul(x), true
+	}))
+	gjson.AddModifier("div", jsonQueryArithmetic(func(d, x decimal.Decimal) (decimal.Decimal, bool) {
+		if x.IsZero() {
+			return decimal.Decimal{}, false
+		}
+		return d.Div(x), true
+	}))
+}
+
+func (t *JSONQueryTask) Type() TaskType {
+	return TaskTypeJSONQuery
+}
+
+func (t *JSONQueryTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		query StringParam
+		data  BytesParam
+		lax   BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&query, From(VarExpr(t.Query, vars), NonemptyString(t.Query))), "query"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	path, err := interpolateJSONQuery(string(query), vars)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if !gjson.ValidBytes(data) {
+		return Result{Error: errors.Wrap(ErrBadInput, "data is not valid JSON")}, runInfo
+	}
+
+	queried := gjson.GetBytes(data, path)
+	if !queried.Exists() {
+		if lax {
+			return Result{}, runInfo
+		}
+		return Result{Error: errors.Wrapf(ErrKeypathNotFound, "could not resolve query %q", path)}, runInfo
+	}
+
+	var value interface{}
+	d := json.NewDecoder(bytes.NewReader([]byte(queried.Raw)))
+	d.UseNumber()
+	err = d.Decode(&value)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	value, err = jsonserializable.ReinterpretJSONNumbers(value)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+
+	return Result{Value: value}, runInfo
+}
+
+// interpolateJSONQuery replaces the variable expressions in query with their values encoded as JSON.
+func interpolateJSONQuery(query string, vars Vars) (string, error) {
+	var err error
+	interpolated := variableRegexp.ReplaceAllStringFunc(query, func(expr string) string {
+		val, verr := VarExpr(expr, vars)()
+		if verr != nil {
+			err = multierr.Append(err, errors.Wrapf(verr, "query variable %s", expr))
+			return expr
+		}
+		b, merr := json.Marshal(val)
+		if merr != nil {
+			err = multierr.Append(err, errors.Wrapf(ErrBadInput, "query variable %s: %v", expr, merr))
+			return expr
+		}
+		return string(b)
+	})
+	return interpolated, err
+}
+
+// jsonQueryNumber parses a JSON number, or a string containing one.
+func jsonQueryNumber(r gjson.Result) (decimal.Decimal, bool) {
+	if r.Type != gjson.Number && r.Type != gjson.String {
+		return decimal.Decimal{}, false
+	}
+	d, err := decimal.NewFromString(r.String())
+	return d, err == nil
+}
+
+// jsonQueryAggregate returns a gjson modifier applying aggregate to a non-empty array of numbers.
+// Like the builtin gjson modifiers, it returns no value if its input is invalid.
+func jsonQueryAggregate(aggregate func([]decimal.Decimal) decimal.Decimal) func(data, arg string) string {
+	return func(data, _ string) string {
+		r := gjson.Parse(data)
+		if !r.IsArray() {
+			return ""
+		}
+		var ds []decimal.Decimal
+		for _, elem := range r.Array() {
+			d, ok := jsonQueryNumber(elem)
+			if !ok {
+				return ""
+			}
+			ds = append(ds, d)
+		}
+		if len(ds) == 0 {
+			return ""
+		}
+		return aggregate(ds).String()
+	}
+}
+
+// jsonQueryArithmetic returns a gjson modifier applying op to a number and the number in its argument.
+// Like the builtin gjson modifiers, it returns no value if its input or argument is invalid, or op fails.
+func jsonQueryArithmetic(op func(d, x decimal.Decimal) (decimal.Decimal, bool)) func(data, arg string) string {
+	return func(data, arg string) string {
+		d, ok := jsonQueryNumber(gjson.Parse(data))
+		if !ok {
+			return ""
+		}
+		x, ok := jsonQueryNumber(gjson.Parse(arg))
+		if !ok {
+			return ""
+		}
+		result, ok := op(d, x)
+		if !ok {
+			return ""
+		}
+		return result.String()
+	}
+}
@@ -0,0 +1,258 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJSONQueryTask(t *testing.T) {
+	t.Parallel()
+
+	const quotes = `{
+		"base": "ETH",
+		"quotes": [
+			{"venue": "cex-a", "price": 100.5, "volume": 10},
+			{"venue": "cex-b", "price": "101.5", "volume": 30},
+			{"venue": "dex", "price": 99, "volume": 5},
+			{"venue": "otc"}
+		],
+		"meta": {"decimals": 8, "tags": ["spot", "usd"]}
+	}`
+
+	tests := []struct {
+		name              string
+		query             string
+		data              string
+		lax               string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantData          interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{
+			"member",
+			"base",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			"ETH",
+			nil,
+			"",
+		},
+		{
+			"nested member and index",
+			"meta.tags.1",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			"usd",
+			nil,
+			"",
+		},
+		{
+			"projection skips missing keys",
+			"quotes.#.volume",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			[]interface{}{int64(10), int64(30), int64(5)},
+			nil,
+			"",
+		},
+		{
+			"filter",
+			"quotes.#(volume>=10)#.venue",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			[]interface{}{"cex-a", "cex-b"},
+			nil,
+			"",
+		},
+		{
+			"filter without matches",
+			"quotes.#(volume>100)#",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			[]interface{}{},
+			nil,
+			"",
+		},
+		{
+			"aggregates",
+			"quotes.#.volume|@sum",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			int64(45),
+			nil,
+			"",
+		},
+		{
+			"avg over filtered projection",
+			`quotes.#(venue!="dex")#.price|@avg`,
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			int64(101),
+			nil,
+			"",
+		},
+		{
+			"arithmetic",
+			`quotes.#(venue=="dex")#.price|@max|@sub:1.5|@div:2`,
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			48.75,
+			nil,
+			"",
+		},
+		{
+			"variables in query",
+			"quotes.#(venue==$(foo.venue))#.price|@sum|@mul:$(foo.times)",
+			"",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo": map[string]interface{}{"venue": "dex", "times": 2},
+			}),
+			[]pipeline.Result{{Value: quotes}},
+			int64(198),
+			nil,
+			"",
+		},
+		{
+			"query and data from variables",
+			"$(foo.query)",
+			"$(foo.data)",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo": map[string]interface{}{"query": "meta.tags.0", "data": quotes},
+			}),
+			[]pipeline.Result{},
+			"spot",
+			nil,
+			"",
+		},
+		{
+			"missing key",
+			"quotes.0.bid",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			nil,
+			pipeline.ErrKeypathNotFound,
+			`could not resolve query "quotes.0.bid"`,
+		},
+		{
+			"missing key lax",
+			"quotes.0.bid",
+			"",
+			"true",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			nil,
+			nil,
+			"",
+		},
+		{
+			"divide by zero",
+			"meta.decimals|@div:0",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			nil,
+			pipeline.ErrKeypathNotFound,
+			"",
+		},
+		{
+			"arithmetic on a string",
+			"base|@mul:2",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			nil,
+			pipeline.ErrKeypathNotFound,
+			"",
+		},
+		{
+			"missing variable",
+			"quotes.#(venue==$(foo.venue))#",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			nil,
+			pipeline.ErrKeypathNotFound,
+			"query variable $(foo.venue)",
+		},
+		{
+			"invalid data",
+			"base",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"base": `}},
+			nil,
+			pipeline.ErrBadInput,
+			"",
+		},
+		{
+			"missing query",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			nil,
+			pipeline.ErrParameterEmpty,
+			"query",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JSONQueryTask{
+				BaseTask: pipeline.NewBaseTask(0, "query", nil, nil, 0),
+				Query:    test.query,
+				Data:     test.data,
+				Lax:      test.lax,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				if test.wantErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				}
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package pipeline
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of pipeline spans. Spans are exported according
+// to the node's Tracing config, via the global tracer provider.
+const tracerName = "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// startRunSpan starts the root span of a pipeline run, which is the parent of its task run
+// spans. A span already in ctx, e.g. of the request that triggered the run, is linked.
+func startRunSpan(ctx context.Context, run *Run) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{
+		trace.WithNewRoot(),
+		trace.WithAttributes(
+			attribute.Int64("pipeline.run.id", run.ID),
+			attribute.Int("pipeline.spec.id", int(run.PipelineSpecID)),
+			attribute.String("job.id", strconv.Itoa(int(run.PipelineSpec.JobID))),
+			attribute.String("job.name", run.PipelineSpec.JobName),
+			attribute.String("job.type", run.PipelineSpec.JobType),
+		),
+	}
+	if parent := trace.SpanContextFromContext(ctx); parent.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: parent}))
+	}
+	return tracer().Start(ctx, "pipeline.run", opts...)
+}
+
+// endRunSpan records the outcome of run on span, and ends it.
+func endRunSpan(span trace.Span, run *Run) {
+	span.SetAttributes(
+		attribute.String("pipeline.run.state", string(run.State)),
+		attribute.Bool("pipeline.run.pending", run.Pending),
+	)
+	if run.HasFatalErrors() {
+		span.SetStatus(codes.Error, run.FatalErrors.ToError().Error())
+	}
+	span.End()
+}
+
+// startTaskRunSpan starts the span of a single attempt of a task run.
+func startTaskRunSpan(ctx context.Context, taskRun *memoryTaskRun) (context.Context, trace.Span) {
+	task := taskRun.task
+	attrs := []attribute.KeyValue{
+		attribute.String("pipeline.task.type", string(task.Type())),
+		attribute.String("pipeline.task.dot_id", task.DotID()),
+		attribute.Int("pipeline.task.attempt", int(taskRun.attempts)),
+		attribute.Int("pipeline.task.retries", int(task.TaskRetries())),
+	}
+	if bridgeTask, ok := task.(*BridgeTask); ok {
+		attrs = append(attrs, attribute.String("pipeline.task.bridge_name", bridgeTask.Name))
+	}
+	return tracer().Start(ctx, "pipeline.task."+string(task.Type()), trace.WithAttributes(attrs...))
+}
+
+// endTaskRunSpan records the result of a task run on span, and ends it.
+func endTaskRunSpan(span trace.Span, result Result, runInfo RunInfo) {
+	span.SetAttributes(
+		attribute.Bool("pipeline.task.pending", runInfo.IsPending),
+		attribute.Bool("pipeline.task.retryable", runInfo.IsRetryable),
+	)
+	if result.Error != nil {
+		span.RecordError(result.Error)
+		span.SetStatus(codes.Error, result.Error.Error())
+	}
+	span.End()
+}
+
+// startHTTPRequestSpan starts a client span for an outgoing HTTP request. If propagate is set, the
+// span is injected in the request headers so that the receiving adapter or API can continue the trace.
+// Propagation is opt-in, so that trace IDs are not sent to third party APIs.
+func startHTTPRequestSpan(request *http.Request, propagate bool) trace.Span {
+	ctx, span := tracer().Start(request.Context(), "pipeline.http_request",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", request.Method),
+			attribute.String("server.address", request.URL.Host),
+			attribute.String("url.path", request.URL.Path),
+		),
+	)
+	if propagate {
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(request.Header))
+	}
+	return span
+}
+
+// endHTTPRequestSpan records the response status code or error on span, and ends it.
+func endHTTPRequestSpan(span trace.Span, statusCode int, err error) {
+	if statusCode != 0 {
+		span.SetAttributes(attribute.Int("http.response.status_code", statusCode))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -3,15 +3,18 @@ package evm
 import (
 	"errors"
 	"fmt"
+	"math/big"
 
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
 	"github.com/smartcontractkit/chainlink-evm/pkg/gas/rollups"
 	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 )
 
 // ErrNoChains indicates that no EVM chains have been started
@@ -66,7 +69,7 @@ func NewLegacyChains(
 		cid := enabled[i].ChainID.ToInt()
 		opts := legacyevm.ChainRelayOpts{
 			Logger:    logger.Named(lggr, cid.String()),
-			KeyStore:  newChainStore(keystore.NewEthSigner(ks, cid), cid),
+			KeyStore:  newChainStore(NewEthSigner(ks, chainOpts.RemoteSigner, cid), cid),
 			ChainOpts: chainOpts,
 		}
 
@@ -91,3 +94,12 @@ func NewLegacyChainsAndConfig(
 	// always return because it's accumulating errors
 	return &LegacyChainsAndConfig{result, chainOpts.ChainConfigs}, err
 }
+
+// NewEthSigner returns the signer of the Eth keys of chainID, which is remoteSigner if it is
+// set, and ks otherwise.
+func NewEthSigner(ks keystore.Eth, remoteSigner *remotesigner.Client, chainID *big.Int) core.Keystore {
+	if remoteSigner != nil {
+		return remoteSigner.Keystore(remotesigner.KeyTypeEth, chainID)
+	}
+	return keystore.NewEthSigner(ks, chainID)
+}
@@ -63,6 +63,19 @@ func (c CachedORM) DeleteExpired(ctx context.Context, limit uint, utcNow time.Ti
 	return deletedRows, nil
 }
 
+func (c CachedORM) DeleteOrphanedChunks(ctx context.Context, limit uint) (int64, error) {
+	deletedRows, err := c.underlayingORM.DeleteOrphanedChunks(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	if deletedRows > 0 {
+		c.cache.Flush()
+	}
+
+	return deletedRows, nil
+}
+
 func (c CachedORM) GetSnapshot(ctx context.Context, addressRange *AddressRange) ([]*SnapshotRow, error) {
 	key := fmt.Sprintf("%s_%s_%s", getSnapshotCachePrefix, addressRange.MinAddress.String(), addressRange.MaxAddress.String())
 
@@ -229,6 +229,35 @@ func TestDeletedExpired(t *testing.T) {
 	})
 }
 
+func TestDeleteOrphanedChunks(t *testing.T) {
+	var limit uint = 1
+
+	lggr := logger.TestLogger(t)
+
+	t.Run("OK-DeleteOrphanedChunks_underlaying_ORM_returns_a_row", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		var expectedDeleted int64 = 10
+		underlayingORM := mocks.NewORM(t)
+		underlayingORM.On("DeleteOrphanedChunks", mock.Anything, limit).Return(expectedDeleted, nil).Once()
+		orm := s4.NewCachedORMWrapper(underlayingORM, lggr)
+
+		actualDeleted, err := orm.DeleteOrphanedChunks(ctx, limit)
+		require.NoError(t, err)
+		require.Equal(t, expectedDeleted, actualDeleted)
+	})
+	t.Run("NOK-DeleteOrphanedChunks_underlaying_ORM_returns_an_error", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		var expectedDeleted int64
+		underlayingORM := mocks.NewORM(t)
+		underlayingORM.On("DeleteOrphanedChunks", mock.Anything, limit).Return(expectedDeleted, errors.New("some_error")).Once()
+		orm := s4.NewCachedORMWrapper(underlayingORM, lggr)
+
+		actualDeleted, err := orm.DeleteOrphanedChunks(ctx, limit)
+		require.EqualError(t, err, "some_error")
+		require.Equal(t, expectedDeleted, actualDeleted)
+	})
+}
+
 // GetUnconfirmedRows(limit uint, qopts ...pg.QOpt) ([]*Row, error)
 func TestGetUnconfirmedRows(t *testing.T) {
 	var limit uint = 1
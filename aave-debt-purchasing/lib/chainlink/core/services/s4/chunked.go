@@ -0,0 +1,195 @@
+package s4
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Chunked records store payloads larger than MaxPayloadSizeBytes in several slots of a user:
+// chunk records holding parts of the payload, and a manifest record referencing them by content hash.
+// Each record is signed like any other record.
+//
+// A manifest payload is manifestHeader followed by the JSON encoded chunkedManifest.
+// A chunk payload is "s4chunk/1:<manifest slot id>:<manifest version>\n" followed by the data,
+// binding the chunk to its manifest.
+const (
+	manifestHeader    = "s4manifest/1\n"
+	chunkHeaderFormat = "s4chunk/1:%d:%d\n"
+)
+
+var chunkHeaderRegexp = regexp.MustCompile(`^s4chunk/1:([0-9]+):([0-9]+)\n`)
+
+type chunkedManifest struct {
+	// Size is the size of the payload
+	Size uint64 `json:"size"`
+	// Hash is the keccak256 hash of the payload
+	Hash   common.Hash `json:"hash"`
+	Chunks []chunkRef  `json:"chunks"`
+}
+
+type chunkRef struct {
+	SlotID  uint   `json:"slot_id"`
+	Version uint64 `json:"version"`
+	// Hash is the keccak256 hash of the chunk record payload
+	Hash common.Hash `json:"hash"`
+}
+
+// SignedRecord is a record with the signature of its envelope.
+type SignedRecord struct {
+	Key       Key
+	Record    Record
+	Signature []byte
+}
+
+// ChunkedRecord is a payload stored in chunk records, referenced by a manifest record.
+type ChunkedRecord struct {
+	Manifest SignedRecord
+	Chunks   []SignedRecord
+}
+
+// NewChunkedRecord splits payload in chunk records stored in chunkSlotIDs, with the same version and
+// expiration as the manifest record stored in manifestKey. maxPayloadSizeBytes is the MaxPayloadSizeBytes
+// constraint of the storage. The records need to be signed before being stored.
+func NewChunkedRecord(manifestKey Key, payload []byte, expiration int64, chunkSlotIDs []uint, maxPayloadSizeBytes uint) (*ChunkedRecord, error) {
+	header := fmt.Sprintf(chunkHeaderFormat, manifestKey.SlotId, manifestKey.Version)
+	if uint(len(header)) >= maxPayloadSizeBytes {
+		return nil, ErrPayloadTooBig
+	}
+	chunkSize := int(maxPayloadSizeBytes) - len(header)
+	nChunks := (len(payload) + chunkSize - 1) / chunkSize
+	if nChunks > len(chunkSlotIDs) {
+		return nil, fmt.Errorf("payload needs %d chunk slots, got %d", nChunks, len(chunkSlotIDs))
+	}
+
+	record := &ChunkedRecord{Chunks: make([]SignedRecord, nChunks)}
+	manifest := chunkedManifest{
+		Size:   uint64(len(payload)),
+		Hash:   crypto.Keccak256Hash(payload),
+		Chunks: make([]chunkRef, nChunks),
+	}
+	for i := range nChunks {
+		data := payload[i*chunkSize : min((i+1)*chunkSize, len(payload))]
+		chunkPayload := append([]byte(header), data...)
+		record.Chunks[i] = SignedRecord{
+			Key: Key{
+				Address: manifestKey.Address,
+				SlotId:  chunkSlotIDs[i],
+				Version: manifestKey.Version,
+			},
+			Record: Record{
+				Payload:    chunkPayload,
+				Expiration: expiration,
+			},
+		}
+		manifest.Chunks[i] = chunkRef{
+			SlotID:  chunkSlotIDs[i],
+			Version: manifestKey.Version,
+			Hash:    crypto.Keccak256Hash(chunkPayload),
+		}
+	}
+
+	manifestJson, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	manifestPayload := append([]byte(manifestHeader), manifestJson...)
+	if uint(len(manifestPayload)) > maxPayloadSizeBytes {
+		return nil, ErrPayloadTooBig
+	}
+	record.Manifest = SignedRecord{
+		Key: manifestKey,
+		Record: Record{
+			Payload:    manifestPayload,
+			Expiration: expiration,
+		},
+	}
+	return record, nil
+}
+
+// Sign signs the manifest and chunk records.
+func (c *ChunkedRecord) Sign(privateKey *ecdsa.PrivateKey) error {
+	sign := func(r *SignedRecord) (err error) {
+		r.Signature, err = NewEnvelopeFromRecord(&r.Key, &r.Record).Sign(privateKey)
+		return
+	}
+	for i := range c.Chunks {
+		if err := sign(&c.Chunks[i]); err != nil {
+			return err
+		}
+	}
+	return sign(&c.Manifest)
+}
+
+// validate checks that the chunks are the ones referenced by the manifest, and returns the manifest.
+func (c *ChunkedRecord) validate() (*chunkedManifest, error) {
+	manifest, ok := parseManifest(c.Manifest.Record.Payload)
+	if !ok {
+		return nil, ErrNotChunked
+	}
+	if len(manifest.Chunks) != len(c.Chunks) {
+		return nil, fmt.Errorf("%w: manifest references %d chunks, got %d", ErrChunkMismatch, len(manifest.Chunks), len(c.Chunks))
+	}
+	slots := map[uint]struct{}{c.Manifest.Key.SlotId: {}}
+	var size uint64
+	hash := crypto.NewKeccakState()
+	for i, chunk := range c.Chunks {
+		ref := manifest.Chunks[i]
+		if chunk.Key.Address != c.Manifest.Key.Address || chunk.Key.SlotId != ref.SlotID || chunk.Key.Version != ref.Version ||
+			crypto.Keccak256Hash(chunk.Record.Payload) != ref.Hash {
+			return nil, fmt.Errorf("%w: chunk %d", ErrChunkMismatch, i)
+		}
+		if _, ok := slots[chunk.Key.SlotId]; ok {
+			return nil, fmt.Errorf("%w: slot %d is used twice", ErrChunkMismatch, chunk.Key.SlotId)
+		}
+		slots[chunk.Key.SlotId] = struct{}{}
+		// chunks are deleted along with their manifest
+		if chunk.Record.Expiration != c.Manifest.Record.Expiration {
+			return nil, fmt.Errorf("%w: chunk %d expires before its manifest", ErrChunkMismatch, i)
+		}
+		manifestSlotID, manifestVersion, data, ok := parseChunk(chunk.Record.Payload)
+		if !ok || manifestSlotID != c.Manifest.Key.SlotId || manifestVersion != c.Manifest.Key.Version {
+			return nil, fmt.Errorf("%w: chunk %d is not bound to the manifest", ErrChunkMismatch, i)
+		}
+		size += uint64(len(data))
+		hash.Write(data)
+	}
+	if size != manifest.Size || common.BytesToHash(hash.Sum(nil)) != manifest.Hash {
+		return nil, fmt.Errorf("%w: payload does not match the manifest", ErrChunkMismatch)
+	}
+	return manifest, nil
+}
+
+func parseManifest(payload []byte) (*chunkedManifest, bool) {
+	if !bytes.HasPrefix(payload, []byte(manifestHeader)) {
+		return nil, false
+	}
+	var manifest chunkedManifest
+	if err := json.Unmarshal(payload[len(manifestHeader):], &manifest); err != nil {
+		return nil, false
+	}
+	return &manifest, true
+}
+
+// parseChunk returns the manifest slot and version a chunk is bound to, and its data.
+func parseChunk(payload []byte) (manifestSlotID uint, manifestVersion uint64, data []byte, ok bool) {
+	match := chunkHeaderRegexp.FindSubmatch(payload)
+	if match == nil {
+		return 0, 0, nil, false
+	}
+	slotID, err := strconv.ParseUint(string(match[1]), 10, 0)
+	if err != nil {
+		return 0, 0, nil, false
+	}
+	version, err := strconv.ParseUint(string(match[2]), 10, 64)
+	if err != nil {
+		return 0, 0, nil, false
+	}
+	return uint(slotID), version, payload[len(match[0]):], true
+}
@@ -0,0 +1,204 @@
+package s4_test
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+)
+
+var chunkedConstraints = s4.Constraints{
+	MaxSlotsPerUser:        10,
+	MaxPayloadSizeBytes:    512,
+	MaxExpirationLengthSec: 3600,
+}
+
+// testDeleteOrphanedChunks stores two versions of a chunked record in orm, and checks that only the chunks
+// of the first one are deleted, whatever the payload of the rows.
+func testDeleteOrphanedChunks(t *testing.T, orm s4.ORM) {
+	t.Helper()
+
+	ctx := testutils.Context(t)
+	address := big.New(testutils.NewAddress().Big())
+	row := func(slotID uint, version uint64, payload string) *s4.Row {
+		return &s4.Row{
+			Address:    address,
+			SlotId:     slotID,
+			Payload:    []byte(payload),
+			Version:    version,
+			Expiration: time.Now().Add(time.Hour).UnixMilli(),
+			Confirmed:  true,
+			Signature:  []byte("signature"),
+		}
+	}
+	manifest := func(chunks ...string) string {
+		return "s4manifest/1\n" + fmt.Sprintf(`{"size":0,"hash":"%s","chunks":[%s]}`, common.Hash{}.Hex(), strings.Join(chunks, ","))
+	}
+	chunk := func(slotID uint, version uint64) string {
+		return fmt.Sprintf(`{"slot_id":%d,"version":%d,"hash":"%s"}`, slotID, version, common.Hash{}.Hex())
+	}
+	for _, r := range []*s4.Row{
+		row(1, 1, "chunk 1"),
+		row(2, 1, "chunk 2"),
+		row(0, 1, manifest(chunk(1, 1), chunk(2, 1))),
+		// slot 2 is reused for a plain record
+		row(2, 2, "plain"),
+		row(3, 2, "chunk 3"),
+		// a plain record with the payload of a chunk of the first manifest
+		row(4, 2, "s4chunk/1:0:1\nplain"),
+		row(0, 2, manifest(chunk(3, 2))),
+	} {
+		require.NoError(t, orm.Update(ctx, r))
+	}
+
+	deleted, err := orm.DeleteOrphanedChunks(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+
+	deleted, err = orm.DeleteOrphanedChunks(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	_, err = orm.Get(ctx, address, 1)
+	assert.ErrorIs(t, err, s4.ErrNotFound)
+	for _, slotID := range []uint{0, 2, 3, 4} {
+		_, err = orm.Get(ctx, address, slotID)
+		assert.NoError(t, err)
+	}
+
+	// a chunk still referenced by the current manifest is kept
+	require.NoError(t, orm.Update(ctx, row(0, 3, manifest(chunk(3, 2)))))
+	deleted, err = orm.DeleteOrphanedChunks(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+	_, err = orm.Get(ctx, address, 3)
+	assert.NoError(t, err)
+}
+
+func TestNewChunkedRecord(t *testing.T) {
+	t.Parallel()
+
+	key := s4.Key{Address: testutils.NewAddress(), SlotId: 0, Version: 1}
+	expiration := time.Now().Add(time.Minute).UnixMilli()
+
+	t.Run("not enough slots", func(t *testing.T) {
+		_, err := s4.NewChunkedRecord(key, make([]byte, 1000), expiration, []uint{1}, chunkedConstraints.MaxPayloadSizeBytes)
+		assert.ErrorContains(t, err, "payload needs 3 chunk slots, got 1")
+	})
+
+	t.Run("manifest too big", func(t *testing.T) {
+		_, err := s4.NewChunkedRecord(key, make([]byte, 100), expiration, []uint{1, 2}, 64)
+		assert.ErrorIs(t, err, s4.ErrPayloadTooBig)
+	})
+
+	t.Run("chunks", func(t *testing.T) {
+		record, err := s4.NewChunkedRecord(key, make([]byte, 1000), expiration, []uint{1, 2, 3, 4}, chunkedConstraints.MaxPayloadSizeBytes)
+		require.NoError(t, err)
+		assert.Equal(t, key, record.Manifest.Key)
+		require.Len(t, record.Chunks, 3)
+		for i, chunk := range record.Chunks {
+			assert.Equal(t, s4.Key{Address: key.Address, SlotId: uint(i + 1), Version: 1}, chunk.Key)
+			assert.Equal(t, expiration, chunk.Record.Expiration)
+			assert.LessOrEqual(t, len(chunk.Record.Payload), int(chunkedConstraints.MaxPayloadSizeBytes))
+		}
+	})
+}
+
+func TestStorage_PutAndGetChunked(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	now := time.Now()
+	orm := s4.NewInMemoryORM()
+	storage := s4.NewStorage(logger.TestLogger(t), chunkedConstraints, orm, clockwork.NewFakeClockAt(now))
+	privateKey, address := testutils.NewPrivateKeyAndAddress(t)
+	expiration := now.Add(time.Hour).UnixMilli()
+
+	newChunkedRecord := func(version uint64, payload []byte, chunkSlotIDs []uint) *s4.ChunkedRecord {
+		key := s4.Key{Address: address, SlotId: 0, Version: version}
+		record, err := s4.NewChunkedRecord(key, payload, expiration, chunkSlotIDs, chunkedConstraints.MaxPayloadSizeBytes)
+		require.NoError(t, err)
+		require.NoError(t, record.Sign(privateKey))
+		return record
+	}
+	payload := make([]byte, 1200)
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+	record := newChunkedRecord(1, payload, []uint{1, 2, 3})
+	require.NoError(t, storage.PutChunked(ctx, record))
+
+	t.Run("get", func(t *testing.T) {
+		got, metadata, err := storage.GetChunked(ctx, &record.Manifest.Key)
+		require.NoError(t, err)
+		assert.Equal(t, payload, got.Payload)
+		assert.Equal(t, expiration, got.Expiration)
+		assert.False(t, metadata.Confirmed)
+		assert.Equal(t, record.Manifest.Signature, metadata.Signature)
+	})
+
+	t.Run("confirmed", func(t *testing.T) {
+		for _, r := range append(record.Chunks, record.Manifest) {
+			row, err := orm.Get(ctx, big.New(address.Big()), r.Key.SlotId)
+			require.NoError(t, err)
+			row.Confirmed = true
+			require.NoError(t, orm.Update(ctx, row))
+		}
+		_, metadata, err := storage.GetChunked(ctx, &record.Manifest.Key)
+		require.NoError(t, err)
+		assert.True(t, metadata.Confirmed)
+	})
+
+	t.Run("not chunked", func(t *testing.T) {
+		_, _, err := storage.GetChunked(ctx, &record.Chunks[0].Key)
+		assert.ErrorIs(t, err, s4.ErrNotChunked)
+	})
+
+	t.Run("mismatching chunk", func(t *testing.T) {
+		invalid := newChunkedRecord(2, payload, []uint{4, 5, 6})
+		invalid.Chunks[1].Record.Payload[20]++
+		assert.ErrorIs(t, storage.PutChunked(ctx, invalid), s4.ErrChunkMismatch)
+	})
+
+	t.Run("chunk slot in use", func(t *testing.T) {
+		assert.ErrorIs(t, storage.PutChunked(ctx, newChunkedRecord(2, payload, []uint{3, 4, 5})), s4.ErrChunkSlotInUse)
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		newPayload := payload[:700]
+		newRecord := newChunkedRecord(2, newPayload, []uint{4, 5})
+		require.NoError(t, storage.PutChunked(ctx, newRecord))
+
+		got, _, err := storage.GetChunked(ctx, &newRecord.Manifest.Key)
+		require.NoError(t, err)
+		assert.Equal(t, newPayload, got.Payload)
+
+		// the chunks of the previous manifest are orphaned
+		deleted, err := orm.DeleteOrphanedChunks(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), deleted)
+		got, _, err = storage.GetChunked(ctx, &newRecord.Manifest.Key)
+		require.NoError(t, err)
+		assert.Equal(t, newPayload, got.Payload)
+	})
+
+	t.Run("overwritten chunk", func(t *testing.T) {
+		key := &s4.Key{Address: address, SlotId: 5, Version: 3}
+		chunk := &s4.Record{Payload: []byte("foobar"), Expiration: expiration}
+		signature, err := s4.NewEnvelopeFromRecord(key, chunk).Sign(privateKey)
+		require.NoError(t, err)
+		require.NoError(t, storage.Put(ctx, key, chunk, signature))
+
+		_, _, err = storage.GetChunked(ctx, &s4.Key{Address: address, SlotId: 0, Version: 2})
+		assert.ErrorIs(t, err, s4.ErrNotFound)
+	})
+}
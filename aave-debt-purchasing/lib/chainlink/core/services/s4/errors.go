@@ -10,4 +10,7 @@ var (
 	ErrPastExpiration    = errors.New("past expiration")
 	ErrVersionTooLow     = errors.New("version too low")
 	ErrExpirationTooLong = errors.New("expiration too long")
+	ErrNotChunked        = errors.New("record is not chunked")
+	ErrChunkMismatch     = errors.New("chunks do not match the manifest")
+	ErrChunkSlotInUse    = errors.New("chunk slot is used by the current manifest")
 )
@@ -19,16 +19,27 @@ type mrow struct {
 	UpdatedAt time.Time
 }
 
+// chunkRef is a chunk referenced by the manifest stored in a slot at a version.
+type chunkRef struct {
+	address         string
+	manifestSlot    uint
+	manifestVersion uint64
+	slot            uint
+	version         uint64
+}
+
 type inMemoryOrm struct {
-	rows map[key]*mrow
-	mu   sync.RWMutex
+	rows      map[key]*mrow
+	chunkRefs map[chunkRef]struct{}
+	mu        sync.RWMutex
 }
 
 var _ ORM = (*inMemoryOrm)(nil)
 
 func NewInMemoryORM() ORM {
 	return &inMemoryOrm{
-		rows: make(map[key]*mrow),
+		rows:      make(map[key]*mrow),
+		chunkRefs: make(map[chunkRef]struct{}),
 	}
 }
 
@@ -71,6 +82,11 @@ func (o *inMemoryOrm) Update(ctx context.Context, row *Row) error {
 		Row:       row.Clone(),
 		UpdatedAt: time.Now().UTC(),
 	}
+	if manifest, ok := parseManifest(row.Payload); ok {
+		for _, ref := range manifest.Chunks {
+			o.chunkRefs[chunkRef{mkey.address, row.SlotId, row.Version, ref.SlotID, ref.Version}] = struct{}{}
+		}
+	}
 	return nil
 }
 
@@ -94,6 +110,37 @@ func (o *inMemoryOrm) DeleteExpired(ctx context.Context, limit uint, now time.Ti
 	return int64(len(queue)), nil
 }
 
+func (o *inMemoryOrm) DeleteOrphanedChunks(ctx context.Context, limit uint) (int64, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	current := make(map[chunkRef]struct{})
+	orphans := make([]chunkRef, 0)
+	for ref := range o.chunkRefs {
+		manifest, ok := o.rows[key{address: ref.address, slot: ref.manifestSlot}]
+		if ok && manifest.Row.Version <= ref.manifestVersion {
+			current[chunkRef{address: ref.address, slot: ref.slot, version: ref.version}] = struct{}{}
+		} else if len(orphans) < int(limit) {
+			orphans = append(orphans, ref)
+		}
+	}
+
+	var deleted int64
+	for _, ref := range orphans {
+		delete(o.chunkRefs, ref)
+		if _, ok := current[chunkRef{address: ref.address, slot: ref.slot, version: ref.version}]; ok {
+			continue
+		}
+		k := key{address: ref.address, slot: ref.slot}
+		if chunk, ok := o.rows[k]; ok && chunk.Row.Version == ref.version {
+			delete(o.rows, k)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
 func (o *inMemoryOrm) GetSnapshot(ctx context.Context, _ *AddressRange) ([]*SnapshotRow, error) {
 	o.mu.RLock()
 	defer o.mu.RUnlock()
@@ -170,3 +170,9 @@ func TestInMemoryORM_GetSnapshot(t *testing.T) {
 		assert.Equal(t, 1, c)
 	}
 }
+
+func TestInMemoryORM_DeleteOrphanedChunks(t *testing.T) {
+	t.Parallel()
+
+	testDeleteOrphanedChunks(t, s4.NewInMemoryORM())
+}
@@ -85,6 +85,63 @@ func (_c *ORM_DeleteExpired_Call) RunAndReturn(run func(context.Context, uint, t
 	return _c
 }
 
+// DeleteOrphanedChunks provides a mock function with given fields: ctx, limit
+func (_m *ORM) DeleteOrphanedChunks(ctx context.Context, limit uint) (int64, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrphanedChunks")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (int64, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) int64); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_DeleteOrphanedChunks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrphanedChunks'
+type ORM_DeleteOrphanedChunks_Call struct {
+	*mock.Call
+}
+
+// DeleteOrphanedChunks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit uint
+func (_e *ORM_Expecter) DeleteOrphanedChunks(ctx interface{}, limit interface{}) *ORM_DeleteOrphanedChunks_Call {
+	return &ORM_DeleteOrphanedChunks_Call{Call: _e.mock.On("DeleteOrphanedChunks", ctx, limit)}
+}
+
+func (_c *ORM_DeleteOrphanedChunks_Call) Run(run func(ctx context.Context, limit uint)) *ORM_DeleteOrphanedChunks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *ORM_DeleteOrphanedChunks_Call) Return(_a0 int64, _a1 error) *ORM_DeleteOrphanedChunks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_DeleteOrphanedChunks_Call) RunAndReturn(run func(context.Context, uint) (int64, error)) *ORM_DeleteOrphanedChunks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, address, slotId
 func (_m *ORM) Get(ctx context.Context, address *big.Big, slotId uint) (*s4.Row, error) {
 	ret := _m.Called(ctx, address, slotId)
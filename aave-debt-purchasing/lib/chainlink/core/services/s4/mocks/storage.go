@@ -138,6 +138,74 @@ func (_c *Storage_Get_Call) RunAndReturn(run func(context.Context, *s4.Key) (*s4
 	return _c
 }
 
+// GetChunked provides a mock function with given fields: ctx, key
+func (_m *Storage) GetChunked(ctx context.Context, key *s4.Key) (*s4.Record, *s4.Metadata, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChunked")
+	}
+
+	var r0 *s4.Record
+	var r1 *s4.Metadata
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s4.Key) (*s4.Record, *s4.Metadata, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *s4.Key) *s4.Record); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s4.Record)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *s4.Key) *s4.Metadata); ok {
+		r1 = rf(ctx, key)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*s4.Metadata)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *s4.Key) error); ok {
+		r2 = rf(ctx, key)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Storage_GetChunked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChunked'
+type Storage_GetChunked_Call struct {
+	*mock.Call
+}
+
+// GetChunked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key *s4.Key
+func (_e *Storage_Expecter) GetChunked(ctx interface{}, key interface{}) *Storage_GetChunked_Call {
+	return &Storage_GetChunked_Call{Call: _e.mock.On("GetChunked", ctx, key)}
+}
+
+func (_c *Storage_GetChunked_Call) Run(run func(ctx context.Context, key *s4.Key)) *Storage_GetChunked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*s4.Key))
+	})
+	return _c
+}
+
+func (_c *Storage_GetChunked_Call) Return(_a0 *s4.Record, _a1 *s4.Metadata, _a2 error) *Storage_GetChunked_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Storage_GetChunked_Call) RunAndReturn(run func(context.Context, *s4.Key) (*s4.Record, *s4.Metadata, error)) *Storage_GetChunked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: ctx, address
 func (_m *Storage) List(ctx context.Context, address common.Address) ([]*s4.SnapshotRow, error) {
 	ret := _m.Called(ctx, address)
@@ -197,6 +265,39 @@ func (_c *Storage_List_Call) RunAndReturn(run func(context.Context, common.Addre
 	return _c
 }
 
+// Notify provides a mock function with given fields: event
+func (_m *Storage) Notify(event s4.Event) {
+	_m.Called(event)
+}
+
+// Storage_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type Storage_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+//   - event s4.Event
+func (_e *Storage_Expecter) Notify(event interface{}) *Storage_Notify_Call {
+	return &Storage_Notify_Call{Call: _e.mock.On("Notify", event)}
+}
+
+func (_c *Storage_Notify_Call) Run(run func(event s4.Event)) *Storage_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(s4.Event))
+	})
+	return _c
+}
+
+func (_c *Storage_Notify_Call) Return() *Storage_Notify_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Storage_Notify_Call) RunAndReturn(run func(s4.Event)) *Storage_Notify_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Put provides a mock function with given fields: ctx, key, record, signature
 func (_m *Storage) Put(ctx context.Context, key *s4.Key, record *s4.Record, signature []byte) error {
 	ret := _m.Called(ctx, key, record, signature)
@@ -246,6 +347,113 @@ func (_c *Storage_Put_Call) RunAndReturn(run func(context.Context, *s4.Key, *s4.
 	return _c
 }
 
+// PutChunked provides a mock function with given fields: ctx, record
+func (_m *Storage) PutChunked(ctx context.Context, record *s4.ChunkedRecord) error {
+	ret := _m.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutChunked")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s4.ChunkedRecord) error); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_PutChunked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutChunked'
+type Storage_PutChunked_Call struct {
+	*mock.Call
+}
+
+// PutChunked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record *s4.ChunkedRecord
+func (_e *Storage_Expecter) PutChunked(ctx interface{}, record interface{}) *Storage_PutChunked_Call {
+	return &Storage_PutChunked_Call{Call: _e.mock.On("PutChunked", ctx, record)}
+}
+
+func (_c *Storage_PutChunked_Call) Run(run func(ctx context.Context, record *s4.ChunkedRecord)) *Storage_PutChunked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*s4.ChunkedRecord))
+	})
+	return _c
+}
+
+func (_c *Storage_PutChunked_Call) Return(_a0 error) *Storage_PutChunked_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_PutChunked_Call) RunAndReturn(run func(context.Context, *s4.ChunkedRecord) error) *Storage_PutChunked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Watch provides a mock function with given fields: ctx, address, slotIDs
+func (_m *Storage) Watch(ctx context.Context, address common.Address, slotIDs []uint) (<-chan s4.Event, error) {
+	ret := _m.Called(ctx, address, slotIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Watch")
+	}
+
+	var r0 <-chan s4.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []uint) (<-chan s4.Event, error)); ok {
+		return rf(ctx, address, slotIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []uint) <-chan s4.Event); ok {
+		r0 = rf(ctx, address, slotIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan s4.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, []uint) error); ok {
+		r1 = rf(ctx, address, slotIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_Watch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Watch'
+type Storage_Watch_Call struct {
+	*mock.Call
+}
+
+// Watch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address common.Address
+//   - slotIDs []uint
+func (_e *Storage_Expecter) Watch(ctx interface{}, address interface{}, slotIDs interface{}) *Storage_Watch_Call {
+	return &Storage_Watch_Call{Call: _e.mock.On("Watch", ctx, address, slotIDs)}
+}
+
+func (_c *Storage_Watch_Call) Run(run func(ctx context.Context, address common.Address, slotIDs []uint)) *Storage_Watch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].([]uint))
+	})
+	return _c
+}
+
+func (_c *Storage_Watch_Call) Return(_a0 <-chan s4.Event, _a1 error) *Storage_Watch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_Watch_Call) RunAndReturn(run func(context.Context, common.Address, []uint) (<-chan s4.Event, error)) *Storage_Watch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewStorage creates a new instance of Storage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewStorage(t interface {
@@ -40,6 +40,8 @@ type ORM interface {
 	// When updating, the new row must have greater or equal version,
 	// otherwise ErrVersionTooLow is returned.
 	// UpdatedAt field value is ignored.
+	// If the row holds the manifest of a chunked record, the chunks it references are recorded
+	// for DeleteOrphanedChunks.
 	Update(ctx context.Context, row *Row) error
 
 	// DeleteExpired deletes any entries having Expiration < utcNow,
@@ -47,6 +49,12 @@ type ORM interface {
 	// Returns the number of deleted rows.
 	DeleteExpired(ctx context.Context, limit uint, utcNow time.Time) (int64, error)
 
+	// DeleteOrphanedChunks deletes the chunks referenced by manifests which have since been
+	// replaced by a newer version or deleted, processing up to the given limit of references.
+	// Chunks which were replaced since, or are still referenced by a current manifest, are kept.
+	// Returns the number of deleted rows.
+	DeleteOrphanedChunks(ctx context.Context, limit uint) (int64, error)
+
 	// GetSnapshot selects all non-expired row versions for the given addresses range.
 	// For the full address range, use NewFullAddressRange().
 	GetSnapshot(ctx context.Context, addressRange *AddressRange) ([]*SnapshotRow, error)
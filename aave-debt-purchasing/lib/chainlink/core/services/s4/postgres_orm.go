@@ -18,18 +18,20 @@ const (
 )
 
 type orm struct {
-	ds        sqlutil.DataSource
-	tableName string
-	namespace string
+	ds                 sqlutil.DataSource
+	tableName          string
+	chunkRefsTableName string
+	namespace          string
 }
 
 var _ ORM = (*orm)(nil)
 
 func NewPostgresORM(ds sqlutil.DataSource, tableName, namespace string) ORM {
 	return &orm{
-		ds:        ds,
-		tableName: fmt.Sprintf(`"%s".%s`, s4PostgresSchema, tableName),
-		namespace: namespace,
+		ds:                 ds,
+		tableName:          fmt.Sprintf(`"%s".%s`, s4PostgresSchema, tableName),
+		chunkRefsTableName: fmt.Sprintf(`"%s".%s_chunk_refs`, s4PostgresSchema, tableName),
+		namespace:          namespace,
 	}
 }
 
@@ -62,12 +64,34 @@ signature = EXCLUDED.signature,
 updated_at = NOW()
 WHERE (t.version < EXCLUDED.version) OR (t.version <= EXCLUDED.version AND EXCLUDED.confirmed IS TRUE)
 RETURNING id;`, o.tableName)
-	var id uint64
-	err := o.ds.GetContext(ctx, &id, stmt, o.namespace, row.Address, row.SlotId, row.Version, row.Expiration, row.Confirmed, row.Payload, row.Signature)
-	if errors.Is(err, sql.ErrNoRows) {
-		return ErrVersionTooLow
+	return sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		var id uint64
+		err := tx.GetContext(ctx, &id, stmt, o.namespace, row.Address, row.SlotId, row.Version, row.Expiration, row.Confirmed, row.Payload, row.Signature)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrVersionTooLow
+		}
+		if err != nil {
+			return err
+		}
+		return o.insertChunkRefs(ctx, tx, row)
+	})
+}
+
+// insertChunkRefs records the chunks referenced by the manifest stored in row, if any, for DeleteOrphanedChunks.
+// Chunks are only ever identified by the manifest referencing them, whatever their own payload.
+func (o *orm) insertChunkRefs(ctx context.Context, ds sqlutil.DataSource, row *Row) error {
+	manifest, ok := parseManifest(row.Payload)
+	if !ok {
+		return nil
+	}
+	stmt := fmt.Sprintf(`INSERT INTO %s (namespace, address, manifest_slot_id, manifest_version, slot_id, version)
+VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING;`, o.chunkRefsTableName)
+	for _, ref := range manifest.Chunks {
+		if _, err := ds.ExecContext(ctx, stmt, o.namespace, row.Address, row.SlotId, row.Version, ref.SlotID, ref.Version); err != nil {
+			return err
+		}
 	}
-	return err
+	return nil
 }
 
 func (o *orm) DeleteExpired(ctx context.Context, limit uint, utcNow time.Time) (int64, error) {
@@ -80,6 +104,32 @@ func (o *orm) DeleteExpired(ctx context.Context, limit uint, utcNow time.Time) (
 	return result.RowsAffected()
 }
 
+func (o *orm) DeleteOrphanedChunks(ctx context.Context, limit uint) (int64, error) {
+	// The references of replaced (or deleted) manifests are deleted along with the chunks they reference,
+	// unless the chunk row was replaced since or is still referenced by a current manifest.
+	stmt := fmt.Sprintf(`WITH orphans AS (
+	SELECT r.namespace, r.address, r.manifest_slot_id, r.manifest_version, r.slot_id FROM %[1]s r
+	LEFT JOIN %[2]s m ON m.namespace = r.namespace AND m.address = r.address AND m.slot_id = r.manifest_slot_id
+	WHERE r.namespace = $1 AND (m.version IS NULL OR m.version > r.manifest_version) LIMIT $2
+), deleted AS (
+	DELETE FROM %[1]s r USING orphans o
+	WHERE r.namespace = o.namespace AND r.address = o.address AND r.manifest_slot_id = o.manifest_slot_id
+	AND r.manifest_version = o.manifest_version AND r.slot_id = o.slot_id
+	RETURNING r.address, r.slot_id, r.version
+)
+DELETE FROM %[2]s c USING deleted d
+WHERE c.namespace = $1 AND c.address = d.address AND c.slot_id = d.slot_id AND c.version = d.version
+AND NOT EXISTS (
+	SELECT 1 FROM %[1]s r JOIN %[2]s m ON m.namespace = r.namespace AND m.address = r.address AND m.slot_id = r.manifest_slot_id
+	WHERE r.namespace = $1 AND r.address = d.address AND r.slot_id = d.slot_id AND r.version = d.version AND m.version = r.manifest_version
+);`, o.chunkRefsTableName, o.tableName)
+	result, err := o.ds.ExecContext(ctx, stmt, o.namespace, limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 func (o *orm) GetSnapshot(ctx context.Context, addressRange *AddressRange) ([]*SnapshotRow, error) {
 	rows := make([]*SnapshotRow, 0)
 
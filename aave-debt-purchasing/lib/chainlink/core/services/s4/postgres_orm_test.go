@@ -144,6 +144,12 @@ func TestPostgresORM_DeleteExpired(t *testing.T) {
 	assert.Equal(t, total-expired, count)
 }
 
+func TestPostgresORM_DeleteOrphanedChunks(t *testing.T) {
+	t.Parallel()
+
+	testDeleteOrphanedChunks(t, setupORM(t, "test"))
+}
+
 func TestPostgresORM_GetSnapshot(t *testing.T) {
 	t.Parallel()
 
@@ -2,10 +2,13 @@ package s4
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/jonboulle/clockwork"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -62,6 +65,23 @@ type Storage interface {
 	// List returns a snapshot for the specified address.
 	// Slots having no data are not returned.
 	List(ctx context.Context, address common.Address) ([]*SnapshotRow, error)
+
+	// GetChunked returns the payload of the chunked record whose manifest is identified by key.
+	// The returned Metadata is confirmed once the manifest and all chunks are, and has the signature of the manifest.
+	GetChunked(ctx context.Context, key *Key) (*Record, *Metadata, error)
+
+	// PutChunked stores a chunked record, see NewChunkedRecord. The chunks are stored before the manifest,
+	// and cannot be stored in the chunk slots of the current manifest, so that readers get either the
+	// previous or the new payload. Chunks of replaced manifests are deleted along with expired records.
+	PutChunked(ctx context.Context, record *ChunkedRecord) error
+
+	// Watch returns the events of the specified slots of address, or of all its slots if slotIDs is empty,
+	// until ctx is done. The channel is closed when ctx is done, or if the receiver falls behind,
+	// in which case List can be used to catch up.
+	Watch(ctx context.Context, address common.Address, slotIDs []uint) (<-chan Event, error)
+
+	// Notifier sends the changes detected outside of Storage, such as confirmations, to the watchers.
+	Notifier
 }
 
 type storage struct {
@@ -69,6 +89,7 @@ type storage struct {
 	contraints Constraints
 	orm        ORM
 	clock      clockwork.Clock
+	watchers   *watchers
 }
 
 var _ Storage = (*storage)(nil)
@@ -79,6 +100,7 @@ func NewStorage(lggr logger.Logger, contraints Constraints, orm ORM, clock clock
 		contraints: contraints,
 		orm:        orm,
 		clock:      clock,
+		watchers:   newWatchers(),
 	}
 }
 
@@ -158,5 +180,95 @@ func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature [
 	copy(row.Payload, record.Payload)
 	copy(row.Signature, signature)
 
-	return s.orm.Update(ctx, row)
+	if err = s.orm.Update(ctx, row); err != nil {
+		return err
+	}
+	s.Notify(Event{Type: EventUpdated, Key: *key, Expiration: record.Expiration})
+	return nil
+}
+
+func (s *storage) GetChunked(ctx context.Context, key *Key) (*Record, *Metadata, error) {
+	record, metadata, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	manifest, ok := parseManifest(record.Payload)
+	if !ok {
+		return nil, nil, ErrNotChunked
+	}
+
+	payload := make([]byte, 0, manifest.Size)
+	for _, ref := range manifest.Chunks {
+		chunkKey := &Key{
+			Address: key.Address,
+			SlotId:  ref.SlotID,
+			Version: ref.Version,
+		}
+		chunkRecord, chunkMetadata, err := s.Get(ctx, chunkKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get the chunk in slot %d: %w", ref.SlotID, err)
+		}
+		if crypto.Keccak256Hash(chunkRecord.Payload) != ref.Hash {
+			return nil, nil, fmt.Errorf("%w: chunk in slot %d", ErrChunkMismatch, ref.SlotID)
+		}
+		_, _, data, _ := parseChunk(chunkRecord.Payload)
+		payload = append(payload, data...)
+		metadata.Confirmed = metadata.Confirmed && chunkMetadata.Confirmed
+	}
+	if uint64(len(payload)) != manifest.Size || crypto.Keccak256Hash(payload) != manifest.Hash {
+		return nil, nil, fmt.Errorf("%w: payload does not match the manifest", ErrChunkMismatch)
+	}
+
+	record.Payload = payload
+	return record, metadata, nil
+}
+
+func (s *storage) PutChunked(ctx context.Context, record *ChunkedRecord) error {
+	if _, err := record.validate(); err != nil {
+		return err
+	}
+
+	manifestKey := &record.Manifest.Key
+	row, err := s.orm.Get(ctx, big.New(manifestKey.Address.Big()), manifestKey.SlotId)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if err == nil && row.Expiration > s.clock.Now().UnixMilli() {
+		if current, ok := parseManifest(row.Payload); ok {
+			currentSlots := make(map[uint]struct{}, len(current.Chunks))
+			for _, ref := range current.Chunks {
+				currentSlots[ref.SlotID] = struct{}{}
+			}
+			for _, chunk := range record.Chunks {
+				if _, ok := currentSlots[chunk.Key.SlotId]; ok {
+					return fmt.Errorf("%w: slot %d", ErrChunkSlotInUse, chunk.Key.SlotId)
+				}
+			}
+		}
+	}
+
+	for _, chunk := range record.Chunks {
+		if err := s.Put(ctx, &chunk.Key, &chunk.Record, chunk.Signature); err != nil {
+			return fmt.Errorf("failed to put the chunk in slot %d: %w", chunk.Key.SlotId, err)
+		}
+	}
+	return s.Put(ctx, manifestKey, &record.Manifest.Record, record.Manifest.Signature)
+}
+
+func (s *storage) Watch(ctx context.Context, address common.Address, slotIDs []uint) (<-chan Event, error) {
+	for _, slotID := range slotIDs {
+		if slotID >= s.contraints.MaxSlotsPerUser {
+			return nil, ErrSlotIdTooBig
+		}
+	}
+	wt := s.watchers.add(address, slotIDs)
+	go func() {
+		<-ctx.Done()
+		s.watchers.remove(address, wt)
+	}()
+	return wt.ch, nil
+}
+
+func (s *storage) Notify(event Event) {
+	s.watchers.notify(event)
 }
@@ -1,6 +1,7 @@
 package s4_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -235,3 +236,81 @@ func TestStorage_List(t *testing.T) {
 		}
 	}
 }
+
+func TestStorage_Watch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	ormMock, storage := setupTestStorage(t, now)
+	ormMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	privateKey, address := testutils.NewPrivateKeyAndAddress(t)
+	put := func(slotID uint, version uint64) {
+		key := &s4.Key{
+			Address: address,
+			SlotId:  slotID,
+			Version: version,
+		}
+		record := &s4.Record{
+			Payload:    []byte("foobar"),
+			Expiration: now.Add(time.Hour).UnixMilli(),
+		}
+		signature, err := s4.NewEnvelopeFromRecord(key, record).Sign(privateKey)
+		require.NoError(t, err)
+		require.NoError(t, storage.Put(testutils.Context(t), key, record, signature))
+	}
+
+	t.Run("ErrSlotIdTooBig", func(t *testing.T) {
+		_, err := storage.Watch(testutils.Context(t), address, []uint{constraints.MaxSlotsPerUser})
+		assert.ErrorIs(t, err, s4.ErrSlotIdTooBig)
+	})
+
+	t.Run("updates and confirmations", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		events, err := storage.Watch(ctx, address, []uint{1})
+		require.NoError(t, err)
+
+		put(2, 1)
+		put(1, 1)
+		confirmed := s4.Event{Type: s4.EventConfirmed, Key: s4.Key{Address: address, SlotId: 1, Version: 1}}
+		storage.Notify(confirmed)
+		// versions are confirmed once
+		storage.Notify(confirmed)
+		storage.Notify(s4.Event{Type: s4.EventConfirmed, Key: s4.Key{Address: testutils.NewAddress(), SlotId: 1, Version: 1}})
+
+		event := <-events
+		assert.Equal(t, s4.EventUpdated, event.Type)
+		assert.Equal(t, s4.Key{Address: address, SlotId: 1, Version: 1}, event.Key)
+		assert.Equal(t, now.Add(time.Hour).UnixMilli(), event.Expiration)
+		assert.Equal(t, confirmed, <-events)
+		assert.Empty(t, events)
+
+		cancel()
+		_, ok := <-events
+		assert.False(t, ok)
+	})
+
+	t.Run("all slots", func(t *testing.T) {
+		events, err := storage.Watch(testutils.Context(t), address, nil)
+		require.NoError(t, err)
+
+		put(3, 1)
+		put(4, 1)
+		assert.Equal(t, uint(3), (<-events).Key.SlotId)
+		assert.Equal(t, uint(4), (<-events).Key.SlotId)
+	})
+
+	t.Run("closed when falling behind", func(t *testing.T) {
+		events, err := storage.Watch(testutils.Context(t), address, []uint{0})
+		require.NoError(t, err)
+
+		for i := 0; i <= 100; i++ {
+			storage.Notify(s4.Event{Type: s4.EventUpdated, Key: s4.Key{Address: address, SlotId: 0, Version: uint64(i)}})
+		}
+		for i := 0; i < 100; i++ {
+			<-events
+		}
+		_, ok := <-events
+		assert.False(t, ok)
+	})
+}
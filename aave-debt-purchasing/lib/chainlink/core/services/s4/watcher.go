@@ -0,0 +1,123 @@
+package s4
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EventType is the type of a slot change.
+type EventType string
+
+const (
+	// EventUpdated is sent when a new version of a slot is stored, before it is confirmed.
+	EventUpdated EventType = "updated"
+	// EventConfirmed is sent when a version of a slot is confirmed by the DON.
+	EventConfirmed EventType = "confirmed"
+)
+
+// watchBufferSize is the number of events buffered for each watcher.
+const watchBufferSize = 100
+
+// Event is a slot change sent to its watchers.
+type Event struct {
+	Type EventType
+	// Key is the updated or confirmed version of the slot.
+	Key Key
+	// Expiration timestamp of the version (unix time in milliseconds)
+	Expiration int64
+}
+
+// Notifier sends slot changes to their watchers.
+type Notifier interface {
+	// Notify sends the event to the watchers of its slot. It never blocks.
+	Notify(event Event)
+}
+
+type watch struct {
+	// slots is nil when all slots are watched
+	slots map[uint]struct{}
+	// confirmed is the last confirmed version sent for each slot, as reports may confirm a version again
+	confirmed map[uint]uint64
+	ch        chan Event
+}
+
+// watchers keeps the watches of each address. All methods are thread-safe.
+type watchers struct {
+	mu      sync.Mutex
+	watches map[common.Address]map[*watch]struct{}
+}
+
+func newWatchers() *watchers {
+	return &watchers{
+		watches: make(map[common.Address]map[*watch]struct{}),
+	}
+}
+
+// add watches the slots of address, or all its slots if slotIDs is empty.
+func (w *watchers) add(address common.Address, slotIDs []uint) *watch {
+	wt := &watch{
+		confirmed: make(map[uint]uint64),
+		ch:        make(chan Event, watchBufferSize),
+	}
+	if len(slotIDs) > 0 {
+		wt.slots = make(map[uint]struct{}, len(slotIDs))
+		for _, slotID := range slotIDs {
+			wt.slots[slotID] = struct{}{}
+		}
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.watches[address]; !ok {
+		w.watches[address] = make(map[*watch]struct{})
+	}
+	w.watches[address][wt] = struct{}{}
+	return wt
+}
+
+// remove stops the watch and closes its channel, if it was not removed already.
+func (w *watchers) remove(address common.Address, wt *watch) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.removeLocked(address, wt)
+}
+
+func (w *watchers) removeLocked(address common.Address, wt *watch) {
+	addressWatches, ok := w.watches[address]
+	if !ok {
+		return
+	}
+	if _, ok = addressWatches[wt]; !ok {
+		return
+	}
+	delete(addressWatches, wt)
+	if len(addressWatches) == 0 {
+		delete(w.watches, address)
+	}
+	close(wt.ch)
+}
+
+func (w *watchers) notify(event Event) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for wt := range w.watches[event.Key.Address] {
+		if wt.slots != nil {
+			if _, ok := wt.slots[event.Key.SlotId]; !ok {
+				continue
+			}
+		}
+		if event.Type == EventConfirmed {
+			if version, ok := wt.confirmed[event.Key.SlotId]; ok && version >= event.Key.Version {
+				continue
+			}
+			wt.confirmed[event.Key.SlotId] = event.Key.Version
+		}
+		select {
+		case wt.ch <- event:
+		default:
+			// The watcher fell behind, and has to list the slots again to catch up
+			w.removeLocked(event.Key.Address, wt)
+		}
+	}
+}
@@ -12,14 +12,14 @@ import (
 
 // NewTestTelemetryIngressClient calls NewTelemetryIngressClient and injects telemClient.
 func NewTestTelemetryIngressClient(t *testing.T, url *url.URL, serverPubKeyHex string, csaKeyStore keystore.CSA, telemClient telemPb.TelemClient) TelemetryService {
-	tc := NewTelemetryIngressClient(url, serverPubKeyHex, csaKeyStore, logger.TestLogger(t), 100)
+	tc := NewTelemetryIngressClient(url, serverPubKeyHex, keystore.CSASigner{CSA: csaKeyStore}, logger.TestLogger(t), 100)
 	tc.(*telemetryIngressClient).telemClient = telemClient
 	return tc
 }
 
 // NewTestTelemetryIngressBatchClient calls NewTelemetryIngressBatchClient and injects telemClient.
 func NewTestTelemetryIngressBatchClient(t *testing.T, url *url.URL, serverPubKeyHex string, csaKeyStore keystore.CSA, logging bool, telemClient telemPb.TelemClient, sendInterval time.Duration, uniconn bool) TelemetryService {
-	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, csaKeyStore, logging, logger.TestLogger(t), 100, 50, sendInterval, time.Second, uniconn)
+	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, keystore.CSASigner{CSA: csaKeyStore}, logging, logger.TestLogger(t), 100, 50, sendInterval, time.Second, uniconn)
 	tc.(*telemetryIngressBatchClient).closeFn = func() error { return nil }
 	tc.(*telemetryIngressBatchClient).telemClient = telemClient
 	return tc
@@ -47,7 +47,7 @@ type telemetryIngressBatchClient struct {
 	eng *services.Engine
 
 	url             *url.URL
-	csaKeyStore     keystore.CSA
+	csaKeyStore     core.Keystore
 	csaSigner       *core.Ed25519Signer
 	serverPubKeyHex string
 
@@ -72,7 +72,7 @@ type telemetryIngressBatchClient struct {
 
 // NewTelemetryIngressBatchClient returns a client backed by wsrpc that
 // can send telemetry to the telemetry ingress server
-func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, csaKeyStore keystore.CSA, logging bool, lggr logger.Logger, telemBufferSize uint, telemMaxBatchSize uint, telemSendInterval time.Duration, telemSendTimeout time.Duration, useUniconn bool) TelemetryService {
+func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, csaKeyStore core.Keystore, logging bool, lggr logger.Logger, telemBufferSize uint, telemMaxBatchSize uint, telemSendInterval time.Duration, telemSendTimeout time.Duration, useUniconn bool) TelemetryService {
 	c := &telemetryIngressBatchClient{
 		telemBufferSize:   telemBufferSize,
 		telemMaxBatchSize: telemMaxBatchSize,
@@ -106,11 +106,8 @@ func (tc *telemetryIngressBatchClient) start(ctx context.Context) error {
 	// Initialize a new wsrpc client caller
 	// This is used to call RPC methods on the server
 	if tc.telemClient == nil { // only preset for tests
-		key, err := keystore.GetDefault(ctx, tc.csaKeyStore)
-		if err != nil {
-			return err
-		}
-		tc.csaSigner, err = core.NewEd25519Signer(key.ID(), keystore.CSASigner{CSA: tc.csaKeyStore}.Sign)
+		var err error
+		tc.csaSigner, err = keystore.NewCSAEd25519Signer(ctx, tc.csaKeyStore)
 		if err != nil {
 			return err
 		}
@@ -39,7 +39,7 @@ type telemetryIngressClient struct {
 	eng *services.Engine
 
 	url         *url.URL
-	csaKeyStore keystore.CSA
+	csaKeyStore core.Keystore
 	csaSigner   *core.Ed25519Signer
 
 	serverPubKeyHex string
@@ -53,7 +53,7 @@ type telemetryIngressClient struct {
 
 // NewTelemetryIngressClient returns a client backed by wsrpc that
 // can send telemetry to the telemetry ingress server
-func NewTelemetryIngressClient(url *url.URL, serverPubKeyHex string, csaKeyStore keystore.CSA, lggr logger.Logger, telemBufferSize uint) TelemetryService {
+func NewTelemetryIngressClient(url *url.URL, serverPubKeyHex string, csaKeyStore core.Keystore, lggr logger.Logger, telemBufferSize uint) TelemetryService {
 	c := &telemetryIngressClient{
 		url:             url,
 		csaKeyStore:     csaKeyStore,
@@ -80,11 +80,8 @@ func (tc *telemetryIngressClient) start(context.Context) error {
 	tc.eng.Go(func(ctx context.Context) {
 		conn, err := func() (*wsrpc.ClientConn, error) {
 			serverPubKey := keys.FromHex(tc.serverPubKeyHex)
-			key, err := keystore.GetDefault(ctx, tc.csaKeyStore)
-			if err != nil {
-				return nil, err
-			}
-			tc.csaSigner, err = core.NewEd25519Signer(key.ID(), keystore.CSASigner{CSA: tc.csaKeyStore}.Sign)
+			var err error
+			tc.csaSigner, err = keystore.NewCSAEd25519Signer(ctx, tc.csaKeyStore)
 			if err != nil {
 				return nil, err
 			}
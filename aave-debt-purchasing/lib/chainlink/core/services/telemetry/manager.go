@@ -11,7 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	common "github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
-	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
@@ -23,7 +23,7 @@ type Manager struct {
 
 	bufferSize uint
 	endpoints  []*telemetryEndpoint
-	ks         keystore.CSA
+	ks         core.Keystore
 
 	logging                     bool
 	maxBatchSize                uint
@@ -43,7 +43,7 @@ type telemetryEndpoint struct {
 }
 
 // NewManager create a new telemetry manager that is responsible for configuring telemetry agents and generating the defined telemetry endpoints and monitoring endpoints
-func NewManager(cfg config.TelemetryIngress, csaKeyStore keystore.CSA, lggr logger.Logger) *Manager {
+func NewManager(cfg config.TelemetryIngress, csaKeyStore core.Keystore, lggr logger.Logger) *Manager {
 	m := &Manager{
 		bufferSize:   cfg.BufferSize(),
 		ks:           csaKeyStore,
@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/config/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
 	keymocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
@@ -48,7 +49,7 @@ func TestManagerAgents(t *testing.T) {
 
 	lggr, _ := logger.TestLoggerObserved(t, zapcore.InfoLevel)
 
-	ks := keymocks.NewCSA(t)
+	ks := keystore.CSASigner{CSA: keymocks.NewCSA(t)}
 
 	tm := NewManager(tic, ks, lggr)
 	require.Equal(t, "*synchronization.telemetryIngressBatchClient", reflect.TypeOf(tm.endpoints[0].client).String())
@@ -153,10 +154,10 @@ func TestNewManager(t *testing.T) {
 	key := csakey.MustNewV2XXXTestingOnly(big.NewInt(0))
 	ks.On("GetAll").Return([]csakey.KeyV2{key}, nil)
 	ks.On("Get", key.ID()).Return(key, nil)
-	m := NewManager(tic, ks, lggr)
+	m := NewManager(tic, keystore.CSASigner{CSA: ks}, lggr)
 
 	require.Equal(t, uint(123), m.bufferSize)
-	require.Equal(t, ks, m.ks)
+	require.Equal(t, keystore.CSASigner{CSA: ks}, m.ks)
 	require.Equal(t, "TelemetryManager", m.Name())
 	require.True(t, m.logging)
 	require.Equal(t, uint(51), m.maxBatchSize)
@@ -201,7 +202,7 @@ func TestCorrectEndpointRouting(t *testing.T) {
 
 	lggr, obsLogs := logger.TestLoggerObserved(t, zapcore.InfoLevel)
 
-	ks := keymocks.NewCSA(t)
+	ks := keystore.CSASigner{CSA: keymocks.NewCSA(t)}
 	tm := NewManager(tic, ks, lggr)
 
 	type testEndpoint struct {
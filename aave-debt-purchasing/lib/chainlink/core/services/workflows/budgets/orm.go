@@ -0,0 +1,187 @@
+package budgets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ErrBudgetExceeded is returned by Check when a workflow or its owner has spent its budget.
+var ErrBudgetExceeded = errors.New("workflow budget exceeded")
+
+// Budget caps the spend of a workflow owner in one spend unit, as recorded by the metering
+// reports of the engine. A budget with an empty WorkflowID covers every workflow of the
+// owner, otherwise only the given workflow.
+type Budget struct {
+	Owner      string          `db:"owner"`
+	WorkflowID string          `db:"workflow_id"`
+	SpendUnit  string          `db:"spend_unit"`
+	Limit      decimal.Decimal `db:"spend_limit"`
+	Spent      decimal.Decimal `db:"spent"`
+	ResetAt    time.Time       `db:"reset_at"`
+	CreatedAt  time.Time       `db:"created_at"`
+	UpdatedAt  time.Time       `db:"updated_at"`
+}
+
+// Exceeded reports whether the budget has been spent.
+func (b Budget) Exceeded() bool {
+	return b.Spent.GreaterThanOrEqual(b.Limit)
+}
+
+type ORM interface {
+	// SetBudget sets the spend limit of the owner, or of the workflow if workflowID is not
+	// empty, in the given unit. The amount spent so far is kept if the budget already exists.
+	SetBudget(ctx context.Context, owner, workflowID, unit string, limit decimal.Decimal) (Budget, error)
+
+	// GetBudgets returns the budgets of owner, or of every owner if owner is empty.
+	GetBudgets(ctx context.Context, owner string) ([]Budget, error)
+
+	// DeleteBudget deletes the budget of the owner or workflow in the given unit.
+	DeleteBudget(ctx context.Context, owner, workflowID, unit string) error
+
+	// ResetBudgets sets the amount spent of the budgets of the owner or workflow back to zero,
+	// and returns the number of budgets reset.
+	ResetBudgets(ctx context.Context, owner, workflowID string) (int64, error)
+
+	// AddSpend adds spend to the budgets of the workflow and of its owner. Units without a
+	// budget are ignored.
+	AddSpend(ctx context.Context, owner, workflowID string, spend map[string]decimal.Decimal) error
+
+	// Check returns an error wrapping ErrBudgetExceeded if a budget of the workflow or of its
+	// owner has been spent.
+	Check(ctx context.Context, owner, workflowID string) error
+}
+
+type orm struct {
+	ds   sqlutil.DataSource
+	lggr logger.Logger
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource, lggr logger.Logger) *orm {
+	return &orm{
+		ds:   ds,
+		lggr: lggr.Named("WorkflowBudgets"),
+	}
+}
+
+func (orm *orm) SetBudget(ctx context.Context, owner, workflowID, unit string, limit decimal.Decimal) (Budget, error) {
+	if unit == "" {
+		return Budget{}, errors.New("spend unit must be provided")
+	}
+	if limit.IsNegative() {
+		return Budget{}, errors.New("spend limit must not be negative")
+	}
+
+	var budget Budget
+	err := orm.ds.GetContext(ctx, &budget,
+		`INSERT INTO workflow_budgets (owner, workflow_id, spend_unit, spend_limit, spent, reset_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, 0, NOW(), NOW(), NOW())
+		ON CONFLICT (owner, workflow_id, spend_unit) DO UPDATE SET
+			spend_limit = EXCLUDED.spend_limit,
+			updated_at = EXCLUDED.updated_at
+		RETURNING *`,
+		normalizeOwner(owner), workflowID, unit, limit,
+	)
+	if err != nil {
+		return Budget{}, fmt.Errorf("failed to set budget: %w", err)
+	}
+
+	return budget, nil
+}
+
+func (orm *orm) GetBudgets(ctx context.Context, owner string) ([]Budget, error) {
+	budgets := []Budget{}
+	err := orm.ds.SelectContext(ctx, &budgets,
+		`SELECT * FROM workflow_budgets
+		WHERE $1 = '' OR owner = $1
+		ORDER BY owner, workflow_id, spend_unit`,
+		normalizeOwner(owner),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budgets: %w", err)
+	}
+
+	return budgets, nil
+}
+
+func (orm *orm) DeleteBudget(ctx context.Context, owner, workflowID, unit string) error {
+	_, err := orm.ds.ExecContext(ctx,
+		`DELETE FROM workflow_budgets WHERE owner = $1 AND workflow_id = $2 AND spend_unit = $3`,
+		normalizeOwner(owner), workflowID, unit,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete budget: %w", err)
+	}
+
+	return nil
+}
+
+func (orm *orm) ResetBudgets(ctx context.Context, owner, workflowID string) (int64, error) {
+	res, err := orm.ds.ExecContext(ctx,
+		`UPDATE workflow_budgets SET spent = 0, reset_at = NOW(), updated_at = NOW()
+		WHERE owner = $1 AND workflow_id = $2`,
+		normalizeOwner(owner), workflowID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reset budgets: %w", err)
+	}
+
+	return res.RowsAffected()
+}
+
+func (orm *orm) AddSpend(ctx context.Context, owner, workflowID string, spend map[string]decimal.Decimal) error {
+	owner = normalizeOwner(owner)
+	return sqlutil.TransactDataSource(ctx, orm.ds, nil, func(tx sqlutil.DataSource) error {
+		for unit, value := range spend {
+			if value.IsZero() {
+				continue
+			}
+			_, err := tx.ExecContext(ctx,
+				`UPDATE workflow_budgets SET spent = spent + $4, updated_at = NOW()
+				WHERE owner = $1 AND workflow_id IN ('', $2) AND spend_unit = $3`,
+				owner, workflowID, unit, value,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to add spend in %s: %w", unit, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (orm *orm) Check(ctx context.Context, owner, workflowID string) error {
+	var exceeded []Budget
+	err := orm.ds.SelectContext(ctx, &exceeded,
+		`SELECT * FROM workflow_budgets
+		WHERE owner = $1 AND workflow_id IN ('', $2) AND spent >= spend_limit
+		ORDER BY workflow_id, spend_unit`,
+		normalizeOwner(owner), workflowID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to check budgets: %w", err)
+	}
+	if len(exceeded) == 0 {
+		return nil
+	}
+
+	b := exceeded[0]
+	scope := "owner"
+	if b.WorkflowID != "" {
+		scope = "workflow"
+	}
+	return fmt.Errorf("%w: %s spent %s of %s %s", ErrBudgetExceeded, scope, b.Spent, b.Limit, b.SpendUnit)
+}
+
+// normalizeOwner removes any 0x prefix, so that budgets match the owner as encoded by the engine.
+func normalizeOwner(k string) string {
+	return strings.ToLower(strings.TrimPrefix(k, "0x"))
+}
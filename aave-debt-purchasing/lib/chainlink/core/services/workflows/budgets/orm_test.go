@@ -0,0 +1,88 @@
+package budgets
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestWorkflowBudgetsORM(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+	orm := NewORM(db, logger.TestLogger(t))
+
+	const (
+		owner      = "0xABCDEF"
+		workflowID = "workflow-1"
+		otherID    = "workflow-2"
+		unit       = "COMPUTE_SECONDS"
+	)
+
+	_, err := orm.SetBudget(ctx, owner, "", unit, decimal.NewFromInt(10))
+	require.NoError(t, err)
+	budget, err := orm.SetBudget(ctx, owner, workflowID, unit, decimal.NewFromInt(4))
+	require.NoError(t, err)
+	assert.Equal(t, "abcdef", budget.Owner)
+	assert.True(t, budget.Spent.IsZero())
+
+	_, err = orm.SetBudget(ctx, owner, "", unit, decimal.NewFromInt(-1))
+	require.Error(t, err)
+
+	t.Run("spend is added to workflow and owner budgets", func(t *testing.T) {
+		require.NoError(t, orm.AddSpend(ctx, owner, workflowID, map[string]decimal.Decimal{
+			unit:         decimal.NewFromInt(3),
+			"OTHER_UNIT": decimal.NewFromInt(100),
+		}))
+		require.NoError(t, orm.Check(ctx, owner, workflowID))
+
+		budgets, err := orm.GetBudgets(ctx, "abcdef")
+		require.NoError(t, err)
+		require.Len(t, budgets, 2)
+		for _, b := range budgets {
+			assert.True(t, decimal.NewFromInt(3).Equal(b.Spent), "budget of %q", b.WorkflowID)
+		}
+	})
+
+	t.Run("workflow budget exceeded", func(t *testing.T) {
+		require.NoError(t, orm.AddSpend(ctx, owner, workflowID, map[string]decimal.Decimal{unit: decimal.NewFromInt(1)}))
+		err := orm.Check(ctx, owner, workflowID)
+		require.ErrorIs(t, err, ErrBudgetExceeded)
+		assert.ErrorContains(t, err, "workflow spent 4 of 4 COMPUTE_SECONDS")
+
+		// other workflows of the owner are only limited by the owner budget
+		require.NoError(t, orm.Check(ctx, owner, otherID))
+	})
+
+	t.Run("owner budget exceeded", func(t *testing.T) {
+		require.NoError(t, orm.AddSpend(ctx, owner, otherID, map[string]decimal.Decimal{unit: decimal.NewFromInt(6)}))
+		require.ErrorIs(t, orm.Check(ctx, owner, otherID), ErrBudgetExceeded)
+	})
+
+	t.Run("raising the limit keeps the spend", func(t *testing.T) {
+		budget, err := orm.SetBudget(ctx, owner, "", unit, decimal.NewFromInt(20))
+		require.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(10).Equal(budget.Spent))
+		require.NoError(t, orm.Check(ctx, owner, otherID))
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		n, err := orm.ResetBudgets(ctx, owner, workflowID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+		require.NoError(t, orm.Check(ctx, owner, workflowID))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, orm.DeleteBudget(ctx, owner, workflowID, unit))
+		budgets, err := orm.GetBudgets(ctx, "")
+		require.NoError(t, err)
+		require.Len(t, budgets, 1)
+		assert.Equal(t, "", budgets[0].WorkflowID)
+	})
+}
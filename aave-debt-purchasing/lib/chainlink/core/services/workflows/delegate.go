@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/platform"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/budgets"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncerlimiter"
@@ -26,6 +27,7 @@ type Delegate struct {
 	store          store.Store
 	ratelimiter    *ratelimiter.RateLimiter
 	workflowLimits *syncerlimiter.Limits
+	budgets        budgets.ORM
 }
 
 var _ job.Delegate = (*Delegate)(nil)
@@ -78,6 +80,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		SecretsFetcher: d.secretsFetcher,
 		RateLimiter:    d.ratelimiter,
 		WorkflowLimits: d.workflowLimits,
+		Budgets:        d.budgets,
 	}
 	engine, err := NewEngine(ctx, cfg)
 	if err != nil {
@@ -93,6 +96,7 @@ func NewDelegate(
 	store store.Store,
 	ratelimiter *ratelimiter.RateLimiter,
 	workflowLimits *syncerlimiter.Limits,
+	budgets budgets.ORM,
 ) *Delegate {
 	return &Delegate{
 		logger:   logger,
@@ -103,6 +107,7 @@ func NewDelegate(
 		store:          store,
 		ratelimiter:    ratelimiter,
 		workflowLimits: workflowLimits,
+		budgets:        budgets,
 	}
 }
 
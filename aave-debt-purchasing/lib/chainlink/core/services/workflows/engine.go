@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/jonboulle/clockwork"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel/metric"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/aggregation"
@@ -27,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/transmission"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/platform"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/budgets"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/pb"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
@@ -37,6 +40,7 @@ const (
 	fifteenMinutesSec            = 15 * 60
 	reservedFieldNameStepTimeout = "cre_step_timeout"
 	maxStepTimeoutOverrideSec    = 10 * 60 // 10 minutes
+	resumeExecutionsPageSize     = 100
 )
 
 var (
@@ -47,6 +51,17 @@ var (
 type stepRequest struct {
 	stepRef string
 	state   store.WorkflowExecution
+	// attempt is the number of previous attempts of the step; it's only non-zero for retries.
+	attempt int
+	// firstAttemptAt is when the first attempt of the step started; it's only set for retries.
+	firstAttemptAt time.Time
+}
+
+// triggerEvent is a response from one of the workflow's triggers, along with the ID the
+// trigger was registered with.
+type triggerEvent struct {
+	triggerID string
+	resp      capabilities.TriggerResponse
 }
 
 type stepUpdateChannel struct {
@@ -107,6 +122,7 @@ type SecretsFor func(ctx context.Context, workflowOwner, hexWorkflowName, decode
 type Engine struct {
 	services.StateMachine
 	cma                  custmsg.MessageEmitter
+	emitter              beholder.Emitter
 	metrics              workflowsMetricLabeler
 	logger               logger.Logger
 	registry             core.CapabilitiesRegistry
@@ -116,7 +132,7 @@ type Engine struct {
 	localNode            capabilities.Node
 	executionsStore      store.Store
 	pendingStepRequests  chan stepRequest
-	triggerEvents        chan capabilities.TriggerResponse
+	triggerEvents        chan triggerEvent
 	stepUpdatesChMap     stepUpdateManager
 	wg                   sync.WaitGroup
 	stopCh               services.StopChan
@@ -145,6 +161,7 @@ type Engine struct {
 	clock          clockwork.Clock
 	ratelimiter    *ratelimiter.RateLimiter
 	workflowLimits *syncerlimiter.Limits
+	budgets        budgets.ORM
 	meterReports   *MeterReports
 
 	// sendMeteringReport is a test hook to send a metering report
@@ -366,6 +383,13 @@ func (e *Engine) init(ctx context.Context) {
 
 	e.logger.Debug("capabilities resolved")
 
+	// Resume any executions that were in-flight when the node last shut down
+	// before registering triggers, so that resumed steps are queued ahead of new executions.
+	if err := e.resumeInProgressExecutions(ctx); err != nil {
+		e.logger.Errorf("failed to resume in-progress executions: %s", err)
+		logCustMsg(ctx, e.cma, fmt.Sprintf("failed to resume in-progress executions: %s", err), e.logger)
+	}
+
 	e.logger.Debug("registering triggers")
 	for idx, t := range e.workflow.triggers {
 		terr := e.registerTrigger(ctx, t, idx)
@@ -382,6 +406,81 @@ func (e *Engine) init(ctx context.Context) {
 	e.afterInit(true)
 }
 
+// resumeInProgressExecutions loads any executions of this workflow that haven't been finished yet
+// from the store and re-queues every step that is ready to run.
+//
+// Steps are only persisted once they have been executed, so any step that was in-flight when the node
+// shut down is absent from the stored state and will be executed again.
+func (e *Engine) resumeInProgressExecutions(ctx context.Context) error {
+	var unfinished []store.WorkflowExecution
+	for offset := 0; ; offset += resumeExecutionsPageSize {
+		page, err := e.executionsStore.GetUnfinished(ctx, e.workflow.id, offset, resumeExecutionsPageSize)
+		if err != nil {
+			return fmt.Errorf("failed to get unfinished executions: %w", err)
+		}
+		unfinished = append(unfinished, page...)
+		if len(page) < resumeExecutionsPageSize {
+			break
+		}
+	}
+
+	for _, execution := range unfinished {
+		if err := e.resumeExecution(ctx, execution); err != nil {
+			e.logger.With(platform.KeyWorkflowExecutionID, execution.ExecutionID).Errorf("failed to resume execution: %s", err)
+			continue
+		}
+	}
+
+	if len(unfinished) > 0 {
+		e.logger.Infof("resumed %d in-progress executions", len(unfinished))
+	}
+	return nil
+}
+
+func (e *Engine) resumeExecution(ctx context.Context, execution store.WorkflowExecution) error {
+	lggr := e.logger.With(platform.KeyWorkflowExecutionID, execution.ExecutionID)
+	cma := e.cma.With(platform.KeyWorkflowExecutionID, execution.ExecutionID)
+
+	// The node may have stopped after the last step was persisted but before the
+	// execution was marked as finished.
+	workflowIsFullyProcessed, status, err := e.isWorkflowFullyProcessed(ctx, execution)
+	if err != nil {
+		return err
+	}
+	if workflowIsFullyProcessed {
+		return e.finishExecution(ctx, cma, execution.ExecutionID, status)
+	}
+
+	ch := make(chan store.WorkflowExecutionStep)
+	added := e.stepUpdatesChMap.add(execution.ExecutionID, stepUpdateChannel{
+		ch:          ch,
+		executionID: execution.ExecutionID,
+	})
+	if !added {
+		lggr.Debug("execution is already running, skipping resume")
+		return nil
+	}
+
+	// metering reports are not persisted, so the spend of steps executed before the restart is lost.
+	e.meterReports.Add(execution.ExecutionID, NewMeteringReport())
+
+	e.wg.Add(1)
+	go e.stepUpdateLoop(ctx, execution.ExecutionID, ch, execution.CreatedAt)
+
+	lggr.Debug("resuming execution")
+	logCustMsg(ctx, cma, "execution resumed", lggr)
+
+	return e.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
+		// Skip steps which have already been processed, queueIfReady
+		// will check whether the remaining steps have all their dependencies completed.
+		if _, ok := execution.Steps[s.Ref]; ok {
+			return nil
+		}
+		e.queueIfReady(execution, s)
+		return nil
+	})
+}
+
 func generateTriggerID(workflowID string, triggerIdx int) string {
 	return fmt.Sprintf("wf_%s_trigger_%d", workflowID, triggerIdx)
 }
@@ -448,7 +547,7 @@ func (e *Engine) registerTrigger(ctx context.Context, t *triggerCapability, trig
 				select {
 				case <-e.stopCh:
 					return
-				case e.triggerEvents <- event:
+				case e.triggerEvents <- triggerEvent{triggerID: triggerID, resp: event}:
 				}
 			}
 		}
@@ -509,7 +608,7 @@ func generateExecutionID(workflowID, eventID string) (string, error) {
 func (e *Engine) startExecution(ctx context.Context, executionID string, triggerID string, event *values.Map) error {
 	e.meterReports.Add(executionID, NewMeteringReport())
 
-	err := emitExecutionStartedEvent(ctx, e.cma, triggerID)
+	err := emitExecutionStartedEvent(ctx, e.emitter, e.cma, triggerID)
 	if err != nil {
 		e.logger.Errorf("failed to emit execution started event: %+v", err)
 	}
@@ -685,7 +784,7 @@ func (e *Engine) finishExecution(ctx context.Context, cma custmsg.MessageEmitter
 	}
 	logCustMsg(ctx, cma, fmt.Sprintf("execution duration: %d (seconds)", executionDuration), l)
 	l.Infof("execution duration: %d (seconds)", executionDuration)
-	err = emitExecutionFinishedEvent(ctx, cma, status)
+	err = emitExecutionFinishedEvent(ctx, e.emitter, cma, status)
 	if err != nil {
 		e.logger.Errorf("failed to emit execution finished event: %+v", err)
 	}
@@ -703,12 +802,13 @@ func (e *Engine) worker(ctx context.Context) {
 		select {
 		case pendingStepRequest := <-e.pendingStepRequests:
 			e.workerForStepRequest(ctx, pendingStepRequest)
-		case resp, isOpen := <-e.triggerEvents:
+		case event, isOpen := <-e.triggerEvents:
 			if !isOpen {
 				e.logger.Error("trigger events channel is no longer open, skipping")
 				continue
 			}
 
+			resp := event.resp
 			if resp.Err != nil {
 				e.logger.Errorf("trigger event was an error %v; not executing", resp.Err)
 				continue
@@ -727,19 +827,25 @@ func (e *Engine) worker(ctx context.Context) {
 				continue
 			}
 
-			senderAllowed, globalAllowed := e.ratelimiter.Allow(e.workflow.owner)
-			if !senderAllowed {
+			allowed, deniedAt := e.ratelimiter.AllowKey(ratelimiter.Key{
+				Owner:      e.workflow.owner,
+				WorkflowID: e.workflow.id,
+				TriggerID:  event.triggerID,
+			})
+			if !allowed {
 				e.onRateLimit(executionID)
-				e.logger.With(platform.KeyWorkflowID, e.workflow.id, platform.KeyWorkflowOwner, e.workflow.owner, platform.KeyWorkflowExecutionID, executionID).Errorf("failed to start execution: per sender rate limit exceeded")
-				logCustMsg(ctx, e.cma.With(platform.KeyCapabilityID, te.ID), "failed to start execution: per sender rate limit exceeded", e.logger)
-				e.metrics.with(platform.KeyWorkflowID, e.workflow.id, platform.KeyWorkflowExecutionID, executionID, platform.KeyTriggerID, te.ID, platform.KeyWorkflowOwner, e.workflow.owner).incrementWorkflowExecutionRateLimitPerUserCounter(ctx)
+				msg := fmt.Sprintf("failed to start execution: %s rate limit exceeded", deniedAt)
+				e.logger.With(platform.KeyWorkflowID, e.workflow.id, platform.KeyWorkflowOwner, e.workflow.owner, platform.KeyWorkflowExecutionID, executionID).Error(msg)
+				logCustMsg(ctx, e.cma.With(platform.KeyCapabilityID, te.ID), msg, e.logger)
+				e.metrics.with(platform.KeyWorkflowID, e.workflow.id, platform.KeyWorkflowExecutionID, executionID, platform.KeyTriggerID, te.ID, platform.KeyWorkflowOwner, e.workflow.owner).incrementWorkflowExecutionRateLimitCounter(ctx, deniedAt)
 				continue
 			}
-			if !globalAllowed {
-				e.onRateLimit(executionID)
-				e.logger.With(platform.KeyWorkflowID, e.workflow.id, platform.KeyWorkflowOwner, e.workflow.owner, platform.KeyWorkflowExecutionID, executionID).Errorf("failed to start execution: global rate limit exceeded")
-				logCustMsg(ctx, e.cma.With(platform.KeyCapabilityID, te.ID), "failed to start execution: global rate limit exceeded", e.logger)
-				e.metrics.with(platform.KeyWorkflowID, e.workflow.id, platform.KeyWorkflowExecutionID, executionID, platform.KeyTriggerID, te.ID, platform.KeyWorkflowOwner, e.workflow.owner).incrementWorkflowExecutionRateLimitGlobalCounter(ctx)
+
+			if err = e.checkBudget(ctx); err != nil {
+				msg := fmt.Sprintf("failed to start execution: %s", err)
+				e.logger.With(platform.KeyWorkflowID, e.workflow.id, platform.KeyWorkflowOwner, e.workflow.owner, platform.KeyWorkflowExecutionID, executionID).Error(msg)
+				logCustMsg(ctx, e.cma.With(platform.KeyCapabilityID, te.ID), msg, e.logger)
+				e.metrics.with(platform.KeyWorkflowID, e.workflow.id, platform.KeyWorkflowExecutionID, executionID, platform.KeyTriggerID, te.ID, platform.KeyWorkflowOwner, e.workflow.owner).incrementWorkflowExecutionBudgetExceededCounter(ctx)
 				continue
 			}
 
@@ -774,9 +880,19 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 
 	logCustMsg(ctx, cma, "executing step", l)
 
-	stepExecutionStartTime := time.Now()
-	inputs, response, sErr := e.executeStep(ctx, l, msg)
-	stepExecutionDuration := time.Since(stepExecutionStartTime).Seconds()
+	stepExecutionStartTime := e.clock.Now()
+	if msg.firstAttemptAt.IsZero() {
+		msg.firstAttemptAt = stepExecutionStartTime
+	}
+	var inputs *values.Map
+	var response capabilities.CapabilityResponse
+	sErr := e.checkBudget(ctx)
+	if sErr != nil {
+		e.metrics.with(platform.KeyWorkflowID, e.workflow.id, platform.KeyWorkflowExecutionID, msg.state.ExecutionID, platform.KeyStepRef, msg.stepRef, platform.KeyWorkflowOwner, e.workflow.owner).incrementWorkflowExecutionBudgetExceededCounter(ctx)
+	} else {
+		inputs, response, sErr = e.executeStep(ctx, l, msg)
+	}
+	stepExecutionDuration := e.clock.Since(stepExecutionStartTime).Seconds()
 
 	curStepID := "UNSET"
 	curStep, verr := e.workflow.Vertex(msg.stepRef)
@@ -787,6 +903,13 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	}
 	e.metrics.with(platform.KeyCapabilityID, curStepID).updateWorkflowStepDurationHistogram(ctx, int64(stepExecutionDuration))
 
+	if sErr != nil && verr == nil && curStep.retryPolicy != nil {
+		if wait, ok := curStep.retryPolicy.nextRetry(l, msg.attempt, e.clock.Since(msg.firstAttemptAt), sErr); ok {
+			e.retryStep(ctx, msg, wait)
+			return
+		}
+	}
+
 	var stepStatus string
 	switch {
 	case sErr != nil && capabilities.ErrStopExecution.Is(sErr):
@@ -833,6 +956,16 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 		l.Warnf("no metering report found for %v", msg.state.ExecutionID)
 	}
 
+	if e.budgets != nil && len(meteringSteps) > 0 {
+		spend := map[string]decimal.Decimal{}
+		for unit, value := range medianSpend(meteringSteps) {
+			spend[unit.String()] = value.Decimal()
+		}
+		if err := e.budgets.AddSpend(ctx, e.workflow.owner, e.workflow.id, spend); err != nil {
+			l.Errorf("failed to add step spend to budgets: %s", err)
+		}
+	}
+
 	stepState.Status = stepStatus
 	stepState.Outputs.Value = response.Value
 	stepState.Outputs.Err = sErr
@@ -948,6 +1081,20 @@ func (e *Engine) configForStep(ctx context.Context, lggr logger.Logger, step *st
 	return merge(config, capConfig), nil
 }
 
+// checkBudget returns an error wrapping budgets.ErrBudgetExceeded if the workflow or its owner
+// has spent a budget. Budgets that cannot be read do not block the workflow.
+func (e *Engine) checkBudget(ctx context.Context) error {
+	if e.budgets == nil {
+		return nil
+	}
+	err := e.budgets.Check(ctx, e.workflow.owner, e.workflow.id)
+	if err != nil && !errors.Is(err, budgets.ErrBudgetExceeded) {
+		e.logger.Errorf("failed to check workflow budgets: %s", err)
+		return nil
+	}
+	return err
+}
+
 // executeStep executes the referenced capability within a step and returns the result.
 func (e *Engine) executeStep(ctx context.Context, lggr logger.Logger, msg stepRequest) (*values.Map, capabilities.CapabilityResponse, error) {
 	curStep, err := e.workflow.Vertex(msg.stepRef)
@@ -1010,10 +1157,13 @@ func (e *Engine) executeStep(ctx context.Context, lggr logger.Logger, msg stepRe
 	defer cancel()
 
 	e.metrics.with(platform.KeyCapabilityID, curStep.ID).incrementCapabilityInvocationCounter(ctx)
-	err = emitCapabilityStartedEvent(ctx, e.cma, curStep.ID, msg.stepRef)
+	err = emitCapabilityStartedEvent(ctx, e.emitter, e.cma, curStep.ID, msg.stepRef)
 	if err != nil {
 		e.logger.Errorf("failed to emit capability event: %v", err)
 	}
+	if msg.attempt > 0 {
+		e.metrics.with(platform.KeyStepRef, msg.stepRef, platform.KeyCapabilityID, curStep.ID).incrementCapabilityRetryCounter(ctx)
+	}
 	output, capErr := curStep.capability.Execute(stepCtx, tr)
 	status := store.StatusCompleted
 
@@ -1025,7 +1175,7 @@ func (e *Engine) executeStep(ctx context.Context, lggr logger.Logger, msg stepRe
 	}
 
 	defer func() {
-		if err := emitCapabilityFinishedEvent(ctx, e.cma, curStep.ID, msg.stepRef, status); err != nil {
+		if err := emitCapabilityFinishedEvent(ctx, e.emitter, e.cma, curStep.ID, msg.stepRef, status); err != nil {
 			e.logger.Errorf("failed to emit capability event: %v", err)
 		}
 	}()
@@ -1038,6 +1188,18 @@ func (e *Engine) executeStep(ctx context.Context, lggr logger.Logger, msg stepRe
 	return inputsMap, output, nil
 }
 
+// retryStep re-enqueues a failed step once wait has passed, leaving the worker free to handle other
+// steps in the meantime. The retry is dropped if the engine is shutting down.
+func (e *Engine) retryStep(ctx context.Context, msg stepRequest, wait time.Duration) {
+	msg.attempt++
+	e.clock.AfterFunc(wait, func() {
+		select {
+		case e.pendingStepRequests <- msg:
+		case <-ctx.Done():
+		}
+	})
+}
+
 func (e *Engine) deregisterTrigger(ctx context.Context, t *triggerCapability, triggerIdx int) error {
 	deregRequest := capabilities.TriggerRegistrationRequest{
 		Metadata: capabilities.RequestMetadata{
@@ -1281,6 +1443,16 @@ type Config struct {
 	// running globally and per workflow owner.
 	WorkflowLimits *syncerlimiter.Limits
 
+	// Budgets caps the metered spend of the workflow and its owner. Executions and their
+	// steps are rejected once a budget is spent. Budgets are not enforced if nil.
+	Budgets budgets.ORM
+
+	// Telemetry of the engine, defaulting to the global beholder client.
+	// Replays use no-op ones to keep their telemetry out of production.
+	messageEmitter custmsg.MessageEmitter
+	emitter        beholder.Emitter
+	meter          metric.Meter
+
 	// For testing purposes only
 	maxRetries          int
 	retryMs             int
@@ -1357,6 +1529,18 @@ func NewEngine(ctx context.Context, cfg Config) (engine *Engine, err error) {
 		cfg.sendMeteringReport = func(*MeteringReport, string, string, string) {}
 	}
 
+	if cfg.messageEmitter == nil {
+		cfg.messageEmitter = custmsg.NewLabeler()
+	}
+
+	if cfg.emitter == nil {
+		cfg.emitter = beholder.GetEmitter()
+	}
+
+	if cfg.meter == nil {
+		cfg.meter = beholder.GetMeter()
+	}
+
 	if cfg.RateLimiter == nil {
 		return nil, &workflowError{reason: "ratelimiter must be provided",
 			labels: map[string]string{
@@ -1382,7 +1566,7 @@ func NewEngine(ctx context.Context, cfg Config) (engine *Engine, err error) {
 	// - etc.
 
 	// spin up monitoring resources
-	em, err := initMonitoringResources()
+	em, err := initMonitoringResources(cfg.meter)
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize monitoring resources: %w", err)
 	}
@@ -1391,7 +1575,7 @@ func NewEngine(ctx context.Context, cfg Config) (engine *Engine, err error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not get local node state: %w", err)
 	}
-	cma := custmsg.NewLabeler().With(platform.KeyWorkflowID, cfg.WorkflowID,
+	cma := cfg.messageEmitter.With(platform.KeyWorkflowID, cfg.WorkflowID,
 		platform.KeyWorkflowOwner, cfg.WorkflowOwner,
 		platform.KeyWorkflowName, cfg.WorkflowName.String(),
 		platform.KeyDonID, strconv.Itoa(int(nodeState.WorkflowDON.ID)),
@@ -1415,6 +1599,7 @@ func NewEngine(ctx context.Context, cfg Config) (engine *Engine, err error) {
 
 	engine = &Engine{
 		cma:            cma,
+		emitter:        cfg.emitter,
 		logger:         cfg.Lggr.Named("WorkflowEngine").With("workflowID", cfg.WorkflowID),
 		metrics:        workflowsMetricLabeler{metrics.NewLabeler().With(platform.KeyWorkflowID, cfg.WorkflowID, platform.KeyWorkflowOwner, cfg.WorkflowOwner, platform.KeyWorkflowName, cfg.WorkflowName.String()), *em},
 		registry:       cfg.Registry,
@@ -1427,7 +1612,7 @@ func NewEngine(ctx context.Context, cfg Config) (engine *Engine, err error) {
 		executionsStore:      cfg.Store,
 		pendingStepRequests:  make(chan stepRequest, cfg.QueueSize),
 		stepUpdatesChMap:     stepUpdateManager{m: map[string]stepUpdateChannel{}},
-		triggerEvents:        make(chan capabilities.TriggerResponse),
+		triggerEvents:        make(chan triggerEvent),
 		stopCh:               make(chan struct{}),
 		newWorkerTimeout:     cfg.NewWorkerTimeout,
 		stepTimeoutDuration:  cfg.StepTimeout,
@@ -1442,6 +1627,7 @@ func NewEngine(ctx context.Context, cfg Config) (engine *Engine, err error) {
 		clock:                cfg.clock,
 		ratelimiter:          cfg.RateLimiter,
 		workflowLimits:       cfg.WorkflowLimits,
+		budgets:              cfg.Budgets,
 		meterReports:         NewMeterReports(),
 		sendMeteringReport:   cfg.sendMeteringReport,
 	}
@@ -1525,7 +1711,7 @@ func buildWorkflowMetadata(kvs map[string]string) *pb.WorkflowMetadata {
 }
 
 // emitProtoMessage marshals a proto.Message and emits it via beholder.
-func emitProtoMessage(ctx context.Context, msg proto.Message) error {
+func emitProtoMessage(ctx context.Context, emitter beholder.Emitter, msg proto.Message) error {
 	b, err := proto.Marshal(msg)
 	if err != nil {
 		return err
@@ -1553,13 +1739,13 @@ func emitProtoMessage(ctx context.Context, msg proto.Message) error {
 	// entity must be prefixed with the proto package name
 	entity = fmt.Sprintf("%s.%s", EventsProtoPkg, entity)
 
-	return beholder.GetEmitter().Emit(ctx, b,
+	return emitter.Emit(ctx, b,
 		"beholder_data_schema", schema, // required
 		"beholder_domain", "platform", // required
 		"beholder_entity", entity) // required
 }
 
-func emitExecutionStartedEvent(ctx context.Context, cma custmsg.MessageEmitter, triggerID string) error {
+func emitExecutionStartedEvent(ctx context.Context, emitter beholder.Emitter, cma custmsg.MessageEmitter, triggerID string) error {
 	metadata := buildWorkflowMetadata(cma.Labels())
 
 	event := &pb.WorkflowExecutionStarted{
@@ -1568,10 +1754,10 @@ func emitExecutionStartedEvent(ctx context.Context, cma custmsg.MessageEmitter,
 		TriggerID: triggerID,
 	}
 
-	return emitProtoMessage(ctx, event)
+	return emitProtoMessage(ctx, emitter, event)
 }
 
-func emitExecutionFinishedEvent(ctx context.Context, cma custmsg.MessageEmitter, status string) error {
+func emitExecutionFinishedEvent(ctx context.Context, emitter beholder.Emitter, cma custmsg.MessageEmitter, status string) error {
 	metadata := buildWorkflowMetadata(cma.Labels())
 
 	event := &pb.WorkflowExecutionFinished{
@@ -1580,10 +1766,10 @@ func emitExecutionFinishedEvent(ctx context.Context, cma custmsg.MessageEmitter,
 		Status:    status,
 	}
 
-	return emitProtoMessage(ctx, event)
+	return emitProtoMessage(ctx, emitter, event)
 }
 
-func emitCapabilityStartedEvent(ctx context.Context, cma custmsg.MessageEmitter, capabilityID, stepRef string) error {
+func emitCapabilityStartedEvent(ctx context.Context, emitter beholder.Emitter, cma custmsg.MessageEmitter, capabilityID, stepRef string) error {
 	metadata := buildWorkflowMetadata(cma.Labels())
 
 	event := &pb.CapabilityExecutionStarted{
@@ -1593,10 +1779,10 @@ func emitCapabilityStartedEvent(ctx context.Context, cma custmsg.MessageEmitter,
 		StepRef:      stepRef,
 	}
 
-	return emitProtoMessage(ctx, event)
+	return emitProtoMessage(ctx, emitter, event)
 }
 
-func emitCapabilityFinishedEvent(ctx context.Context, cma custmsg.MessageEmitter, capabilityID, stepRef, status string) error {
+func emitCapabilityFinishedEvent(ctx context.Context, emitter beholder.Emitter, cma custmsg.MessageEmitter, capabilityID, stepRef, status string) error {
 	metadata := buildWorkflowMetadata(cma.Labels())
 
 	event := &pb.CapabilityExecutionFinished{
@@ -1607,5 +1793,5 @@ func emitCapabilityFinishedEvent(ctx context.Context, cma custmsg.MessageEmitter
 		Status:       status,
 	}
 
-	return emitProtoMessage(ctx, event)
+	return emitProtoMessage(ctx, emitter, event)
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand/v2"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -40,6 +41,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
 	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/budgets"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncerlimiter"
@@ -673,6 +675,47 @@ func TestEngine_RateLimit(t *testing.T) {
 		}
 	})
 
+	t.Run("per workflow rate limit", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		reg := coreCap.NewRegistry(lggr)
+
+		trigger, _ := mockTrigger(t)
+		require.NoError(t, reg.Add(ctx, trigger))
+		require.NoError(t, reg.Add(ctx, mockConsensus("")))
+		require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+		setRateLimiter := func(c *Config) {
+			rl, err := ratelimiter.NewRateLimiter(ratelimiter.Config{
+				GlobalRPS:        100.0,
+				GlobalBurst:      100,
+				PerSenderRPS:     100.0,
+				PerSenderBurst:   100,
+				PerWorkflowRPS:   1.0,
+				PerWorkflowBurst: 1,
+			})
+			require.NoError(t, err)
+			c.RateLimiter = rl
+		}
+
+		eng, testHooks := newTestEngineWithYAMLSpec(
+			t,
+			reg,
+			simpleWorkflow,
+			setRateLimiter,
+		)
+
+		// Call RateLimiter once for the workflow, so next execution gets blocked by the per workflow limit
+		allowed, _ := eng.ratelimiter.AllowKey(ratelimiter.Key{Owner: "some other owner", WorkflowID: eng.workflow.id})
+		require.True(t, allowed)
+		servicetest.Run(t, eng)
+
+		select {
+		case <-testHooks.rateLimited:
+		case <-ctx.Done():
+			t.FailNow()
+		}
+	})
+
 	t.Run("global workflow limit", func(t *testing.T) {
 		ctx := testutils.Context(t)
 		reg := coreCap.NewRegistry(logger.TestLogger(t))
@@ -1005,6 +1048,154 @@ func TestEngine_MultiStepDependencies(t *testing.T) {
 	assert.Equal(t, obs.([]any)[1], o)
 }
 
+func TestEngine_ResumesInProgressExecutions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, tr := mockTrigger(t)
+	// Don't fire any new trigger events; the only execution should be the resumed one.
+	trigger.(*mockTriggerCapability).triggerEvent = nil
+	require.NoError(t, reg.Add(ctx, trigger))
+
+	consensus := mockConsensus("")
+	require.NoError(t, reg.Add(ctx, consensus))
+	target := mockTarget("")
+	require.NoError(t, reg.Add(ctx, target))
+
+	clock := clockwork.NewFakeClock()
+	executionsStore := store.NewInMemoryStore(logger.TestLogger(t), clock)
+	consensusOutputs, err := values.NewMap(map[string]any{"report": tr.Event.Outputs})
+	require.NoError(t, err)
+
+	// Simulate an execution that was interrupted after the consensus step completed.
+	executionID := "resumed-execution"
+	_, err = executionsStore.Add(ctx, map[string]*store.WorkflowExecutionStep{
+		workflows.KeywordTrigger: {
+			ExecutionID: executionID,
+			Ref:         workflows.KeywordTrigger,
+			Status:      store.StatusCompleted,
+			Outputs:     store.StepOutput{Value: tr.Event.Outputs},
+		},
+		"evm_median": {
+			ExecutionID: executionID,
+			Ref:         "evm_median",
+			Status:      store.StatusCompleted,
+			Outputs:     store.StepOutput{Value: consensusOutputs},
+		},
+	}, executionID, testWorkflowID, store.StatusStarted)
+	require.NoError(t, err)
+
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		c.Store = executionsStore
+		c.clock = clock
+	})
+	servicetest.Run(t, eng)
+
+	eid := getExecutionID(t, eng, hooks)
+	assert.Equal(t, executionID, eid)
+
+	state, err := eng.executionsStore.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+
+	resp := <-target.response
+	assert.Equal(t, tr.Event.Outputs, resp.Value)
+	// The consensus step was already completed and must not be executed again.
+	assert.Empty(t, consensus.response)
+}
+
+const retryingWorkflow = `
+triggers:
+  - id: "mercury-trigger@1.0.0"
+    config:
+      feedlist:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000" # ETHUSD
+
+consensus:
+  - id: "offchain_reporting@1.0.0"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+      encoder: "EVM"
+
+targets:
+  - id: "write_polygon-testnet-mumbai@1.0.0"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+      cre_retry_policy:
+        backoff: exponential
+        initial_interval: 10ms
+        max_interval: 50ms
+        max_retries: 3
+        retryable_errors: ["unavailable"]
+`
+
+func TestEngine_RetriesStepsAccordingToRetryPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+
+	var attempts int
+	target := newMockCapability(
+		capabilities.MustNewCapabilityInfo(
+			"write_polygon-testnet-mumbai@1.0.0",
+			capabilities.CapabilityTypeTarget,
+			"a write capability targeting polygon mumbai testnet",
+		),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			attempts++
+			if attempts < 3 {
+				return capabilities.CapabilityResponse{}, errors.New("failed to send request: dispatcher not ready")
+			}
+			return capabilities.CapabilityResponse{Value: req.Inputs.Underlying["report"]}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, target))
+
+	clock := clockwork.NewFakeClock()
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, retryingWorkflow, func(c *Config) {
+		c.Store = store.NewInMemoryStore(logger.TestLogger(t), clock)
+		c.clock = clock
+	})
+	servicetest.Run(t, eng)
+
+	// Failed attempts are re-enqueued once the backoff has passed on the engine's clock.
+	for i := 0; i < 2; i++ {
+		clock.BlockUntil(1)
+		clock.Advance(time.Second)
+	}
+
+	eid := getExecutionID(t, eng, hooks)
+	state, err := eng.executionsStore.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestEngine_RejectsInvalidRetryPolicy(t *testing.T) {
+	t.Parallel()
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	sdkSpec, err := (&job.WorkflowSpec{
+		Workflow: strings.Replace(retryingWorkflow, "backoff: exponential", "backoff: linear", 1),
+		SpecType: job.YamlSpec,
+	}).SDKSpec(testutils.Context(t))
+	require.NoError(t, err)
+
+	_, _, err = newTestEngine(t, reg, sdkSpec)
+	require.ErrorContains(t, err, "invalid retry policy")
+}
+
 const (
 	delayedWorkflow = `
 triggers:
@@ -2286,3 +2477,82 @@ func TestEngine_ConcurrentExecutions(t *testing.T) {
 	assert.Equal(t, 1, beholderTester.Len(t, platform.KeyWorkflowExecutionID, eid))
 	assert.Equal(t, 1, beholderTester.Len(t, platform.KeyWorkflowExecutionID, eid2))
 }
+
+// testBudgets is a budgets.ORM with a single budget of the test workflow, in memory.
+type testBudgets struct {
+	budgets.ORM
+	limit decimal.Decimal
+
+	mu    sync.Mutex
+	spent decimal.Decimal
+}
+
+func (b *testBudgets) AddSpend(_ context.Context, _, _ string, spend map[string]decimal.Decimal) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.spent = b.spent.Add(spend["COMPUTE_UNITS"])
+	return nil
+}
+
+func (b *testBudgets) Check(_ context.Context, _, _ string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.spent.GreaterThanOrEqual(b.limit) {
+		return fmt.Errorf("%w: spent %s", budgets.ErrBudgetExceeded, b.spent)
+	}
+	return nil
+}
+
+func TestEngine_Budgets(t *testing.T) {
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+
+	consensus := newMockCapability(
+		capabilities.MustNewCapabilityInfo(
+			"offchain_reporting@1.0.0",
+			capabilities.CapabilityTypeConsensus,
+			"an ocr3 consensus capability",
+		),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			obs := req.Inputs.Underlying["observations"].(*values.List)
+			rv, err := values.NewMap(map[string]any{"report": obs.Underlying[0]})
+			if err != nil {
+				return capabilities.CapabilityResponse{}, err
+			}
+			return capabilities.CapabilityResponse{
+				Value: rv,
+				Metadata: capabilities.ResponseMetadata{
+					Metering: []capabilities.MeteringNodeDetail{
+						{Peer2PeerID: "a", SpendUnit: "COMPUTE_UNITS", SpendValue: "1"},
+						{Peer2PeerID: "b", SpendUnit: "COMPUTE_UNITS", SpendValue: "2"},
+						{Peer2PeerID: "c", SpendUnit: "COMPUTE_UNITS", SpendValue: "100"},
+					},
+				},
+			}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, consensus))
+	target := mockTarget("")
+	require.NoError(t, reg.Add(ctx, target))
+
+	// the median spend of the consensus step uses up the budget, so the target step is rejected
+	b := &testBudgets{limit: decimal.NewFromInt(2)}
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		c.Budgets = b
+	})
+	servicetest.Run(t, eng)
+
+	eid := getExecutionID(t, eng, hooks)
+	state, err := eng.executionsStore.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusErrored, state.Status)
+	assert.True(t, decimal.NewFromInt(2).Equal(b.spent))
+	assert.Empty(t, target.response)
+
+	targetStep := state.Steps["write_polygon-testnet-mumbai@1.0.0"]
+	require.NotNil(t, targetStep)
+	require.ErrorIs(t, targetStep.Outputs.Err, budgets.ErrBudgetExceeded)
+}
@@ -70,6 +70,10 @@ func (v MeteringSpendValue) GreaterThan(value MeteringSpendValue) bool {
 	return v.value.GreaterThan(value.value)
 }
 
+func (v MeteringSpendValue) Decimal() decimal.Decimal {
+	return v.value
+}
+
 func (v MeteringSpendValue) String() string {
 	return v.value.StringFixedBank(int32(v.roundingPlace))
 }
@@ -101,18 +105,26 @@ func (r *MeteringReport) MedianSpend() map[MeteringSpendUnit]MeteringSpendValue
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
+	steps := []MeteringReportStep{}
+	for _, nodeVals := range r.steps {
+		steps = append(steps, nodeVals...)
+	}
+
+	return medianSpend(steps)
+}
+
+// medianSpend returns the median of the spend values of steps, per spend unit.
+func medianSpend(steps []MeteringReportStep) map[MeteringSpendUnit]MeteringSpendValue {
 	values := map[MeteringSpendUnit][]MeteringSpendValue{}
 	medians := map[MeteringSpendUnit]MeteringSpendValue{}
 
-	for _, nodeVals := range r.steps {
-		for _, step := range nodeVals {
-			vals, ok := values[step.SpendUnit]
-			if !ok {
-				vals = []MeteringSpendValue{}
-			}
-
-			values[step.SpendUnit] = append(vals, step.SpendValue)
+	for _, step := range steps {
+		vals, ok := values[step.SpendUnit]
+		if !ok {
+			vals = []MeteringSpendValue{}
 		}
+
+		values[step.SpendUnit] = append(vals, step.SpendValue)
 	}
 
 	for unit, set := range values {
@@ -110,6 +110,9 @@ type step struct {
 	capability capabilities.ExecutableCapability
 	info       capabilities.CapabilityInfo
 	config     *values.Map
+
+	// retryPolicy is the policy declared in the step's config; nil if the step is never retried.
+	retryPolicy *retryPolicy
 }
 
 type triggerCapability struct {
@@ -169,7 +172,11 @@ func createWorkflow(wf2 *workflows.DependencyGraph) (*workflow, error) {
 		if innerErr != nil {
 			return nil, fmt.Errorf("failed to retrieve vertex for %s: %w", vertexRef, innerErr)
 		}
-		innerErr = g.AddVertex(&step{Vertex: *v})
+		rp, innerErr := parseRetryPolicy(v.Config)
+		if innerErr != nil {
+			return nil, fmt.Errorf("invalid retry policy for step %s: %w", vertexRef, innerErr)
+		}
+		innerErr = g.AddVertex(&step{Vertex: *v, retryPolicy: rp})
 		if innerErr != nil {
 			return nil, fmt.Errorf("failed to add vertex to executable workflow %s: %w", vertexRef, innerErr)
 		}
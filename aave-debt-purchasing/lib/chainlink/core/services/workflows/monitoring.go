@@ -7,29 +7,33 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 
-	"github.com/smartcontractkit/chainlink-common/pkg/beholder"
 	"github.com/smartcontractkit/chainlink-common/pkg/metrics"
 
 	monutils "github.com/smartcontractkit/chainlink/v2/core/monitoring"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
 )
 
 // em AKA "engine metrics" is to locally scope these instruments to avoid
 // data races in testing
 type engineMetrics struct {
-	registerTriggerFailureCounter            metric.Int64Counter
-	triggerWorkflowStarterErrorCounter       metric.Int64Counter
-	workflowsRunningGauge                    metric.Int64Gauge
-	capabilityInvocationCounter              metric.Int64Counter
-	capabilityFailureCounter                 metric.Int64Counter
-	workflowRegisteredCounter                metric.Int64Counter
-	workflowUnregisteredCounter              metric.Int64Counter
-	workflowExecutionRateLimitGlobalCounter  metric.Int64Counter
-	workflowExecutionRateLimitPerUserCounter metric.Int64Counter
-	workflowLimitGlobalCounter               metric.Int64Counter
-	workflowLimitPerOwnerCounter             metric.Int64Counter
-	workflowExecutionLatencyGauge            metric.Int64Gauge // ms
-	workflowStepErrorCounter                 metric.Int64Counter
-	workflowInitializationCounter            metric.Int64Counter
+	registerTriggerFailureCounter                metric.Int64Counter
+	triggerWorkflowStarterErrorCounter           metric.Int64Counter
+	workflowsRunningGauge                        metric.Int64Gauge
+	capabilityInvocationCounter                  metric.Int64Counter
+	capabilityFailureCounter                     metric.Int64Counter
+	capabilityRetryCounter                       metric.Int64Counter
+	workflowRegisteredCounter                    metric.Int64Counter
+	workflowUnregisteredCounter                  metric.Int64Counter
+	workflowExecutionRateLimitGlobalCounter      metric.Int64Counter
+	workflowExecutionRateLimitPerUserCounter     metric.Int64Counter
+	workflowExecutionRateLimitPerWorkflowCounter metric.Int64Counter
+	workflowExecutionRateLimitPerTriggerCounter  metric.Int64Counter
+	workflowExecutionBudgetExceededCounter       metric.Int64Counter
+	workflowLimitGlobalCounter                   metric.Int64Counter
+	workflowLimitPerOwnerCounter                 metric.Int64Counter
+	workflowExecutionLatencyGauge                metric.Int64Gauge // ms
+	workflowStepErrorCounter                     metric.Int64Counter
+	workflowInitializationCounter                metric.Int64Counter
 
 	// Deprecated: use the gauge instead
 	engineHeartbeatCounter metric.Int64Counter
@@ -43,93 +47,113 @@ type engineMetrics struct {
 	workflowMissingMeteringReport    metric.Int64Counter
 }
 
-func initMonitoringResources() (em *engineMetrics, err error) {
+func initMonitoringResources(meter metric.Meter) (em *engineMetrics, err error) {
 	em = &engineMetrics{}
 
-	em.workflowExecutionRateLimitGlobalCounter, err = beholder.GetMeter().Int64Counter("platform_engine_execution_ratelimit_global")
+	em.workflowExecutionRateLimitGlobalCounter, err = meter.Int64Counter("platform_engine_execution_ratelimit_global")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register execution rate limit global counter: %w", err)
 	}
 
-	em.workflowExecutionRateLimitPerUserCounter, err = beholder.GetMeter().Int64Counter("platform_engine_execution_ratelimit_peruser")
+	em.workflowExecutionRateLimitPerUserCounter, err = meter.Int64Counter("platform_engine_execution_ratelimit_peruser")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register execution rate limit per user counter: %w", err)
 	}
 
-	em.workflowLimitGlobalCounter, err = beholder.GetMeter().Int64Counter("platform_engine_limit_global")
+	em.workflowExecutionRateLimitPerWorkflowCounter, err = meter.Int64Counter("platform_engine_execution_ratelimit_perworkflow")
+	if err != nil {
+		return nil, fmt.Errorf("failed to register execution rate limit per workflow counter: %w", err)
+	}
+
+	em.workflowExecutionRateLimitPerTriggerCounter, err = meter.Int64Counter("platform_engine_execution_ratelimit_pertrigger")
+	if err != nil {
+		return nil, fmt.Errorf("failed to register execution rate limit per trigger counter: %w", err)
+	}
+
+	em.workflowExecutionBudgetExceededCounter, err = meter.Int64Counter("platform_engine_execution_budget_exceeded")
+	if err != nil {
+		return nil, fmt.Errorf("failed to register execution budget exceeded counter: %w", err)
+	}
+
+	em.workflowLimitGlobalCounter, err = meter.Int64Counter("platform_engine_limit_global")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register execution limit global counter: %w", err)
 	}
 
-	em.workflowLimitPerOwnerCounter, err = beholder.GetMeter().Int64Counter("platform_engine_limit_perowner")
+	em.workflowLimitPerOwnerCounter, err = meter.Int64Counter("platform_engine_limit_perowner")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register execution limit per owner counter: %w", err)
 	}
 
-	em.registerTriggerFailureCounter, err = beholder.GetMeter().Int64Counter("platform_engine_registertrigger_failures")
+	em.registerTriggerFailureCounter, err = meter.Int64Counter("platform_engine_registertrigger_failures")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register trigger failure counter: %w", err)
 	}
 
-	em.triggerWorkflowStarterErrorCounter, err = beholder.GetMeter().Int64Counter("platform_engine_triggerworkflow_starter_errors")
+	em.triggerWorkflowStarterErrorCounter, err = meter.Int64Counter("platform_engine_triggerworkflow_starter_errors")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register trigger workflow starter error counter: %w", err)
 	}
 
-	em.workflowsRunningGauge, err = beholder.GetMeter().Int64Gauge("platform_engine_workflow_count")
+	em.workflowsRunningGauge, err = meter.Int64Gauge("platform_engine_workflow_count")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register workflows running gauge: %w", err)
 	}
 
-	em.capabilityInvocationCounter, err = beholder.GetMeter().Int64Counter("platform_engine_capabilities_count")
+	em.capabilityInvocationCounter, err = meter.Int64Counter("platform_engine_capabilities_count")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register capability invocation counter: %w", err)
 	}
 
-	em.capabilityFailureCounter, err = beholder.GetMeter().Int64Counter("platform_engine_capabilities_failures")
+	em.capabilityFailureCounter, err = meter.Int64Counter("platform_engine_capabilities_failures")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register capability failure counter: %w", err)
 	}
 
-	em.workflowRegisteredCounter, err = beholder.GetMeter().Int64Counter("platform_engine_workflow_registered_count")
+	em.capabilityRetryCounter, err = meter.Int64Counter("platform_engine_capabilities_retries")
+	if err != nil {
+		return nil, fmt.Errorf("failed to register capability retry counter: %w", err)
+	}
+
+	em.workflowRegisteredCounter, err = meter.Int64Counter("platform_engine_workflow_registered_count")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register workflow registered counter: %w", err)
 	}
 
-	em.workflowUnregisteredCounter, err = beholder.GetMeter().Int64Counter("platform_engine_workflow_unregistered_count")
+	em.workflowUnregisteredCounter, err = meter.Int64Counter("platform_engine_workflow_unregistered_count")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register workflow unregistered counter: %w", err)
 	}
 
-	em.workflowExecutionLatencyGauge, err = beholder.GetMeter().Int64Gauge(
+	em.workflowExecutionLatencyGauge, err = meter.Int64Gauge(
 		"platform_engine_workflow_time",
 		metric.WithUnit("ms"))
 	if err != nil {
 		return nil, fmt.Errorf("failed to register workflow execution latency gauge: %w", err)
 	}
 
-	em.workflowInitializationCounter, err = beholder.GetMeter().Int64Counter("platform_engine_workflow_initializations")
+	em.workflowInitializationCounter, err = meter.Int64Counter("platform_engine_workflow_initializations")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register workflow initialization counter: %w", err)
 	}
 
-	em.workflowStepErrorCounter, err = beholder.GetMeter().Int64Counter("platform_engine_workflow_errors")
+	em.workflowStepErrorCounter, err = meter.Int64Counter("platform_engine_workflow_errors")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register workflow step error counter: %w", err)
 	}
 
 	// Deprecated: use the gauge below
-	em.engineHeartbeatCounter, err = beholder.GetMeter().Int64Counter("platform_engine_heartbeat")
+	em.engineHeartbeatCounter, err = meter.Int64Counter("platform_engine_heartbeat")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register engine heartbeat counter: %w", err)
 	}
 
-	em.engineHeartbeatGauge, err = beholder.GetMeter().Int64Gauge("platform_engine_workflow_heartbeat")
+	em.engineHeartbeatGauge, err = meter.Int64Gauge("platform_engine_workflow_heartbeat")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register engine heartbeat gauge: %w", err)
 	}
 
-	em.workflowCompletedDurationSeconds, err = beholder.GetMeter().Int64Histogram(
+	em.workflowCompletedDurationSeconds, err = meter.Int64Histogram(
 		"platform_engine_workflow_completed_time_seconds",
 		metric.WithDescription("Distribution of completed execution latencies"),
 		metric.WithUnit("seconds"))
@@ -137,7 +161,7 @@ func initMonitoringResources() (em *engineMetrics, err error) {
 		return nil, fmt.Errorf("failed to register completed duration histogram: %w", err)
 	}
 
-	em.workflowEarlyExitDurationSeconds, err = beholder.GetMeter().Int64Histogram(
+	em.workflowEarlyExitDurationSeconds, err = meter.Int64Histogram(
 		"platform_engine_workflow_earlyexit_time_seconds",
 		metric.WithDescription("Distribution of earlyexit execution latencies"),
 		metric.WithUnit("seconds"))
@@ -145,7 +169,7 @@ func initMonitoringResources() (em *engineMetrics, err error) {
 		return nil, fmt.Errorf("failed to register early exit duration histogram: %w", err)
 	}
 
-	em.workflowErrorDurationSeconds, err = beholder.GetMeter().Int64Histogram(
+	em.workflowErrorDurationSeconds, err = meter.Int64Histogram(
 		"platform_engine_workflow_error_time_seconds",
 		metric.WithDescription("Distribution of error execution latencies"),
 		metric.WithUnit("seconds"))
@@ -153,7 +177,7 @@ func initMonitoringResources() (em *engineMetrics, err error) {
 		return nil, fmt.Errorf("failed to register error duration histogram: %w", err)
 	}
 
-	em.workflowTimeoutDurationSeconds, err = beholder.GetMeter().Int64Histogram(
+	em.workflowTimeoutDurationSeconds, err = meter.Int64Histogram(
 		"platform_engine_workflow_timeout_time_seconds",
 		metric.WithDescription("Distribution of timeout execution latencies"),
 		metric.WithUnit("seconds"))
@@ -161,7 +185,7 @@ func initMonitoringResources() (em *engineMetrics, err error) {
 		return nil, fmt.Errorf("failed to register timeout duration histogram: %w", err)
 	}
 
-	em.workflowStepDurationSeconds, err = beholder.GetMeter().Int64Histogram(
+	em.workflowStepDurationSeconds, err = meter.Int64Histogram(
 		"platform_engine_workflow_step_time_seconds",
 		metric.WithDescription("Distribution of step execution times"),
 		metric.WithUnit("seconds"))
@@ -169,7 +193,7 @@ func initMonitoringResources() (em *engineMetrics, err error) {
 		return nil, fmt.Errorf("failed to register step execution time histogram: %w", err)
 	}
 
-	em.workflowMissingMeteringReport, err = beholder.GetMeter().Int64Counter("platform_engine_workflow_missing_metering_report")
+	em.workflowMissingMeteringReport, err = meter.Int64Counter("platform_engine_workflow_missing_metering_report")
 	if err != nil {
 		return nil, fmt.Errorf("failed to register workflow metering missing gauge: %w", err)
 	}
@@ -220,6 +244,22 @@ func (c workflowsMetricLabeler) with(keyValues ...string) workflowsMetricLabeler
 	return workflowsMetricLabeler{c.With(keyValues...), c.em}
 }
 
+// incrementWorkflowExecutionRateLimitCounter counts an execution denied at the given level of the rate limiter.
+func (c workflowsMetricLabeler) incrementWorkflowExecutionRateLimitCounter(ctx context.Context, level ratelimiter.Level) {
+	switch level {
+	case ratelimiter.LevelGlobal:
+		c.incrementWorkflowExecutionRateLimitGlobalCounter(ctx)
+	case ratelimiter.LevelOwner:
+		c.incrementWorkflowExecutionRateLimitPerUserCounter(ctx)
+	case ratelimiter.LevelWorkflow:
+		otelLabels := monutils.KvMapToOtelAttributes(c.Labels)
+		c.em.workflowExecutionRateLimitPerWorkflowCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
+	case ratelimiter.LevelTrigger:
+		otelLabels := monutils.KvMapToOtelAttributes(c.Labels)
+		c.em.workflowExecutionRateLimitPerTriggerCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
+	}
+}
+
 func (c workflowsMetricLabeler) incrementWorkflowExecutionRateLimitGlobalCounter(ctx context.Context) {
 	otelLabels := monutils.KvMapToOtelAttributes(c.Labels)
 	c.em.workflowExecutionRateLimitGlobalCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
@@ -230,6 +270,11 @@ func (c workflowsMetricLabeler) incrementWorkflowExecutionRateLimitPerUserCounte
 	c.em.workflowExecutionRateLimitPerUserCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
 }
 
+func (c workflowsMetricLabeler) incrementWorkflowExecutionBudgetExceededCounter(ctx context.Context) {
+	otelLabels := monutils.KvMapToOtelAttributes(c.Labels)
+	c.em.workflowExecutionBudgetExceededCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
+}
+
 func (c workflowsMetricLabeler) incrementWorkflowLimitGlobalCounter(ctx context.Context) {
 	otelLabels := monutils.KvMapToOtelAttributes(c.Labels)
 	c.em.workflowLimitGlobalCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
@@ -285,6 +330,11 @@ func (c workflowsMetricLabeler) incrementCapabilityFailureCounter(ctx context.Co
 	c.em.capabilityFailureCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
 }
 
+func (c workflowsMetricLabeler) incrementCapabilityRetryCounter(ctx context.Context) {
+	otelLabels := monutils.KvMapToOtelAttributes(c.Labels)
+	c.em.capabilityRetryCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
+}
+
 func (c workflowsMetricLabeler) incrementWorkflowRegisteredCounter(ctx context.Context) {
 	otelLabels := monutils.KvMapToOtelAttributes(c.Labels)
 	c.em.workflowRegisteredCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
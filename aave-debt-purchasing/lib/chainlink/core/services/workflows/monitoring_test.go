@@ -5,11 +5,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/beholder"
 	"github.com/smartcontractkit/chainlink-common/pkg/metrics"
 )
 
 func Test_InitMonitoringResources(t *testing.T) {
-	_, err := initMonitoringResources()
+	_, err := initMonitoringResources(beholder.GetMeter())
 	require.NoError(t, err)
 }
 
@@ -3,16 +3,46 @@ package ratelimiter
 import (
 	"errors"
 	"sync"
+	"time"
 
 	"golang.org/x/time/rate"
 )
 
-// Wrapper around Go's rate.Limiter that supports both global and a per-sender rate limiting.
+// sweepInterval is how often idle limiters are evicted.
+const sweepInterval = time.Minute
+
+// Level is a level of the rate limiter hierarchy, from the global limit down to the limit
+// of a single trigger.
+type Level string
+
+const (
+	LevelGlobal   Level = "global"
+	LevelOwner    Level = "owner"
+	LevelWorkflow Level = "workflow"
+	LevelTrigger  Level = "trigger"
+)
+
+// Key identifies the source of a request at each level of the hierarchy.
+type Key struct {
+	Owner      string
+	WorkflowID string
+	TriggerID  string
+}
+
+// Wrapper around Go's rate.Limiter that supports hierarchical rate limiting: globally,
+// per sender (workflow owner), per workflow and per trigger.
+//
+// A request is checked from the most specific level up, and only consumes a token at every
+// level if all of them allow it, so that a sender exceeding its own limit does not use up
+// the global limit for everyone else.
 type RateLimiter struct {
-	global    *rate.Limiter
-	perSender map[string]*rate.Limiter
-	config    Config
-	mu        sync.Mutex
+	global      *rate.Limiter
+	perSender   map[string]*rate.Limiter
+	perWorkflow map[string]*rate.Limiter
+	perTrigger  map[string]*rate.Limiter
+	config      Config
+	lastSweep   time.Time
+	mu          sync.Mutex
 }
 
 type Config struct {
@@ -20,32 +50,146 @@ type Config struct {
 	GlobalBurst    int     `json:"globalBurst"`
 	PerSenderRPS   float64 `json:"perSenderRPS"`
 	PerSenderBurst int     `json:"perSenderBurst"`
+	// PerWorkflowRPS and PerWorkflowBurst limit each workflow. The limit is disabled if
+	// PerWorkflowRPS is zero.
+	PerWorkflowRPS   float64 `json:"perWorkflowRPS"`
+	PerWorkflowBurst int     `json:"perWorkflowBurst"`
+	// PerTriggerRPS and PerTriggerBurst limit each trigger of a workflow. The limit is
+	// disabled if PerTriggerRPS is zero.
+	PerTriggerRPS   float64 `json:"perTriggerRPS"`
+	PerTriggerBurst int     `json:"perTriggerBurst"`
 }
 
-func NewRateLimiter(config Config) (*RateLimiter, error) {
-	if config.GlobalRPS <= 0.0 || config.PerSenderRPS <= 0.0 {
-		return nil, errors.New("RPS values must be positive")
+func (c Config) validate() error {
+	if c.GlobalRPS <= 0.0 || c.PerSenderRPS <= 0.0 {
+		return errors.New("RPS values must be positive")
+	}
+	if c.GlobalBurst <= 0 || c.PerSenderBurst <= 0 {
+		return errors.New("burst values must be positive")
 	}
-	if config.GlobalBurst <= 0 || config.PerSenderBurst <= 0 {
-		return nil, errors.New("burst values must be positive")
+	if c.PerWorkflowRPS < 0.0 || c.PerTriggerRPS < 0.0 {
+		return errors.New("per workflow and per trigger RPS values must not be negative")
+	}
+	if (c.PerWorkflowRPS > 0.0 && c.PerWorkflowBurst <= 0) || (c.PerTriggerRPS > 0.0 && c.PerTriggerBurst <= 0) {
+		return errors.New("burst values must be positive")
+	}
+	return nil
+}
+
+func NewRateLimiter(config Config) (*RateLimiter, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
 	}
 	return &RateLimiter{
-		global:    rate.NewLimiter(rate.Limit(config.GlobalRPS), config.GlobalBurst),
-		perSender: make(map[string]*rate.Limiter),
-		config:    config,
+		global:      rate.NewLimiter(rate.Limit(config.GlobalRPS), config.GlobalBurst),
+		perSender:   make(map[string]*rate.Limiter),
+		perWorkflow: make(map[string]*rate.Limiter),
+		perTrigger:  make(map[string]*rate.Limiter),
+		config:      config,
+		lastSweep:   time.Now(),
 	}, nil
 }
 
+// Allow checks the global and per sender limits only. See AllowKey.
 func (rl *RateLimiter) Allow(sender string) (senderAllow bool, globalAllow bool) {
+	_, denied := rl.AllowKey(Key{Owner: sender})
+	return denied != LevelOwner, denied != LevelGlobal
+}
+
+// AllowKey reports whether a request from key is allowed at every level of the hierarchy,
+// and if not, the most specific level that denied it.
+func (rl *RateLimiter) AllowKey(key Key) (bool, Level) {
+	return rl.allowAt(key, time.Now())
+}
+
+func (rl *RateLimiter) allowAt(key Key, now time.Time) (bool, Level) {
 	rl.mu.Lock()
-	senderLimiter, ok := rl.perSender[sender]
-	if !ok {
-		senderLimiter = rate.NewLimiter(rate.Limit(rl.config.PerSenderRPS), rl.config.PerSenderBurst)
-		rl.perSender[sender] = senderLimiter
+	defer rl.mu.Unlock()
+
+	if now.Sub(rl.lastSweep) >= sweepInterval {
+		rl.sweep(now)
 	}
-	rl.mu.Unlock()
 
-	senderAllow = senderLimiter.Allow()
-	globalAllow = rl.global.Allow()
-	return senderAllow, globalAllow
+	type level struct {
+		level   Level
+		limiter *rate.Limiter
+	}
+	var levels []level
+	if rl.config.PerTriggerRPS > 0 && key.TriggerID != "" {
+		id := key.WorkflowID + "/" + key.TriggerID
+		levels = append(levels, level{LevelTrigger, getOrCreate(rl.perTrigger, id, rl.config.PerTriggerRPS, rl.config.PerTriggerBurst)})
+	}
+	if rl.config.PerWorkflowRPS > 0 && key.WorkflowID != "" {
+		levels = append(levels, level{LevelWorkflow, getOrCreate(rl.perWorkflow, key.WorkflowID, rl.config.PerWorkflowRPS, rl.config.PerWorkflowBurst)})
+	}
+	levels = append(levels,
+		level{LevelOwner, getOrCreate(rl.perSender, key.Owner, rl.config.PerSenderRPS, rl.config.PerSenderBurst)},
+		level{LevelGlobal, rl.global},
+	)
+
+	reservations := make([]*rate.Reservation, 0, len(levels))
+	for _, l := range levels {
+		r := l.limiter.ReserveN(now, 1)
+		if !r.OK() || r.DelayFrom(now) > 0 {
+			r.CancelAt(now)
+			for _, reserved := range reservations {
+				reserved.CancelAt(now)
+			}
+			return false, l.level
+		}
+		reservations = append(reservations, r)
+	}
+	return true, ""
+}
+
+// SetConfig updates the limits of every level, including those of existing senders,
+// workflows and triggers.
+func (rl *RateLimiter) SetConfig(config Config) error {
+	if err := config.validate(); err != nil {
+		return err
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	update := func(limiters map[string]*rate.Limiter, rps float64, burst int) {
+		for id, limiter := range limiters {
+			if rps <= 0 {
+				delete(limiters, id)
+				continue
+			}
+			limiter.SetLimitAt(now, rate.Limit(rps))
+			limiter.SetBurstAt(now, burst)
+		}
+	}
+	rl.global.SetLimitAt(now, rate.Limit(config.GlobalRPS))
+	rl.global.SetBurstAt(now, config.GlobalBurst)
+	update(rl.perSender, config.PerSenderRPS, config.PerSenderBurst)
+	update(rl.perWorkflow, config.PerWorkflowRPS, config.PerWorkflowBurst)
+	update(rl.perTrigger, config.PerTriggerRPS, config.PerTriggerBurst)
+	rl.config = config
+	return nil
+}
+
+// sweep evicts limiters that have been idle long enough to refill completely, since those
+// are equivalent to new ones.
+func (rl *RateLimiter) sweep(now time.Time) {
+	for _, limiters := range []map[string]*rate.Limiter{rl.perSender, rl.perWorkflow, rl.perTrigger} {
+		for id, limiter := range limiters {
+			if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+				delete(limiters, id)
+			}
+		}
+	}
+	rl.lastSweep = now
+}
+
+func getOrCreate(limiters map[string]*rate.Limiter, id string, rps float64, burst int) *rate.Limiter {
+	limiter, ok := limiters[id]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(rps), burst)
+		limiters[id] = limiter
+	}
+	return limiter
 }
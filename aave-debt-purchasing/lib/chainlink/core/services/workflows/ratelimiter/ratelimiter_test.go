@@ -2,6 +2,7 @@ package ratelimiter
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -28,3 +29,138 @@ func TestRateLimiter(t *testing.T) {
 	allowUserSender, allowUserGlobal = rl.Allow("user3")
 	require.False(t, allowUserSender && allowUserGlobal)
 }
+
+func TestRateLimiter_Hierarchy(t *testing.T) {
+	t.Parallel()
+
+	rl, err := NewRateLimiter(Config{
+		GlobalRPS:        1.0,
+		GlobalBurst:      4,
+		PerSenderRPS:     1.0,
+		PerSenderBurst:   3,
+		PerWorkflowRPS:   1.0,
+		PerWorkflowBurst: 2,
+		PerTriggerRPS:    1.0,
+		PerTriggerBurst:  1,
+	})
+	require.NoError(t, err)
+	now := time.Now()
+
+	allowed, level := rl.allowAt(Key{Owner: "owner1", WorkflowID: "wf1", TriggerID: "trigger1"}, now)
+	require.True(t, allowed)
+	require.Empty(t, level)
+
+	allowed, level = rl.allowAt(Key{Owner: "owner1", WorkflowID: "wf1", TriggerID: "trigger1"}, now)
+	require.False(t, allowed)
+	require.Equal(t, LevelTrigger, level)
+
+	allowed, _ = rl.allowAt(Key{Owner: "owner1", WorkflowID: "wf1", TriggerID: "trigger2"}, now)
+	require.True(t, allowed)
+
+	allowed, level = rl.allowAt(Key{Owner: "owner1", WorkflowID: "wf1", TriggerID: "trigger3"}, now)
+	require.False(t, allowed)
+	require.Equal(t, LevelWorkflow, level)
+
+	allowed, _ = rl.allowAt(Key{Owner: "owner1", WorkflowID: "wf2", TriggerID: "trigger1"}, now)
+	require.True(t, allowed)
+
+	allowed, level = rl.allowAt(Key{Owner: "owner1", WorkflowID: "wf3", TriggerID: "trigger1"}, now)
+	require.False(t, allowed)
+	require.Equal(t, LevelOwner, level)
+
+	// denials of owner1 did not consume the global limit
+	allowed, _ = rl.allowAt(Key{Owner: "owner2", WorkflowID: "wf4", TriggerID: "trigger1"}, now)
+	require.True(t, allowed)
+
+	allowed, level = rl.allowAt(Key{Owner: "owner3", WorkflowID: "wf5", TriggerID: "trigger1"}, now)
+	require.False(t, allowed)
+	require.Equal(t, LevelGlobal, level)
+
+	allowed, _ = rl.allowAt(Key{Owner: "owner3", WorkflowID: "wf5", TriggerID: "trigger1"}, now.Add(time.Second))
+	require.True(t, allowed)
+}
+
+func TestRateLimiter_SetConfig(t *testing.T) {
+	t.Parallel()
+
+	config := Config{
+		GlobalRPS:      1.0,
+		GlobalBurst:    10,
+		PerSenderRPS:   1.0,
+		PerSenderBurst: 1,
+	}
+	rl, err := NewRateLimiter(config)
+	require.NoError(t, err)
+
+	allowed, _ := rl.AllowKey(Key{Owner: "owner1", WorkflowID: "wf1"})
+	require.True(t, allowed)
+	allowed, level := rl.AllowKey(Key{Owner: "owner1", WorkflowID: "wf1"})
+	require.False(t, allowed)
+	require.Equal(t, LevelOwner, level)
+
+	config.PerSenderBurst = 3
+	config.PerWorkflowRPS = 1.0
+	config.PerWorkflowBurst = 1
+	require.NoError(t, rl.SetConfig(config))
+
+	// the existing sender limiter keeps its tokens, but can now hold more of them
+	allowed, level = rl.AllowKey(Key{Owner: "owner1", WorkflowID: "wf1"})
+	require.False(t, allowed)
+	require.Equal(t, LevelOwner, level)
+
+	allowed, _ = rl.AllowKey(Key{Owner: "owner2", WorkflowID: "wf2"})
+	require.True(t, allowed)
+	allowed, level = rl.AllowKey(Key{Owner: "owner2", WorkflowID: "wf2"})
+	require.False(t, allowed)
+	require.Equal(t, LevelWorkflow, level)
+
+	config.PerWorkflowRPS = 0
+	require.NoError(t, rl.SetConfig(config))
+	allowed, _ = rl.AllowKey(Key{Owner: "owner2", WorkflowID: "wf2"})
+	require.True(t, allowed)
+
+	config.GlobalBurst = 0
+	require.Error(t, rl.SetConfig(config))
+}
+
+func TestRateLimiter_EvictsIdleLimiters(t *testing.T) {
+	t.Parallel()
+
+	rl, err := NewRateLimiter(Config{
+		GlobalRPS:        10.0,
+		GlobalBurst:      10,
+		PerSenderRPS:     1.0,
+		PerSenderBurst:   2,
+		PerWorkflowRPS:   1.0,
+		PerWorkflowBurst: 2,
+		PerTriggerRPS:    0.1,
+		PerTriggerBurst:  1,
+	})
+	require.NoError(t, err)
+	now := time.Now()
+
+	allowed, _ := rl.allowAt(Key{Owner: "owner1", WorkflowID: "wf1", TriggerID: "trigger1"}, now)
+	require.True(t, allowed)
+	require.Len(t, rl.perSender, 1)
+	require.Len(t, rl.perWorkflow, 1)
+	require.Len(t, rl.perTrigger, 1)
+
+	// the trigger limiter has not refilled yet
+	rl.sweep(now.Add(5 * time.Second))
+	require.Empty(t, rl.perSender)
+	require.Empty(t, rl.perWorkflow)
+	require.Len(t, rl.perTrigger, 1)
+
+	rl.sweep(now.Add(10 * time.Second))
+	require.Empty(t, rl.perTrigger)
+
+	// limiters are swept while allowing requests
+	allowed, _ = rl.allowAt(Key{Owner: "owner1", WorkflowID: "wf1", TriggerID: "trigger1"}, now.Add(time.Minute))
+	require.True(t, allowed)
+	allowed, _ = rl.allowAt(Key{Owner: "owner2", WorkflowID: "wf2"}, now.Add(time.Minute+sweepInterval))
+	require.True(t, allowed)
+	require.Len(t, rl.perSender, 1)
+	require.Contains(t, rl.perSender, "owner2")
+	require.Len(t, rl.perWorkflow, 1)
+	require.Empty(t, rl.perTrigger)
+}
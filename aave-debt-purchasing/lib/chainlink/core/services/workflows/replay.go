@@ -0,0 +1,626 @@
+package workflows
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	p2ptypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/beholder"
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/custmsg"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm/host"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncerlimiter"
+)
+
+const replayEventIDPrefix = "replay-"
+
+// ReplayedStep describes a single step invocation made by the engine while replaying an execution.
+type ReplayedStep struct {
+	Ref          string
+	CapabilityID string
+	// Recorded is false if the original execution has no outcome stored for the step,
+	// e.g. because the execution was interrupted before the step finished.
+	Recorded       bool
+	Inputs         *values.Map
+	RecordedInputs *values.Map
+	// InputsMatch reports whether the inputs computed during the replay are identical
+	// to the inputs the step received in the original execution.
+	InputsMatch bool
+	Status      string
+	Outputs     store.StepOutput
+}
+
+// ReplayResult is the outcome of replaying a stored workflow execution.
+type ReplayResult struct {
+	ExecutionID       string
+	ReplayExecutionID string
+	RecordedStatus    string
+	Status            string
+	// Steps are the step invocations in the order the engine made them.
+	Steps []ReplayedStep
+}
+
+// ReplayConfig configures the replay of a stored workflow execution.
+type ReplayConfig struct {
+	Lggr          logger.Logger
+	Workflow      sdk.WorkflowSpec
+	WorkflowID    string
+	WorkflowOwner string
+	WorkflowName  string
+	Config        []byte
+	Binary        []byte
+	Execution     store.WorkflowExecution
+
+	// SecretsFetcher is used to interpolate secrets into step configs.
+	// Defaults to a fetcher that returns no secrets.
+	SecretsFetcher SecretsFor
+
+	// OnStep is called before the recorded outcome of a step is returned to the engine.
+	// It may block to pause the replay between steps; returning an error fails the step.
+	// Neither the step timeout nor the maximum execution duration elapse while it blocks.
+	OnStep func(ctx context.Context, step ReplayedStep) error
+}
+
+// NewReplayConfig builds a ReplayConfig for the given execution from the stored workflow spec.
+// Both job based specs and specs synced from the workflow registry are supported.
+func NewReplayConfig(ctx context.Context, lggr logger.Logger, spec *job.WorkflowSpec, execution store.WorkflowExecution) (ReplayConfig, error) {
+	cfg := ReplayConfig{
+		Lggr:          lggr,
+		WorkflowID:    spec.WorkflowID,
+		WorkflowOwner: spec.WorkflowOwner,
+		WorkflowName:  spec.WorkflowName,
+		Execution:     execution,
+	}
+
+	// Specs synced from the workflow registry store the hex encoded binary
+	// in place of the workflow, see syncer.eventHandler.
+	if spec.BinaryURL != "" {
+		binary, err := hex.DecodeString(spec.Workflow)
+		if err != nil {
+			return ReplayConfig{}, fmt.Errorf("failed to decode workflow binary: %w", err)
+		}
+
+		config := []byte(spec.Config)
+		sdkSpec, err := host.GetWorkflowSpec(ctx, &host.ModuleConfig{Logger: lggr, Labeler: noopMessageEmitter{}}, binary, config)
+		if err != nil {
+			return ReplayConfig{}, fmt.Errorf("failed to get workflow sdk spec: %w", err)
+		}
+
+		cfg.Workflow = *sdkSpec
+		cfg.Binary = binary
+		cfg.Config = config
+		return cfg, nil
+	}
+
+	sdkSpec, err := spec.SDKSpec(ctx)
+	if err != nil {
+		return ReplayConfig{}, fmt.Errorf("failed to get workflow sdk spec: %w", err)
+	}
+
+	binary, err := spec.RawSpec(ctx)
+	if err != nil {
+		return ReplayConfig{}, fmt.Errorf("failed to fetch workflow spec binary: %w", err)
+	}
+
+	config, err := spec.GetConfig(ctx)
+	if err != nil {
+		return ReplayConfig{}, fmt.Errorf("failed to get workflow spec config: %w", err)
+	}
+
+	cfg.Workflow = sdkSpec
+	cfg.Binary = binary
+	cfg.Config = config
+	return cfg, nil
+}
+
+// Replay re-runs a stored workflow execution against an Engine whose capabilities are stubbed out.
+// The trigger emits the recorded trigger output and every step returns its recorded outcome, so
+// no capability is invoked and nothing is written to the node's execution store. The engine sends
+// no custom messages, events or metrics.
+//
+// Replay blocks until the replayed execution finishes or the context is done.
+func Replay(ctx context.Context, cfg ReplayConfig) (*ReplayResult, error) {
+	var triggerOutputs *values.Map
+	if trigger, ok := cfg.Execution.Steps[workflows.KeywordTrigger]; ok {
+		triggerOutputs, _ = trigger.Outputs.Value.(*values.Map)
+	}
+	if triggerOutputs == nil {
+		return nil, fmt.Errorf("execution %s has no recorded trigger output", cfg.Execution.ExecutionID)
+	}
+
+	eventID := replayEventIDPrefix + cfg.Execution.ExecutionID
+	replayExecutionID, err := generateExecutionID(cfg.WorkflowID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.SecretsFetcher == nil {
+		cfg.SecretsFetcher = func(context.Context, string, string, string, string) (map[string]string, error) {
+			return map[string]string{}, nil
+		}
+	}
+
+	if cfg.OnStep == nil {
+		cfg.OnStep = func(context.Context, ReplayedStep) error { return nil }
+	}
+
+	lggr := cfg.Lggr.Named("WorkflowReplay").With("executionID", cfg.Execution.ExecutionID)
+
+	rl, err := ratelimiter.NewRateLimiter(ratelimiter.Config{
+		GlobalRPS:      1.0,
+		GlobalBurst:    1,
+		PerSenderRPS:   1.0,
+		PerSenderBurst: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	wl, err := syncerlimiter.NewWorkflowLimits(lggr, syncerlimiter.Config{
+		Global:   1,
+		PerOwner: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clock := &replayClock{Clock: clockwork.NewRealClock()}
+	registry := &replayRegistry{
+		ctx:       ctx,
+		clock:     clock,
+		execution: cfg.Execution,
+		event: capabilities.TriggerEvent{
+			ID:      eventID,
+			Outputs: triggerOutputs,
+		},
+		onStep: cfg.OnStep,
+	}
+
+	finished := make(chan struct{})
+	initFailed := make(chan struct{})
+	var finishOnce sync.Once
+	executionStore := store.NewInMemoryStore(lggr, clock)
+	telemetry := beholder.NewNoopClient()
+	engine, err := NewEngine(ctx, Config{
+		Lggr:          lggr,
+		Workflow:      cfg.Workflow,
+		WorkflowID:    cfg.WorkflowID,
+		WorkflowOwner: cfg.WorkflowOwner,
+		WorkflowName: defaultName{
+			name: cfg.WorkflowName,
+		},
+		Registry:       registry,
+		Store:          executionStore,
+		Config:         cfg.Config,
+		Binary:         cfg.Binary,
+		SecretsFetcher: cfg.SecretsFetcher,
+		RateLimiter:    rl,
+		WorkflowLimits: wl,
+		messageEmitter: noopMessageEmitter{},
+		emitter:        telemetry.Emitter,
+		meter:          telemetry.Meter,
+		// The stubbed capabilities always resolve, so there is nothing to retry.
+		maxRetries: 1,
+		afterInit: func(success bool) {
+			if !success {
+				close(initFailed)
+			}
+		},
+		onExecutionFinished: func(weid string) {
+			if weid == replayExecutionID {
+				finishOnce.Do(func() { close(finished) })
+			}
+		},
+		clock: clock,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The recorded outcome of each step is final, so retrying a failed step
+	// would only replay the same failure again.
+	err = engine.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
+		s.retryPolicy = nil
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = engine.Start(ctx); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-finished:
+	case <-initFailed:
+		err = errors.New("failed to initialize workflow engine")
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if cerr := engine.Close(); cerr != nil {
+		err = errors.Join(err, cerr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay execution %s: %w", cfg.Execution.ExecutionID, err)
+	}
+
+	replayed, err := executionStore.Get(ctx, replayExecutionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReplayResult{
+		ExecutionID:       cfg.Execution.ExecutionID,
+		ReplayExecutionID: replayExecutionID,
+		RecordedStatus:    cfg.Execution.Status,
+		Status:            replayed.Status,
+		Steps:             registry.replayedSteps(),
+	}, nil
+}
+
+// SteppedReplay is a replay which pauses before each step until it is resumed by Next.
+// All methods are thread-safe.
+type SteppedReplay struct {
+	paused chan ReplayedStep
+	resume chan struct{}
+	done   chan struct{}
+	cancel context.CancelFunc
+
+	// result and err are set once done is closed
+	result *ReplayResult
+	err    error
+
+	mu       sync.Mutex
+	isPaused bool
+}
+
+// StartSteppedReplay replays a stored workflow execution like Replay, in the background. The replay
+// pauses before the recorded outcome of each step is returned to the engine, until Next is called.
+// It runs until it finishes, ctx is done or Close is called. cfg.OnStep is ignored.
+func StartSteppedReplay(ctx context.Context, cfg ReplayConfig) *SteppedReplay {
+	ctx, cancel := context.WithCancel(ctx)
+	r := &SteppedReplay{
+		paused: make(chan ReplayedStep),
+		resume: make(chan struct{}),
+		done:   make(chan struct{}),
+		cancel: cancel,
+	}
+	cfg.OnStep = r.onStep
+	go func() {
+		defer close(r.done)
+		r.result, r.err = Replay(ctx, cfg)
+	}()
+	return r
+}
+
+// onStep hands the step to Next and waits to be resumed. Steps run concurrently by the engine
+// are handed over one at a time.
+func (r *SteppedReplay) onStep(ctx context.Context, step ReplayedStep) error {
+	select {
+	case r.paused <- step:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-r.resume:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Next resumes the replay if it is paused, and waits until it pauses before the next step or finishes.
+// It returns the step the replay is paused at, or the result of the finished replay.
+func (r *SteppedReplay) Next(ctx context.Context) (*ReplayedStep, *ReplayResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.isPaused {
+		select {
+		case r.resume <- struct{}{}:
+		case <-r.done:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+		r.isPaused = false
+	}
+
+	select {
+	case step := <-r.paused:
+		r.isPaused = true
+		return &step, nil, nil
+	case <-r.done:
+		return nil, r.result, r.err
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	}
+}
+
+// Done is closed once the replay is finished.
+func (r *SteppedReplay) Done() <-chan struct{} {
+	return r.done
+}
+
+// Close stops the replay and waits for it to finish.
+func (r *SteppedReplay) Close() {
+	r.cancel()
+	<-r.done
+}
+
+// noopMessageEmitter is a custmsg.MessageEmitter which drops every message.
+type noopMessageEmitter struct{}
+
+var _ custmsg.MessageEmitter = noopMessageEmitter{}
+
+func (noopMessageEmitter) Emit(context.Context, string) error {
+	return nil
+}
+
+func (e noopMessageEmitter) WithMapLabels(map[string]string) custmsg.MessageEmitter {
+	return e
+}
+
+func (e noopMessageEmitter) With(...string) custmsg.MessageEmitter {
+	return e
+}
+
+func (noopMessageEmitter) Labels() map[string]string {
+	return map[string]string{}
+}
+
+// replayRegistry is a core.CapabilitiesRegistry that resolves every capability
+// to a stub returning the outcomes recorded for the replayed execution.
+type replayRegistry struct {
+	// ctx is the context of the replay. Steps are paused with it rather than with the
+	// context of the step, so that the step timeout does not apply while they are paused.
+	ctx       context.Context
+	clock     *replayClock
+	execution store.WorkflowExecution
+	event     capabilities.TriggerEvent
+	onStep    func(ctx context.Context, step ReplayedStep) error
+
+	mu    sync.Mutex
+	fired bool
+	steps []ReplayedStep
+}
+
+var _ core.CapabilitiesRegistry = (*replayRegistry)(nil)
+
+func (r *replayRegistry) LocalNode(context.Context) (capabilities.Node, error) {
+	return capabilities.Node{PeerID: &p2ptypes.PeerID{}}, nil
+}
+
+func (r *replayRegistry) ConfigForCapability(context.Context, string, uint32) (capabilities.CapabilityConfiguration, error) {
+	return capabilities.CapabilityConfiguration{}, nil
+}
+
+func (r *replayRegistry) Get(_ context.Context, id string) (capabilities.BaseCapability, error) {
+	return &replayCapability{id: id, registry: r}, nil
+}
+
+func (r *replayRegistry) GetTrigger(_ context.Context, id string) (capabilities.TriggerCapability, error) {
+	return &replayTrigger{id: id, registry: r}, nil
+}
+
+func (r *replayRegistry) GetAction(_ context.Context, id string) (capabilities.ActionCapability, error) {
+	return &replayCapability{id: id, registry: r}, nil
+}
+
+func (r *replayRegistry) GetConsensus(_ context.Context, id string) (capabilities.ConsensusCapability, error) {
+	return &replayCapability{id: id, registry: r}, nil
+}
+
+func (r *replayRegistry) GetTarget(_ context.Context, id string) (capabilities.TargetCapability, error) {
+	return &replayCapability{id: id, registry: r}, nil
+}
+
+func (r *replayRegistry) List(context.Context) ([]capabilities.BaseCapability, error) {
+	return nil, nil
+}
+
+func (r *replayRegistry) Add(context.Context, capabilities.BaseCapability) error {
+	return errors.New("cannot add capabilities during a replay")
+}
+
+func (r *replayRegistry) Remove(context.Context, string) error {
+	return errors.New("cannot remove capabilities during a replay")
+}
+
+// fire returns true the first time it is called, so that the recorded
+// trigger event is only emitted once even if the workflow has multiple triggers.
+func (r *replayRegistry) fire() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.fired {
+		return false
+	}
+	r.fired = true
+	return true
+}
+
+func (r *replayRegistry) addStep(s ReplayedStep) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.steps = append(r.steps, s)
+}
+
+func (r *replayRegistry) replayedSteps() []ReplayedStep {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	steps := make([]ReplayedStep, len(r.steps))
+	copy(steps, r.steps)
+	return steps
+}
+
+type replayTrigger struct {
+	id       string
+	registry *replayRegistry
+}
+
+var _ capabilities.TriggerCapability = (*replayTrigger)(nil)
+
+func (t *replayTrigger) Info(context.Context) (capabilities.CapabilityInfo, error) {
+	return capabilities.CapabilityInfo{ID: t.id, CapabilityType: capabilities.CapabilityTypeTrigger, IsLocal: true}, nil
+}
+
+func (t *replayTrigger) RegisterTrigger(context.Context, capabilities.TriggerRegistrationRequest) (<-chan capabilities.TriggerResponse, error) {
+	ch := make(chan capabilities.TriggerResponse, 1)
+	if t.registry.fire() {
+		ch <- capabilities.TriggerResponse{Event: t.registry.event}
+	}
+	close(ch)
+	return ch, nil
+}
+
+func (t *replayTrigger) UnregisterTrigger(context.Context, capabilities.TriggerRegistrationRequest) error {
+	return nil
+}
+
+type replayCapability struct {
+	id       string
+	registry *replayRegistry
+}
+
+var _ capabilities.ExecutableCapability = (*replayCapability)(nil)
+
+// Info reports every stubbed capability as a local action, so that the engine
+// calls it directly instead of wrapping it in a transmission protocol.
+func (c *replayCapability) Info(context.Context) (capabilities.CapabilityInfo, error) {
+	return capabilities.CapabilityInfo{ID: c.id, CapabilityType: capabilities.CapabilityTypeAction, IsLocal: true}, nil
+}
+
+func (c *replayCapability) RegisterToWorkflow(context.Context, capabilities.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (c *replayCapability) UnregisterFromWorkflow(context.Context, capabilities.UnregisterFromWorkflowRequest) error {
+	return nil
+}
+
+func (c *replayCapability) Execute(_ context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	ref := req.Metadata.ReferenceID
+	replayed := ReplayedStep{
+		Ref:          ref,
+		CapabilityID: c.id,
+		Inputs:       req.Inputs,
+	}
+
+	recorded, ok := c.registry.execution.Steps[ref]
+	if ok {
+		replayed.Recorded = true
+		replayed.RecordedInputs = recorded.Inputs
+		replayed.InputsMatch = sameInputs(req.Inputs, recorded.Inputs)
+		replayed.Status = recorded.Status
+		replayed.Outputs = recorded.Outputs
+	}
+
+	c.registry.clock.pause()
+	err := c.registry.onStep(c.registry.ctx, replayed)
+	c.registry.clock.resume()
+	c.registry.addStep(replayed)
+	if err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+
+	if !ok {
+		return capabilities.CapabilityResponse{}, fmt.Errorf("no recorded outcome for step %s", ref)
+	}
+
+	switch {
+	case recorded.Status == store.StatusCompletedEarlyExit:
+		return capabilities.CapabilityResponse{}, capabilities.ErrStopExecution
+	case recorded.Outputs.Err != nil:
+		return capabilities.CapabilityResponse{}, recorded.Outputs.Err
+	case recorded.Status != store.StatusCompleted:
+		return capabilities.CapabilityResponse{}, fmt.Errorf("step %s finished with status %s", ref, recorded.Status)
+	}
+
+	var outputs *values.Map
+	if recorded.Outputs.Value != nil {
+		m, ok := recorded.Outputs.Value.(*values.Map)
+		if !ok {
+			return capabilities.CapabilityResponse{}, fmt.Errorf("recorded output for step %s is not a map", ref)
+		}
+		outputs = m
+	}
+
+	return capabilities.CapabilityResponse{Value: outputs}, nil
+}
+
+// replayClock is a clockwork.Clock which stands still while any step is paused, so that
+// the time a replay spends paused does not count towards the maximum execution duration.
+type replayClock struct {
+	clockwork.Clock
+
+	mu       sync.Mutex
+	paused   int
+	pausedAt time.Time
+	offset   time.Duration
+}
+
+func (c *replayClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.paused > 0 {
+		return c.pausedAt.Add(-c.offset)
+	}
+	return c.Clock.Now().Add(-c.offset)
+}
+
+func (c *replayClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func (c *replayClock) pause() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.paused == 0 {
+		c.pausedAt = c.Clock.Now()
+	}
+	c.paused++
+}
+
+func (c *replayClock) resume() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.paused--
+	if c.paused == 0 {
+		c.offset += c.Clock.Since(c.pausedAt)
+	}
+}
+
+func sameInputs(a, b *values.Map) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	ua, err := a.Unwrap()
+	if err != nil {
+		return false
+	}
+
+	ub, err := b.Unwrap()
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(ua, ub)
+}
@@ -0,0 +1,231 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows"
+
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func recordExecution(t *testing.T, reg *coreCap.Registry) store.WorkflowExecution {
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow)
+	servicetest.Run(t, eng)
+
+	eid := getExecutionID(t, eng, hooks)
+	state, err := eng.executionsStore.Get(testutils.Context(t), eid)
+	require.NoError(t, err)
+	return state
+}
+
+func newTestReplayConfig(t *testing.T, execution store.WorkflowExecution) ReplayConfig {
+	spec := &job.WorkflowSpec{
+		Workflow:      simpleWorkflow,
+		SpecType:      job.YamlSpec,
+		WorkflowOwner: testWorkflowOwner,
+		WorkflowName:  testWorkflowName,
+	}
+	cfg, err := NewReplayConfig(testutils.Context(t), logger.TestLogger(t), spec, execution)
+	require.NoError(t, err)
+	cfg.WorkflowID = testWorkflowID
+	return cfg
+}
+
+func TestReplay_ReproducesRecordedExecution(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	recorded := recordExecution(t, reg)
+	require.Equal(t, store.StatusCompleted, recorded.Status)
+
+	result, err := Replay(ctx, newTestReplayConfig(t, recorded))
+	require.NoError(t, err)
+
+	assert.Equal(t, recorded.ExecutionID, result.ExecutionID)
+	assert.NotEqual(t, recorded.ExecutionID, result.ReplayExecutionID)
+	assert.Equal(t, store.StatusCompleted, result.RecordedStatus)
+	assert.Equal(t, store.StatusCompleted, result.Status)
+
+	require.Len(t, result.Steps, 2)
+	assert.Equal(t, "evm_median", result.Steps[0].Ref)
+	assert.Equal(t, "write_polygon-testnet-mumbai@1.0.0", result.Steps[1].Ref)
+	for _, s := range result.Steps {
+		assert.True(t, s.Recorded)
+		assert.True(t, s.InputsMatch, "inputs for step %s differ from the recorded inputs", s.Ref)
+		assert.Equal(t, recorded.Steps[s.Ref].Outputs.Value, s.Outputs.Value)
+	}
+}
+
+func TestReplay_ReproducesFailedExecution(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockFailingConsensus()))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	recorded := recordExecution(t, reg)
+	require.Equal(t, store.StatusErrored, recorded.Status)
+
+	result, err := Replay(ctx, newTestReplayConfig(t, recorded))
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusErrored, result.Status)
+	require.Len(t, result.Steps, 1)
+	assert.Equal(t, "evm_median", result.Steps[0].Ref)
+	require.Error(t, result.Steps[0].Outputs.Err)
+	assert.Equal(t, "fatal consensus error", result.Steps[0].Outputs.Err.Error())
+}
+
+func TestReplay_PausesBetweenSteps(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	recorded := recordExecution(t, reg)
+
+	paused := make(chan ReplayedStep)
+	resume := make(chan struct{})
+	cfg := newTestReplayConfig(t, recorded)
+	cfg.OnStep = func(ctx context.Context, s ReplayedStep) error {
+		paused <- s
+		select {
+		case <-resume:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	done := make(chan *ReplayResult)
+	go func() {
+		result, err := Replay(ctx, cfg)
+		assert.NoError(t, err)
+		done <- result
+	}()
+
+	for _, ref := range []string{"evm_median", "write_polygon-testnet-mumbai@1.0.0"} {
+		s := <-paused
+		assert.Equal(t, ref, s.Ref)
+		select {
+		case <-done:
+			t.Fatal("replay finished while paused")
+		default:
+		}
+		resume <- struct{}{}
+	}
+
+	result := <-done
+	assert.Equal(t, store.StatusCompleted, result.Status)
+}
+
+func TestSteppedReplay(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	recorded := recordExecution(t, reg)
+
+	t.Run("pauses before each step", func(t *testing.T) {
+		replay := StartSteppedReplay(ctx, newTestReplayConfig(t, recorded))
+		t.Cleanup(replay.Close)
+
+		for _, ref := range []string{"evm_median", "write_polygon-testnet-mumbai@1.0.0"} {
+			step, result, err := replay.Next(ctx)
+			require.NoError(t, err)
+			require.Nil(t, result)
+			require.NotNil(t, step)
+			assert.Equal(t, ref, step.Ref)
+			select {
+			case <-replay.Done():
+				t.Fatal("replay finished while paused")
+			default:
+			}
+		}
+
+		step, result, err := replay.Next(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, step)
+		require.NotNil(t, result)
+		assert.Equal(t, store.StatusCompleted, result.Status)
+		assert.Len(t, result.Steps, 2)
+	})
+
+	t.Run("closes while paused", func(t *testing.T) {
+		replay := StartSteppedReplay(ctx, newTestReplayConfig(t, recorded))
+
+		step, _, err := replay.Next(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, step)
+
+		replay.Close()
+		_, _, err = replay.Next(ctx)
+		require.Error(t, err)
+	})
+}
+
+func TestReplayClock_StandsStillWhilePaused(t *testing.T) {
+	t.Parallel()
+	fake := clockwork.NewFakeClock()
+	clock := &replayClock{Clock: fake}
+	start := clock.Now()
+
+	fake.Advance(time.Minute)
+	assert.Equal(t, time.Minute, clock.Since(start))
+
+	clock.pause()
+	clock.pause()
+	fake.Advance(time.Hour)
+	assert.Equal(t, time.Minute, clock.Since(start))
+	clock.resume()
+	fake.Advance(time.Hour)
+	assert.Equal(t, time.Minute, clock.Since(start))
+	clock.resume()
+
+	fake.Advance(time.Minute)
+	assert.Equal(t, 2*time.Minute, clock.Since(start))
+}
+
+func TestReplay_RequiresTriggerOutput(t *testing.T) {
+	t.Parallel()
+	execution := store.WorkflowExecution{
+		ExecutionID: "test-id",
+		WorkflowID:  testWorkflowID,
+		Status:      store.StatusStarted,
+		Steps: map[string]*store.WorkflowExecutionStep{
+			workflows.KeywordTrigger: {Ref: workflows.KeywordTrigger, Status: store.StatusCompleted},
+		},
+	}
+
+	_, err := Replay(testutils.Context(t), newTestReplayConfig(t, execution))
+	require.ErrorContains(t, err, "no recorded trigger output")
+}
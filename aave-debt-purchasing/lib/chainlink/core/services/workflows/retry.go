@@ -3,8 +3,16 @@ package workflows
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strings"
 	"time"
 
+	"github.com/go-viper/mapstructure/v2"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
@@ -51,3 +59,256 @@ func retryable(ctx context.Context, lggr logger.Logger, retryMs int, maxRetries
 		retries++
 	}
 }
+
+const (
+	// reservedFieldNameRetryPolicy is the step config field used to declare a retryPolicy for a step.
+	reservedFieldNameRetryPolicy = "cre_retry_policy"
+
+	maxStepRetries                = 10
+	defaultRetryInitialInterval   = 1 * time.Second
+	defaultRetryMaxInterval       = 30 * time.Second
+	defaultRetryBackoffMultiplier = 2.0
+	defaultMaxStepRetries         = 3
+)
+
+type backoffType string
+
+const (
+	backoffFixed       backoffType = "fixed"
+	backoffExponential backoffType = "exponential"
+)
+
+// errorClass groups capability errors by whether they are likely to be resolved by retrying.
+type errorClass string
+
+const (
+	// errorClassTimeout is a step attempt which didn't complete within its timeout, e.g.
+	// because a remote DON didn't reach quorum in time.
+	errorClassTimeout errorClass = "timeout"
+	// errorClassUnavailable is a capability which couldn't be reached, e.g. a remote
+	// capability whose dispatcher isn't ready yet.
+	errorClassUnavailable errorClass = "unavailable"
+	// errorClassCapability is any other error returned by the capability itself.
+	errorClassCapability errorClass = "capability"
+)
+
+var validErrorClasses = map[errorClass]bool{
+	errorClassTimeout:     true,
+	errorClassUnavailable: true,
+	errorClassCapability:  true,
+}
+
+// unavailableErrorMessages are substrings of errors, commonly returned by remote capabilities, which
+// indicate that the capability couldn't be reached. Remote errors arrive as strings, so they can't
+// be matched with errors.Is.
+var unavailableErrorMessages = []string{
+	"dispatcher not ready",
+	"failed to send request",
+	"client closed",
+	"connection refused",
+	"connection reset",
+	"no such host",
+}
+
+var timeoutErrorMessages = []string{
+	"request expired",
+	"context done before remote client received a quorum",
+	"deadline exceeded",
+}
+
+// classifyError returns the errorClass of an error returned by a capability.
+func classifyError(err error) errorClass {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errorClassTimeout
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, m := range timeoutErrorMessages {
+		if strings.Contains(msg, m) {
+			return errorClassTimeout
+		}
+	}
+	for _, m := range unavailableErrorMessages {
+		if strings.Contains(msg, m) {
+			return errorClassUnavailable
+		}
+	}
+	return errorClassCapability
+}
+
+// retryPolicy describes how a failed step is retried.
+type retryPolicy struct {
+	Backoff         backoffType
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	// Jitter is the fraction of each interval, between 0 and 1, which is randomized.
+	Jitter float64
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+	// MaxElapsedTime bounds the total time spent on all attempts; 0 means no bound.
+	MaxElapsedTime time.Duration
+	// RetryableErrors are the error classes which are retried; errors of any other class are terminal.
+	RetryableErrors map[errorClass]bool
+}
+
+// rawRetryPolicy is the representation of a retryPolicy in a step's config, e.g.
+//
+//	cre_retry_policy:
+//	  backoff: exponential
+//	  initial_interval: 1s
+//	  max_interval: 30s
+//	  multiplier: 2
+//	  jitter: 0.2
+//	  max_retries: 5
+//	  max_elapsed_time: 2m
+//	  retryable_errors: [timeout, unavailable]
+type rawRetryPolicy struct {
+	Backoff         string   `mapstructure:"backoff"`
+	InitialInterval string   `mapstructure:"initial_interval"`
+	MaxInterval     string   `mapstructure:"max_interval"`
+	Multiplier      *float64 `mapstructure:"multiplier"`
+	Jitter          float64  `mapstructure:"jitter"`
+	MaxRetries      *int     `mapstructure:"max_retries"`
+	MaxElapsedTime  string   `mapstructure:"max_elapsed_time"`
+	RetryableErrors []string `mapstructure:"retryable_errors"`
+}
+
+// parseRetryPolicy returns the retryPolicy declared in a step's config, or nil if the step doesn't declare one.
+func parseRetryPolicy(config map[string]any) (*retryPolicy, error) {
+	raw, ok := config[reservedFieldNameRetryPolicy]
+	if !ok {
+		return nil, nil
+	}
+
+	var rp rawRetryPolicy
+	if err := mapstructure.Decode(raw, &rp); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", reservedFieldNameRetryPolicy, err)
+	}
+
+	p := &retryPolicy{
+		Backoff:         backoffExponential,
+		InitialInterval: defaultRetryInitialInterval,
+		MaxInterval:     defaultRetryMaxInterval,
+		Multiplier:      defaultRetryBackoffMultiplier,
+		Jitter:          rp.Jitter,
+		MaxRetries:      defaultMaxStepRetries,
+		RetryableErrors: map[errorClass]bool{errorClassTimeout: true, errorClassUnavailable: true},
+	}
+
+	switch backoffType(rp.Backoff) {
+	case "":
+	case backoffFixed, backoffExponential:
+		p.Backoff = backoffType(rp.Backoff)
+	default:
+		return nil, fmt.Errorf("invalid backoff %q: must be one of %q, %q", rp.Backoff, backoffFixed, backoffExponential)
+	}
+
+	for _, d := range []struct {
+		name string
+		raw  string
+		to   *time.Duration
+	}{
+		{"initial_interval", rp.InitialInterval, &p.InitialInterval},
+		{"max_interval", rp.MaxInterval, &p.MaxInterval},
+		{"max_elapsed_time", rp.MaxElapsedTime, &p.MaxElapsedTime},
+	} {
+		if d.raw == "" {
+			continue
+		}
+		parsed, perr := time.ParseDuration(d.raw)
+		if perr != nil {
+			return nil, fmt.Errorf("invalid %s: %w", d.name, perr)
+		}
+		if parsed < 0 {
+			return nil, fmt.Errorf("invalid %s: must not be negative", d.name)
+		}
+		*d.to = parsed
+	}
+
+	if p.MaxInterval < p.InitialInterval {
+		return nil, fmt.Errorf("max_interval %s must not be less than initial_interval %s", p.MaxInterval, p.InitialInterval)
+	}
+
+	if rp.Multiplier != nil {
+		if *rp.Multiplier < 1 {
+			return nil, fmt.Errorf("invalid multiplier %v: must be at least 1", *rp.Multiplier)
+		}
+		p.Multiplier = *rp.Multiplier
+	}
+
+	if rp.Jitter < 0 || rp.Jitter > 1 {
+		return nil, fmt.Errorf("invalid jitter %v: must be between 0 and 1", rp.Jitter)
+	}
+
+	if rp.MaxRetries != nil {
+		if *rp.MaxRetries < 0 || *rp.MaxRetries > maxStepRetries {
+			return nil, fmt.Errorf("invalid max_retries %d: must be between 0 and %d", *rp.MaxRetries, maxStepRetries)
+		}
+		p.MaxRetries = *rp.MaxRetries
+	}
+
+	if rp.RetryableErrors != nil {
+		p.RetryableErrors = map[errorClass]bool{}
+		for _, c := range rp.RetryableErrors {
+			if !validErrorClasses[errorClass(c)] {
+				return nil, fmt.Errorf("invalid retryable error class %q", c)
+			}
+			p.RetryableErrors[errorClass(c)] = true
+		}
+	}
+
+	return p, nil
+}
+
+// interval returns how long to wait before the given retry, starting at 1.
+func (p retryPolicy) interval(retry int) time.Duration {
+	interval := p.InitialInterval
+	if p.Backoff == backoffExponential {
+		interval = time.Duration(float64(p.InitialInterval) * math.Pow(p.Multiplier, float64(retry-1)))
+		// guard against overflow for large retry counts
+		if interval > p.MaxInterval || interval <= 0 {
+			interval = p.MaxInterval
+		}
+	}
+
+	if p.Jitter > 0 {
+		delta := p.Jitter * float64(interval)
+		interval = time.Duration(float64(interval) - delta + rand.Float64()*2*delta) //nolint:gosec // jitter doesn't need a secure source
+	}
+	return interval
+}
+
+// isRetryable returns whether an error should be retried under the policy.
+//
+// ErrStopExecution signals a deliberate early exit of the workflow, and is never retried.
+func (p retryPolicy) isRetryable(err error) bool {
+	if capabilities.ErrStopExecution.Is(err) {
+		return false
+	}
+	return p.RetryableErrors[classifyError(err)]
+}
+
+// nextRetry returns how long to wait before retrying a step whose attempt, starting at 0, failed with err.
+//
+// It returns false if the step shouldn't be retried, i.e. the error is terminal or the policy's limits are
+// reached. elapsed is the time since the step's first attempt started.
+func (p retryPolicy) nextRetry(lggr logger.Logger, attempt int, elapsed time.Duration, err error) (time.Duration, bool) {
+	if !p.isRetryable(err) {
+		return 0, false
+	}
+
+	if attempt >= p.MaxRetries {
+		lggr.Errorw("step retries exhausted", "attempts", attempt+1, "err", err)
+		return 0, false
+	}
+
+	wait := p.interval(attempt + 1)
+	if p.MaxElapsedTime > 0 && elapsed+wait > p.MaxElapsedTime {
+		lggr.Errorw("step retry max elapsed time reached", "attempts", attempt+1, "maxElapsedTime", p.MaxElapsedTime, "err", err)
+		return 0, false
+	}
+
+	lggr.Warnw(fmt.Sprintf("%s, retrying in %.2fs", err, wait.Seconds()), "attempt", attempt+1, "errorClass", classifyError(err))
+	return wait, true
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
@@ -111,3 +113,149 @@ func TestRetryableCancellationHandling(t *testing.T) {
 	err := retryable(ctx, logger.NullLogger, 100, 5, fn)
 	assert.ErrorIs(t, err, context.Canceled, "Expected context cancellation error")
 }
+
+func TestParseRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no policy", func(t *testing.T) {
+		p, err := parseRetryPolicy(map[string]any{"foo": "bar"})
+		require.NoError(t, err)
+		assert.Nil(t, p)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		p, err := parseRetryPolicy(map[string]any{reservedFieldNameRetryPolicy: map[string]any{}})
+		require.NoError(t, err)
+		require.NotNil(t, p)
+		assert.Equal(t, backoffExponential, p.Backoff)
+		assert.Equal(t, defaultRetryInitialInterval, p.InitialInterval)
+		assert.Equal(t, defaultRetryMaxInterval, p.MaxInterval)
+		assert.Equal(t, defaultMaxStepRetries, p.MaxRetries)
+		assert.Equal(t, map[errorClass]bool{errorClassTimeout: true, errorClassUnavailable: true}, p.RetryableErrors)
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		p, err := parseRetryPolicy(map[string]any{reservedFieldNameRetryPolicy: map[string]any{
+			"backoff":          "fixed",
+			"initial_interval": "100ms",
+			"max_interval":     "1s",
+			"multiplier":       3,
+			"jitter":           0.5,
+			"max_retries":      0,
+			"max_elapsed_time": "10s",
+			"retryable_errors": []any{"capability"},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, &retryPolicy{
+			Backoff:         backoffFixed,
+			InitialInterval: 100 * time.Millisecond,
+			MaxInterval:     time.Second,
+			Multiplier:      3,
+			Jitter:          0.5,
+			MaxRetries:      0,
+			MaxElapsedTime:  10 * time.Second,
+			RetryableErrors: map[errorClass]bool{errorClassCapability: true},
+		}, p)
+	})
+
+	for name, raw := range map[string]map[string]any{
+		"invalid backoff":        {"backoff": "linear"},
+		"invalid duration":       {"initial_interval": "soon"},
+		"max below initial":      {"initial_interval": "10s", "max_interval": "1s"},
+		"multiplier below one":   {"multiplier": 0.5},
+		"jitter above one":       {"jitter": 1.5},
+		"too many retries":       {"max_retries": maxStepRetries + 1},
+		"unknown error class":    {"retryable_errors": []any{"everything"}},
+		"negative elapsed time":  {"max_elapsed_time": "-1s"},
+		"wrongly typed interval": {"initial_interval": []any{"1s"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseRetryPolicy(map[string]any{reservedFieldNameRetryPolicy: raw})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRetryPolicy_Interval(t *testing.T) {
+	t.Parallel()
+
+	p := retryPolicy{
+		Backoff:         backoffExponential,
+		InitialInterval: 100 * time.Millisecond,
+		MaxInterval:     time.Second,
+		Multiplier:      2,
+	}
+	assert.Equal(t, 100*time.Millisecond, p.interval(1))
+	assert.Equal(t, 200*time.Millisecond, p.interval(2))
+	assert.Equal(t, 800*time.Millisecond, p.interval(4))
+	assert.Equal(t, time.Second, p.interval(5))
+	assert.Equal(t, time.Second, p.interval(1000))
+
+	p.Backoff = backoffFixed
+	assert.Equal(t, 100*time.Millisecond, p.interval(5))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		interval := p.interval(1)
+		assert.GreaterOrEqual(t, interval, 50*time.Millisecond)
+		assert.LessOrEqual(t, interval, 150*time.Millisecond)
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, errorClassTimeout, classifyError(context.DeadlineExceeded))
+	assert.Equal(t, errorClassTimeout, classifyError(errors.New("error executing request: request expired by executable client")))
+	assert.Equal(t, errorClassUnavailable, classifyError(errors.New("failed to send request: dispatcher not ready")))
+	assert.Equal(t, errorClassCapability, classifyError(errors.New("invalid report")))
+}
+
+func TestRetryPolicy_NextRetry(t *testing.T) {
+	t.Parallel()
+
+	policy := retryPolicy{
+		Backoff:         backoffFixed,
+		InitialInterval: 10 * time.Millisecond,
+		MaxInterval:     10 * time.Millisecond,
+		MaxRetries:      3,
+		RetryableErrors: map[errorClass]bool{errorClassUnavailable: true},
+	}
+	unavailable := errors.New("dispatcher not ready")
+
+	t.Run("retries retryable errors", func(t *testing.T) {
+		for attempt := 0; attempt < policy.MaxRetries; attempt++ {
+			wait, ok := policy.nextRetry(logger.NullLogger, attempt, 0, unavailable)
+			require.True(t, ok)
+			assert.Equal(t, policy.InitialInterval, wait)
+		}
+	})
+
+	t.Run("stops once retries are exhausted", func(t *testing.T) {
+		_, ok := policy.nextRetry(logger.NullLogger, policy.MaxRetries, 0, unavailable)
+		assert.False(t, ok)
+	})
+
+	t.Run("does not retry terminal errors", func(t *testing.T) {
+		_, ok := policy.nextRetry(logger.NullLogger, 0, 0, errors.New("invalid report"))
+		assert.False(t, ok)
+	})
+
+	t.Run("does not retry early exits", func(t *testing.T) {
+		p := policy
+		p.RetryableErrors = map[errorClass]bool{errorClassCapability: true}
+		_, ok := p.nextRetry(logger.NullLogger, 0, 0, capabilities.ErrStopExecution)
+		assert.False(t, ok)
+	})
+
+	t.Run("stops at max elapsed time", func(t *testing.T) {
+		p := policy
+		p.MaxRetries = maxStepRetries
+		p.MaxElapsedTime = 25 * time.Millisecond
+
+		_, ok := p.nextRetry(logger.NullLogger, 1, 10*time.Millisecond, unavailable)
+		assert.True(t, ok)
+		_, ok = p.nextRetry(logger.NullLogger, 2, 20*time.Millisecond, unavailable)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,405 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commonservices "github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	valuespb "github.com/smartcontractkit/chainlink-common/pkg/values/pb"
+)
+
+// DBStore is a postgres-backed implementation of the Store interface. Unlike the InMemoryStore, executions
+// survive a node restart, which allows the engine to resume any unfinished executions via GetUnfinished.
+type DBStore struct {
+	lggr logger.Logger
+	commonservices.StateMachine
+	ds                sqlutil.DataSource
+	shutdownWaitGroup sync.WaitGroup
+	chStop            commonservices.StopChan
+
+	clock clockwork.Clock
+
+	// pruneInterval is the interval between pruning expired executions
+	pruneInterval time.Duration
+
+	// maximumExecutionAge is the age after which an execution is removed from the database. Finished
+	// executions are kept around until then so that they can be inspected after the fact.
+	maximumExecutionAge time.Duration
+}
+
+var _ Store = (*DBStore)(nil)
+
+type workflowExecutionRow struct {
+	ID         string     `db:"id"`
+	WorkflowID *string    `db:"workflow_id"`
+	Status     string     `db:"status"`
+	CreatedAt  *time.Time `db:"created_at"`
+	UpdatedAt  *time.Time `db:"updated_at"`
+	FinishedAt *time.Time `db:"finished_at"`
+}
+
+type workflowStepRow struct {
+	ID                  int64          `db:"id"`
+	WorkflowExecutionID string         `db:"workflow_execution_id"`
+	Ref                 string         `db:"ref"`
+	Status              string         `db:"status"`
+	Inputs              []byte         `db:"inputs"`
+	OutputErr           sql.NullString `db:"output_err"`
+	OutputValue         []byte         `db:"output_value"`
+	UpdatedAt           *time.Time     `db:"updated_at"`
+}
+
+func NewDBStore(ds sqlutil.DataSource, lggr logger.Logger, clock clockwork.Clock) *DBStore {
+	return NewDBStoreWithPruneConfiguration(ds, lggr, clock, defaultPruneInterval, maximumExecutionAge)
+}
+
+func NewDBStoreWithPruneConfiguration(ds sqlutil.DataSource, lggr logger.Logger, clock clockwork.Clock,
+	pruneFrequency time.Duration, maximumExecutionAge time.Duration) *DBStore {
+	return &DBStore{lggr: logger.Named(lggr, "WorkflowDBStore"), ds: ds, clock: clock, chStop: make(chan struct{}),
+		pruneInterval: pruneFrequency, maximumExecutionAge: maximumExecutionAge}
+}
+
+// Add adds a new execution state under the given executionID
+func (d *DBStore) Add(ctx context.Context, steps map[string]*WorkflowExecutionStep,
+	executionID string, workflowID string, status string) (WorkflowExecution, error) {
+	now := d.clock.Now()
+	err := sqlutil.TransactDataSource(ctx, d.ds, nil, func(tx sqlutil.DataSource) error {
+		res, err := tx.ExecContext(ctx, `
+			INSERT INTO workflow_executions (id, workflow_id, status, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $4)
+			ON CONFLICT (id) DO NOTHING`,
+			executionID, workflowID, status, now,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert execution %s: %w", executionID, err)
+		}
+
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return fmt.Errorf("execution ID %s already exists in store", executionID)
+		}
+
+		for _, step := range steps {
+			if err := upsertStep(ctx, tx, executionID, step, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return WorkflowExecution{}, err
+	}
+
+	return d.Get(ctx, executionID)
+}
+
+// UpsertStep updates a step for the given executionID
+func (d *DBStore) UpsertStep(ctx context.Context, step *WorkflowExecutionStep) (WorkflowExecution, error) {
+	now := d.clock.Now()
+	err := sqlutil.TransactDataSource(ctx, d.ds, nil, func(tx sqlutil.DataSource) error {
+		res, err := tx.ExecContext(ctx, `UPDATE workflow_executions SET updated_at = $1 WHERE id = $2`, now, step.ExecutionID)
+		if err != nil {
+			return fmt.Errorf("failed to update execution %s: %w", step.ExecutionID, err)
+		}
+
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return fmt.Errorf("could not find execution %s", step.ExecutionID)
+		}
+
+		return upsertStep(ctx, tx, step.ExecutionID, step, now)
+	})
+	if err != nil {
+		return WorkflowExecution{}, err
+	}
+
+	return d.Get(ctx, step.ExecutionID)
+}
+
+// FinishExecution marks the execution as finished with the given status
+func (d *DBStore) FinishExecution(ctx context.Context, executionID string, status string) (WorkflowExecution, error) {
+	if !isCompletedStatus(status) {
+		return WorkflowExecution{}, fmt.Errorf("invalid status for a finished execution %s", status)
+	}
+
+	now := d.clock.Now()
+	res, err := d.ds.ExecContext(ctx, `
+		UPDATE workflow_executions
+		SET status = $1, updated_at = $2, finished_at = $2
+		WHERE id = $3`,
+		status, now, executionID,
+	)
+	if err != nil {
+		return WorkflowExecution{}, fmt.Errorf("failed to finish execution %s: %w", executionID, err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return WorkflowExecution{}, err
+	}
+	if rows == 0 {
+		return WorkflowExecution{}, fmt.Errorf("could not find execution %s", executionID)
+	}
+
+	return d.Get(ctx, executionID)
+}
+
+// Get gets the state for the given executionID
+func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecution, error) {
+	var row workflowExecutionRow
+	err := d.ds.GetContext(ctx, &row, `SELECT * FROM workflow_executions WHERE id = $1`, executionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return WorkflowExecution{}, fmt.Errorf("could not find execution %s: %w", executionID, err)
+	}
+	if err != nil {
+		return WorkflowExecution{}, fmt.Errorf("failed to get execution %s: %w", executionID, err)
+	}
+
+	executions, err := d.withSteps(ctx, []workflowExecutionRow{row})
+	if err != nil {
+		return WorkflowExecution{}, err
+	}
+
+	return executions[0], nil
+}
+
+// GetUnfinished returns the executions of the given workflow which have not yet been finished, oldest first.
+func (d *DBStore) GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error) {
+	var rows []workflowExecutionRow
+	err := d.ds.SelectContext(ctx, &rows, `
+		SELECT * FROM workflow_executions
+		WHERE workflow_id = $1 AND status = $2
+		ORDER BY created_at ASC, id ASC
+		OFFSET $3 LIMIT $4`,
+		workflowID, StatusStarted, offset, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unfinished executions for workflow %s: %w", workflowID, err)
+	}
+
+	return d.withSteps(ctx, rows)
+}
+
+// withSteps loads the steps belonging to each of the given execution rows and converts them
+// into WorkflowExecutions, preserving the order of rows.
+func (d *DBStore) withSteps(ctx context.Context, rows []workflowExecutionRow) ([]WorkflowExecution, error) {
+	if len(rows) == 0 {
+		return []WorkflowExecution{}, nil
+	}
+
+	ids := make([]string, len(rows))
+	executions := make([]WorkflowExecution, len(rows))
+	idxByID := make(map[string]int, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+		idxByID[row.ID] = i
+
+		var workflowID string
+		if row.WorkflowID != nil {
+			workflowID = *row.WorkflowID
+		}
+		executions[i] = WorkflowExecution{
+			Steps:       map[string]*WorkflowExecutionStep{},
+			ExecutionID: row.ID,
+			WorkflowID:  workflowID,
+			Status:      row.Status,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+			FinishedAt:  row.FinishedAt,
+		}
+	}
+
+	var stepRows []workflowStepRow
+	err := d.ds.SelectContext(ctx, &stepRows,
+		`SELECT * FROM workflow_steps WHERE workflow_execution_id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get steps: %w", err)
+	}
+
+	for _, sr := range stepRows {
+		step, err := stepRowToStep(sr)
+		if err != nil {
+			return nil, err
+		}
+		executions[idxByID[sr.WorkflowExecutionID]].Steps[step.Ref] = step
+	}
+
+	return executions, nil
+}
+
+func upsertStep(ctx context.Context, ds sqlutil.DataSource, executionID string, step *WorkflowExecutionStep, now time.Time) error {
+	sr, err := stepToStepRow(executionID, step)
+	if err != nil {
+		return err
+	}
+
+	_, err = ds.ExecContext(ctx, `
+		INSERT INTO workflow_steps (workflow_execution_id, ref, status, inputs, output_err, output_value, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (workflow_execution_id, ref) DO UPDATE
+		SET status = EXCLUDED.status, inputs = EXCLUDED.inputs, output_err = EXCLUDED.output_err,
+			output_value = EXCLUDED.output_value, updated_at = EXCLUDED.updated_at`,
+		sr.WorkflowExecutionID, sr.Ref, sr.Status, sr.Inputs, sr.OutputErr, sr.OutputValue, now,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert step %s for execution %s: %w", step.Ref, executionID, err)
+	}
+	return nil
+}
+
+func stepToStepRow(executionID string, step *WorkflowExecutionStep) (workflowStepRow, error) {
+	sr := workflowStepRow{
+		WorkflowExecutionID: executionID,
+		Ref:                 step.Ref,
+		Status:              step.Status,
+	}
+
+	if step.Inputs != nil {
+		b, err := proto.Marshal(values.ProtoMap(step.Inputs))
+		if err != nil {
+			return workflowStepRow{}, fmt.Errorf("failed to marshal inputs for step %s: %w", step.Ref, err)
+		}
+		sr.Inputs = b
+	}
+
+	if step.Outputs.Value != nil {
+		b, err := proto.Marshal(values.Proto(step.Outputs.Value))
+		if err != nil {
+			return workflowStepRow{}, fmt.Errorf("failed to marshal outputs for step %s: %w", step.Ref, err)
+		}
+		sr.OutputValue = b
+	}
+
+	if step.Outputs.Err != nil {
+		sr.OutputErr = sql.NullString{String: step.Outputs.Err.Error(), Valid: true}
+	}
+
+	return sr, nil
+}
+
+func stepRowToStep(sr workflowStepRow) (*WorkflowExecutionStep, error) {
+	step := &WorkflowExecutionStep{
+		ExecutionID: sr.WorkflowExecutionID,
+		Ref:         sr.Ref,
+		Status:      sr.Status,
+		UpdatedAt:   sr.UpdatedAt,
+	}
+
+	if len(sr.Inputs) > 0 {
+		mpb := &valuespb.Map{}
+		if err := proto.Unmarshal(sr.Inputs, mpb); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal inputs for step %s: %w", sr.Ref, err)
+		}
+		inputs, err := values.FromMapValueProto(mpb)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode inputs for step %s: %w", sr.Ref, err)
+		}
+		step.Inputs = inputs
+	}
+
+	if len(sr.OutputValue) > 0 {
+		vpb := &valuespb.Value{}
+		if err := proto.Unmarshal(sr.OutputValue, vpb); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal outputs for step %s: %w", sr.Ref, err)
+		}
+		output, err := values.FromProto(vpb)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode outputs for step %s: %w", sr.Ref, err)
+		}
+		step.Outputs.Value = output
+	}
+
+	if sr.OutputErr.Valid {
+		step.Outputs.Err = errors.New(sr.OutputErr.String)
+	}
+
+	return step, nil
+}
+
+func (d *DBStore) Start(context.Context) error {
+	return d.StartOnce("WorkflowDBStore", func() error {
+		d.shutdownWaitGroup.Add(1)
+		go d.pruneExpiredExecutionEntries()
+		return nil
+	})
+}
+
+func (d *DBStore) Close() error {
+	return d.StopOnce("WorkflowDBStore", func() error {
+		close(d.chStop)
+		d.shutdownWaitGroup.Wait()
+		return nil
+	})
+}
+
+func (d *DBStore) Ready() error {
+	return nil
+}
+
+func (d *DBStore) HealthReport() map[string]error {
+	return map[string]error{d.Name(): d.Healthy()}
+}
+
+func (d *DBStore) Name() string {
+	return d.lggr.Name()
+}
+
+func (d *DBStore) pruneExpiredExecutionEntries() {
+	defer d.shutdownWaitGroup.Done()
+	ctx, cancel := d.chStop.NewCtx()
+	defer cancel()
+
+	ticker := d.clock.NewTicker(d.pruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.chStop:
+			return
+		case <-ticker.Chan():
+			expirationTime := d.clock.Now().Add(-d.maximumExecutionAge)
+
+			// Steps are removed along with their execution via the ON DELETE CASCADE constraint.
+			res, err := d.ds.ExecContext(ctx, `DELETE FROM workflow_executions WHERE status <> $1 AND updated_at < $2`,
+				StatusStarted, expirationTime)
+			if err != nil {
+				d.lggr.Errorw("Failed to prune finished workflow executions", "err", err)
+				continue
+			}
+			if rows, err := res.RowsAffected(); err == nil && rows > 0 {
+				d.lggr.Debugw("Pruned finished workflow executions", "count", rows)
+			}
+
+			// Prune non-terminated executions that are older than the maximum expiration time; these will have timed
+			// out long ago and would otherwise be resumed on every restart.
+			var prunedNonTerminatedExecutionIDs []string
+			err = d.ds.SelectContext(ctx, &prunedNonTerminatedExecutionIDs,
+				`DELETE FROM workflow_executions WHERE status = $1 AND updated_at < $2 RETURNING id`,
+				StatusStarted, expirationTime)
+			if err != nil {
+				d.lggr.Errorw("Failed to prune expired workflow executions", "err", err)
+				continue
+			}
+			if len(prunedNonTerminatedExecutionIDs) > 0 {
+				d.lggr.Warnw("Found and pruned non completed workflow executions older than the maximum execution age",
+					"maximumExecutionAge", d.maximumExecutionAge, "pruned execution ids", prunedNonTerminatedExecutionIDs)
+			}
+		}
+	}
+}
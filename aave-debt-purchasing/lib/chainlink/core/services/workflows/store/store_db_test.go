@@ -0,0 +1,146 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func newTestDBStore(t *testing.T, clock clockwork.Clock) (*DBStore, sqlutil.DataSource) {
+	db := pgtest.NewSqlxDB(t)
+	return NewDBStore(db, logger.TestLogger(t), clock), db
+}
+
+func createWorkflowSpec(t *testing.T, ds sqlutil.DataSource, workflowID string) {
+	_, err := ds.ExecContext(testutils.Context(t), `
+		INSERT INTO workflow_specs (workflow, workflow_id, workflow_owner, workflow_name, created_at, updated_at)
+		VALUES ('', $1, 'owner', $1, NOW(), NOW())`, workflowID)
+	require.NoError(t, err)
+}
+
+func TestDBStore_Add(t *testing.T) {
+	ctx := testutils.Context(t)
+	store, db := newTestDBStore(t, clockwork.NewFakeClock())
+	createWorkflowSpec(t, db, "w1")
+
+	outputs, err := values.NewMap(map[string]any{"price": 100})
+	require.NoError(t, err)
+
+	execution, err := store.Add(ctx, map[string]*WorkflowExecutionStep{
+		"trigger": {Ref: "trigger", ExecutionID: "test-id", Status: StatusCompleted, Outputs: StepOutput{Value: outputs}},
+	}, "test-id", "w1", StatusStarted)
+	require.NoError(t, err)
+	assert.NotZero(t, execution.CreatedAt)
+	assert.NotZero(t, execution.UpdatedAt)
+	assert.Equal(t, "test-id", execution.ExecutionID)
+	assert.Equal(t, "w1", execution.WorkflowID)
+	assert.Equal(t, StatusStarted, execution.Status)
+	require.Len(t, execution.Steps, 1)
+	assert.Equal(t, StatusCompleted, execution.Steps["trigger"].Status)
+	assert.Equal(t, outputs, execution.Steps["trigger"].Outputs.Value)
+
+	// Try adding the same execution ID again
+	_, err = store.Add(ctx, map[string]*WorkflowExecutionStep{}, "test-id", "w1", StatusStarted)
+	assert.Error(t, err)
+}
+
+func TestDBStore_UpsertStep(t *testing.T) {
+	ctx := testutils.Context(t)
+	store, db := newTestDBStore(t, clockwork.NewFakeClock())
+	createWorkflowSpec(t, db, "w1")
+
+	_, err := store.Add(ctx, map[string]*WorkflowExecutionStep{}, "test-id", "w1", StatusStarted)
+	require.NoError(t, err)
+
+	inputs, err := values.NewMap(map[string]any{"a": "b"})
+	require.NoError(t, err)
+	step := &WorkflowExecutionStep{
+		ExecutionID: "test-id",
+		Ref:         "step-1",
+		Status:      StatusErrored,
+		Inputs:      inputs,
+		Outputs:     StepOutput{Err: errors.New("boom")},
+	}
+	state, err := store.UpsertStep(ctx, step)
+	require.NoError(t, err)
+	require.Contains(t, state.Steps, "step-1")
+	assert.Equal(t, StatusErrored, state.Steps["step-1"].Status)
+	assert.Equal(t, inputs, state.Steps["step-1"].Inputs)
+	require.Error(t, state.Steps["step-1"].Outputs.Err)
+	assert.Equal(t, "boom", state.Steps["step-1"].Outputs.Err.Error())
+
+	// Upserting the same step again updates it in place
+	step.Status = StatusCompleted
+	step.Outputs = StepOutput{Value: values.NewString("ok")}
+	state, err = store.UpsertStep(ctx, step)
+	require.NoError(t, err)
+	require.Len(t, state.Steps, 1)
+	assert.Equal(t, StatusCompleted, state.Steps["step-1"].Status)
+	assert.Equal(t, values.NewString("ok"), state.Steps["step-1"].Outputs.Value)
+	assert.NoError(t, state.Steps["step-1"].Outputs.Err)
+
+	_, err = store.UpsertStep(ctx, &WorkflowExecutionStep{ExecutionID: "unknown-id", Ref: "step-1", Status: StatusCompleted})
+	assert.Error(t, err)
+}
+
+func TestDBStore_FinishExecution(t *testing.T) {
+	ctx := testutils.Context(t)
+	store, db := newTestDBStore(t, clockwork.NewFakeClock())
+	createWorkflowSpec(t, db, "w1")
+
+	_, err := store.Add(ctx, map[string]*WorkflowExecutionStep{}, "test-id", "w1", StatusStarted)
+	require.NoError(t, err)
+
+	_, err = store.FinishExecution(ctx, "test-id", StatusStarted)
+	require.Error(t, err)
+
+	state, err := store.FinishExecution(ctx, "test-id", StatusCompleted)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCompleted, state.Status)
+	assert.NotNil(t, state.FinishedAt)
+
+	_, err = store.FinishExecution(ctx, "unknown-id", StatusCompleted)
+	assert.Error(t, err)
+}
+
+func TestDBStore_GetUnfinished(t *testing.T) {
+	ctx := testutils.Context(t)
+	clock := clockwork.NewFakeClock()
+	store, db := newTestDBStore(t, clock)
+	createWorkflowSpec(t, db, "w1")
+	createWorkflowSpec(t, db, "w2")
+
+	for _, id := range []string{"e1", "e2", "e3"} {
+		_, err := store.Add(ctx, map[string]*WorkflowExecutionStep{
+			"trigger": {Ref: "trigger", ExecutionID: id, Status: StatusCompleted},
+		}, id, "w1", StatusStarted)
+		require.NoError(t, err)
+		clock.Advance(time.Second)
+	}
+	_, err := store.Add(ctx, map[string]*WorkflowExecutionStep{}, "e4", "w2", StatusStarted)
+	require.NoError(t, err)
+	_, err = store.FinishExecution(ctx, "e2", StatusCompleted)
+	require.NoError(t, err)
+
+	unfinished, err := store.GetUnfinished(ctx, "w1", 0, 100)
+	require.NoError(t, err)
+	require.Len(t, unfinished, 2)
+	assert.Equal(t, "e1", unfinished[0].ExecutionID)
+	assert.Equal(t, "e3", unfinished[1].ExecutionID)
+	assert.Contains(t, unfinished[0].Steps, "trigger")
+
+	unfinished, err = store.GetUnfinished(ctx, "w1", 1, 100)
+	require.NoError(t, err)
+	require.Len(t, unfinished, 1)
+	assert.Equal(t, "e3", unfinished[0].ExecutionID)
+}
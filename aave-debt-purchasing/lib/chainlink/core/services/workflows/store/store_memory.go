@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -136,6 +137,33 @@ func (s *InMemoryStore) Get(ctx context.Context, executionID string) (WorkflowEx
 	return execution.DeepCopy(), nil
 }
 
+// GetUnfinished returns the executions of the given workflow which have not yet been finished, oldest first.
+func (s *InMemoryStore) GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var unfinished []*WorkflowExecution
+	for _, execution := range s.idToExecution {
+		if execution.WorkflowID == workflowID && execution.Status == StatusStarted {
+			unfinished = append(unfinished, execution)
+		}
+	}
+
+	sort.Slice(unfinished, func(i, j int) bool {
+		if unfinished[i].CreatedAt.Equal(*unfinished[j].CreatedAt) {
+			return unfinished[i].ExecutionID < unfinished[j].ExecutionID
+		}
+		return unfinished[i].CreatedAt.Before(*unfinished[j].CreatedAt)
+	})
+
+	executions := []WorkflowExecution{}
+	for i := offset; i < len(unfinished) && len(executions) < limit; i++ {
+		executions = append(executions, unfinished[i].DeepCopy())
+	}
+
+	return executions, nil
+}
+
 func (s *InMemoryStore) Start(context.Context) error {
 	return s.StartOnce("InMemoryStore", func() error {
 		s.shutdownWaitGroup.Add(1)
@@ -119,3 +119,29 @@ func TestInMemoryStore_ExpiresNonCompletedExecutions(t *testing.T) {
 		return err2 != nil
 	}, 300*time.Millisecond, 50*time.Millisecond)
 }
+
+func TestInMemoryStore_GetUnfinished(t *testing.T) {
+	fakeClock := clockwork.NewFakeClock()
+	store := NewInMemoryStore(logger.TestLogger(t), fakeClock)
+
+	for _, id := range []string{"e1", "e2", "e3"} {
+		_, err := store.Add(context.Background(), map[string]*WorkflowExecutionStep{}, id, "w1", StatusStarted)
+		require.NoError(t, err)
+		fakeClock.Advance(1 * time.Second)
+	}
+	_, err := store.Add(context.Background(), map[string]*WorkflowExecutionStep{}, "e4", "w2", StatusStarted)
+	require.NoError(t, err)
+	_, err = store.FinishExecution(context.Background(), "e2", StatusCompleted)
+	require.NoError(t, err)
+
+	unfinished, err := store.GetUnfinished(context.Background(), "w1", 0, 100)
+	require.NoError(t, err)
+	require.Len(t, unfinished, 2)
+	assert.Equal(t, "e1", unfinished[0].ExecutionID)
+	assert.Equal(t, "e3", unfinished[1].ExecutionID)
+
+	unfinished, err = store.GetUnfinished(context.Background(), "w1", 1, 1)
+	require.NoError(t, err)
+	require.Len(t, unfinished, 1)
+	assert.Equal(t, "e3", unfinished[0].ExecutionID)
+}
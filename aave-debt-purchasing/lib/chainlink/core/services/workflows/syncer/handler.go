@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/artifacts"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/budgets"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncerlimiter"
@@ -115,6 +116,7 @@ type eventHandler struct {
 	engineFactory          engineFactoryFn
 	ratelimiter            *ratelimiter.RateLimiter
 	workflowLimits         *syncerlimiter.Limits
+	budgets                budgets.ORM
 	workflowArtifactsStore WorkflowArtifactsStore
 }
 
@@ -129,6 +131,13 @@ func WithEngineRegistry(er *EngineRegistry) func(*eventHandler) {
 	}
 }
 
+// WithBudgets enforces the spend budgets of workflows in the engines created by the handler.
+func WithBudgets(b budgets.ORM) func(*eventHandler) {
+	return func(e *eventHandler) {
+		e.budgets = b
+	}
+}
+
 func WithEngineFactoryFn(efn engineFactoryFn) func(*eventHandler) {
 	return func(e *eventHandler) {
 		e.engineFactory = efn
@@ -457,6 +466,7 @@ func (h *eventHandler) engineFactoryFn(ctx context.Context, workflowID string, o
 		SecretsFetcher: h.workflowArtifactsStore.SecretsFor,
 		RateLimiter:    h.ratelimiter,
 		WorkflowLimits: h.workflowLimits,
+		Budgets:        h.budgets,
 	}
 	return workflows.NewEngine(ctx, cfg)
 }
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE http_task_responses (
+    fingerprint TEXT PRIMARY KEY,
+    response BYTEA NOT NULL,
+    fetched_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_http_task_responses_expires_at ON http_task_responses (expires_at);
+
+-- +goose Down
+DROP TABLE http_task_responses;
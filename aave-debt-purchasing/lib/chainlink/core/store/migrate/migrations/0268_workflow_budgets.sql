@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE workflow_budgets (
+    owner TEXT NOT NULL,
+    -- workflow_id is empty for budgets covering every workflow of the owner
+    workflow_id TEXT NOT NULL DEFAULT '',
+    spend_unit TEXT NOT NULL,
+    spend_limit NUMERIC(78, 18) NOT NULL CHECK (spend_limit >= 0),
+    spent NUMERIC(78, 18) NOT NULL DEFAULT 0,
+    reset_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (owner, workflow_id, spend_unit)
+);
+
+-- +goose Down
+DROP TABLE workflow_budgets;
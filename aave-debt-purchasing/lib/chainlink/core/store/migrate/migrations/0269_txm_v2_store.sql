@@ -0,0 +1,49 @@
+-- +goose Up
+CREATE TABLE evm.txm_v2_txes (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78, 0) NOT NULL,
+    idempotency_key TEXT,
+    -- nonce is assigned when the transaction leaves the unstarted state
+    nonce BIGINT,
+    from_address BYTEA NOT NULL,
+    to_address BYTEA NOT NULL,
+    value NUMERIC(78, 0) NOT NULL,
+    data BYTEA NOT NULL,
+    specified_gas_limit BIGINT NOT NULL,
+    state TEXT NOT NULL,
+    is_purgeable BOOLEAN NOT NULL DEFAULT FALSE,
+    meta JSONB,
+    subject UUID,
+    pipeline_task_run_id UUID,
+    min_confirmations INTEGER,
+    signal_callback BOOLEAN NOT NULL DEFAULT FALSE,
+    callback_completed BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL,
+    initial_broadcast_at TIMESTAMPTZ,
+    last_broadcast_at TIMESTAMPTZ,
+    CONSTRAINT chk_txm_v2_txes_nonce CHECK (state = 'unstarted' OR nonce IS NOT NULL)
+);
+
+CREATE UNIQUE INDEX idx_txm_v2_txes_idempotency_key ON evm.txm_v2_txes (evm_chain_id, idempotency_key) WHERE idempotency_key IS NOT NULL;
+CREATE UNIQUE INDEX idx_txm_v2_txes_unconfirmed_nonce ON evm.txm_v2_txes (evm_chain_id, from_address, nonce) WHERE state = 'unconfirmed';
+CREATE INDEX idx_txm_v2_txes_state ON evm.txm_v2_txes (evm_chain_id, from_address, state, nonce);
+
+CREATE TABLE evm.txm_v2_tx_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    tx_id BIGINT NOT NULL REFERENCES evm.txm_v2_txes (id) ON DELETE CASCADE,
+    hash BYTEA NOT NULL,
+    gas_price NUMERIC(78, 0),
+    gas_tip_cap NUMERIC(78, 0),
+    gas_fee_cap NUMERIC(78, 0),
+    gas_limit BIGINT NOT NULL,
+    tx_type SMALLINT NOT NULL,
+    signed_raw_tx BYTEA,
+    created_at TIMESTAMPTZ NOT NULL,
+    broadcast_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_txm_v2_tx_attempts_tx_id ON evm.txm_v2_tx_attempts (tx_id);
+
+-- +goose Down
+DROP TABLE evm.txm_v2_tx_attempts;
+DROP TABLE evm.txm_v2_txes;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE evm.txm_v2_txes ADD COLUMN priority SMALLINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE evm.txm_v2_txes DROP COLUMN priority;
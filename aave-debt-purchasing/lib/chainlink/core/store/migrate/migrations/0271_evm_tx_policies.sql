@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE evm.tx_policies (
+    evm_chain_id NUMERIC(78, 0) NOT NULL,
+    address BYTEA NOT NULL,
+    -- empty allow lists allow any destination or function selector
+    allowed_destinations BYTEA[] NOT NULL DEFAULT '{}',
+    allowed_selectors BYTEA[] NOT NULL DEFAULT '{}',
+    max_value NUMERIC(78, 0) CHECK (max_value >= 0),
+    daily_spend_limit NUMERIC(78, 0) CHECK (daily_spend_limit >= 0),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (evm_chain_id, address)
+);
+
+-- +goose Down
+DROP TABLE evm.tx_policies;
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE job_spec_versions (
+    id BIGSERIAL PRIMARY KEY,
+    -- no foreign key to jobs: updating a job deletes and recreates its row under the same ID,
+    -- which must not delete its history. Versions are deleted along with the job instead.
+    job_id INT NOT NULL,
+    version INT NOT NULL CHECK (version > 0),
+    spec TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (job_id, version)
+);
+
+-- +goose Down
+DROP TABLE job_spec_versions;
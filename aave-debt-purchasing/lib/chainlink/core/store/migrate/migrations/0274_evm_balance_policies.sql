@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE evm.balance_policies (
+    evm_chain_id NUMERIC(78, 0) NOT NULL,
+    address BYTEA NOT NULL,
+    low_balance NUMERIC(78, 0) NOT NULL CHECK (low_balance >= 0),
+    critical_balance NUMERIC(78, 0) NOT NULL CHECK (critical_balance >= 0 AND critical_balance <= low_balance),
+    -- the key is topped up by the funding key only if all three are set
+    funding_address BYTEA CHECK (funding_address <> address),
+    top_up_amount NUMERIC(78, 0) CHECK (top_up_amount > 0),
+    daily_top_up_limit NUMERIC(78, 0) CHECK (daily_top_up_limit >= top_up_amount),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (evm_chain_id, address),
+    CONSTRAINT chk_top_up CHECK (
+        (funding_address IS NULL) = (top_up_amount IS NULL) AND (funding_address IS NULL) = (daily_top_up_limit IS NULL)
+    )
+);
+
+-- +goose Down
+DROP TABLE evm.balance_policies;
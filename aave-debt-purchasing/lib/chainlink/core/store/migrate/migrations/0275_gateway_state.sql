@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE gateway_rate_limit_buckets(
+    key TEXT PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    full_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_gateway_rate_limit_buckets_full_at ON gateway_rate_limit_buckets(full_at);
+
+CREATE TABLE gateway_requests(
+    key TEXT PRIMARY KEY,
+    owner TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_gateway_requests_expires_at ON gateway_requests(expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS gateway_requests;
+DROP TABLE IF EXISTS gateway_rate_limit_buckets;
+-- +goose StatementEnd
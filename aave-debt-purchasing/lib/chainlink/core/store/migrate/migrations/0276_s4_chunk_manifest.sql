@@ -0,0 +1,18 @@
+-- +goose Up
+
+-- The chunks referenced by each stored manifest of a chunked record, see core/services/s4/chunked.go
+CREATE TABLE "s4".shared_chunk_refs(
+    namespace TEXT NOT NULL,
+    address NUMERIC(78,0) NOT NULL,
+    manifest_slot_id INT NOT NULL,
+    manifest_version NUMERIC NOT NULL,
+    slot_id INT NOT NULL,
+    version NUMERIC NOT NULL,
+    PRIMARY KEY (namespace, address, manifest_slot_id, manifest_version, slot_id)
+);
+
+CREATE INDEX shared_chunk_refs_namespace_address_slot_id_idx ON "s4".shared_chunk_refs(namespace, address, slot_id, version);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS "s4".shared_chunk_refs;
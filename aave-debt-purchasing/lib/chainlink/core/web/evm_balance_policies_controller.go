@@ -0,0 +1,137 @@
+package web
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/balance"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// EVMBalancePoliciesController manages the balance thresholds of the keys of the node, and
+// the top-ups sent to them by funding keys.
+type EVMBalancePoliciesController struct {
+	App chainlink.Application
+}
+
+// UpsertEVMBalancePolicyRequest is a request to create or replace the balance policy of a key
+// on a chain. The key is topped up only if FundingAddress, TopUpAmount and DailyTopUpLimit
+// are set.
+type UpsertEVMBalancePolicyRequest struct {
+	EVMChainID      *ubig.Big       `json:"evmChainID"`
+	Address         common.Address  `json:"address"`
+	LowBalance      *ubig.Big       `json:"lowBalance"`
+	CriticalBalance *ubig.Big       `json:"criticalBalance"`
+	FundingAddress  *common.Address `json:"fundingAddress"`
+	TopUpAmount     *ubig.Big       `json:"topUpAmount"`
+	DailyTopUpLimit *ubig.Big       `json:"dailyTopUpLimit"`
+}
+
+func (pc *EVMBalancePoliciesController) orm() balance.ORM {
+	return balance.NewORM(pc.App.GetDB())
+}
+
+// Index lists the balance policies of every chain, or of the chain given as a query parameter.
+// Example:
+//
+//	"<application>/v2/evm/balance_policies?evmChainID=1"
+func (pc *EVMBalancePoliciesController) Index(c *gin.Context) {
+	chainID, err := parseOptionalChainID(c.Query("evmChainID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ps, err := pc.orm().GetPolicies(c.Request.Context(), chainID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEVMBalancePolicyResources(ps), "evmBalancePolicies")
+}
+
+// Upsert creates or replaces the balance policy of a key on a chain.
+// Example:
+//
+//	"<application>/v2/evm/balance_policies"
+func (pc *EVMBalancePoliciesController) Upsert(c *gin.Context) {
+	request := UpsertEVMBalancePolicyRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.EVMChainID == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, ErrEmptyChainID)
+		return
+	}
+	if _, err := getChain(pc.App.GetRelayers().LegacyEVMChains(), request.EVMChainID.String()); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Address == (common.Address{}) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'address'"))
+		return
+	}
+	if request.LowBalance == nil || request.CriticalBalance == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'lowBalance' or 'criticalBalance'"))
+		return
+	}
+	if request.FundingAddress != nil {
+		if err := pc.App.GetKeyStore().Eth().CheckEnabled(c.Request.Context(), *request.FundingAddress, request.EVMChainID.ToInt()); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	p, err := pc.orm().UpsertPolicy(c.Request.Context(), balance.Policy{
+		EVMChainID:      *request.EVMChainID,
+		Address:         request.Address,
+		LowBalance:      *request.LowBalance,
+		CriticalBalance: *request.CriticalBalance,
+		FundingAddress:  request.FundingAddress,
+		TopUpAmount:     request.TopUpAmount,
+		DailyTopUpLimit: request.DailyTopUpLimit,
+	})
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEVMBalancePolicyResource(p), "evmBalancePolicies")
+}
+
+// Delete removes the balance policy of a key on a chain, given as query parameters.
+// Example:
+//
+//	"<application>/v2/evm/balance_policies?evmChainID=1&address=0x..."
+func (pc *EVMBalancePoliciesController) Delete(c *gin.Context) {
+	chainID, err := parseOptionalChainID(c.Query("evmChainID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	address := c.Query("address")
+	if chainID == nil || !common.IsHexAddress(address) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'evmChainID' or 'address' parameter"))
+		return
+	}
+
+	err = pc.orm().DeletePolicy(c.Request.Context(), chainID, common.HexToAddress(address))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("balance policy not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "evmBalancePolicies", http.StatusNoContent)
+}
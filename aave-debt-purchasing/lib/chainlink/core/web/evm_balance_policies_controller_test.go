@@ -0,0 +1,79 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestEVMBalancePoliciesController(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+	_, address := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	_, funding := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	chainID := testutils.FixtureChainID.String()
+
+	t.Run("sets a policy", func(t *testing.T) {
+		body := fmt.Sprintf(`{"evmChainID": "%s", "address": "%s", "lowBalance": "100", "criticalBalance": "10", "fundingAddress": "%s", "topUpAmount": "50", "dailyTopUpLimit": "100"}`,
+			chainID, address.Hex(), funding.Hex())
+		resp, cleanup := client.Put("/v2/evm/balance_policies", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var policy presenters.EVMBalancePolicyResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &policy))
+		assert.Equal(t, chainID+"/"+address.Hex(), policy.ID)
+		assert.Equal(t, "100", policy.LowBalance.String())
+		require.NotNil(t, policy.FundingAddress)
+		assert.Equal(t, funding, *policy.FundingAddress)
+	})
+
+	t.Run("rejects an unknown funding key", func(t *testing.T) {
+		body := fmt.Sprintf(`{"evmChainID": "%s", "address": "%s", "lowBalance": "100", "criticalBalance": "10", "fundingAddress": "%s", "topUpAmount": "50", "dailyTopUpLimit": "100"}`,
+			chainID, address.Hex(), testutils.NewAddress().Hex())
+		resp, cleanup := client.Put("/v2/evm/balance_policies", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("rejects invalid thresholds", func(t *testing.T) {
+		body := fmt.Sprintf(`{"evmChainID": "%s", "address": "%s", "lowBalance": "10", "criticalBalance": "100"}`, chainID, address.Hex())
+		resp, cleanup := client.Put("/v2/evm/balance_policies", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+	})
+
+	t.Run("lists policies", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/evm/balance_policies?evmChainID=" + chainID)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var policies []presenters.EVMBalancePolicyResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &policies))
+		require.Len(t, policies, 1)
+		assert.Equal(t, address, policies[0].Address)
+	})
+
+	t.Run("deletes a policy", func(t *testing.T) {
+		path := fmt.Sprintf("/v2/evm/balance_policies?evmChainID=%s&address=%s", chainID, address.Hex())
+		resp, cleanup := client.Delete(path)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+		resp, cleanup = client.Delete(path)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
@@ -0,0 +1,132 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// EVMNonceRepairController plans and applies the repair of the nonce gaps and stuck transactions
+// of the keys of the node.
+type EVMNonceRepairController struct {
+	App chainlink.Application
+}
+
+// RepairEVMNoncesRequest approves the repair of the nonces of a key. Every nonce of the current
+// plan of the key is repaired if Nonces is empty.
+type RepairEVMNoncesRequest struct {
+	EVMChainID *ubig.Big        `json:"evmChainID"`
+	Address    common.Address   `json:"address"`
+	Nonces     []evmtypes.Nonce `json:"nonces"`
+}
+
+func (rc *EVMNonceRepairController) repairer(chainID string) (*txmgr.NonceRepairer, *ubig.Big, error) {
+	chain, err := getChain(rc.App.GetRelayers().LegacyEVMChains(), chainID)
+	if err != nil {
+		return nil, nil, err
+	}
+	repairer := chain.NonceRepairer()
+	if repairer == nil {
+		return nil, nil, fmt.Errorf("transactions are disabled for chain %s", chain.ID())
+	}
+	return repairer, ubig.New(chain.ID()), nil
+}
+
+// Plan returns the repair plans of the keys of a chain, or of the key given as a query parameter.
+// Keys with nothing to repair are left out.
+// Example:
+//
+//	"<application>/v2/evm/nonce_repair?evmChainID=1&address=0x..."
+func (rc *EVMNonceRepairController) Plan(c *gin.Context) {
+	repairer, chainID, err := rc.repairer(c.Query("evmChainID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	var addresses []common.Address
+	if address := c.Query("address"); address != "" {
+		if !common.IsHexAddress(address) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid 'address' parameter"))
+			return
+		}
+		addresses = append(addresses, common.HexToAddress(address))
+	}
+
+	plans, err := repairer.Plan(c.Request.Context(), addresses...)
+	if errors.Is(err, txmgr.ErrNonceRepairKeyDisabled) || errors.Is(err, txmgr.ErrNonceRepairTxmV2) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEVMNonceRepairPlanResources(*chainID, plans), "evmNonceRepairPlans")
+}
+
+// Repair applies the current repair plan of a key, restricted to the approved nonces.
+// Example:
+//
+//	"<application>/v2/evm/nonce_repair"
+func (rc *EVMNonceRepairController) Repair(c *gin.Context) {
+	request := RepairEVMNoncesRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.EVMChainID == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, ErrEmptyChainID)
+		return
+	}
+	if request.Address == (common.Address{}) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'address'"))
+		return
+	}
+	repairer, chainID, err := rc.repairer(request.EVMChainID.String())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	plans, err := repairer.Plan(ctx, request.Address)
+	if errors.Is(err, txmgr.ErrNonceRepairKeyDisabled) || errors.Is(err, txmgr.ErrNonceRepairTxmV2) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	plan := txmgr.NonceRepairPlan{Address: request.Address}
+	if len(plans) > 0 {
+		plan = plans[0]
+	}
+	if len(request.Nonces) > 0 {
+		plan = plan.Retain(request.Nonces)
+	}
+
+	if err = repairer.Execute(ctx, plan); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.EthNoncesRepaired, map[string]interface{}{
+		"evmChainID": chainID,
+		"address":    plan.Address,
+		"nonces":     plan.Nonces(),
+	})
+
+	jsonAPIResponse(c, presenters.NewEVMNonceRepairPlanResource(*chainID, plan), "evmNonceRepairPlans")
+}
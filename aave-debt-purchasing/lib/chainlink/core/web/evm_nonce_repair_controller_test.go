@@ -0,0 +1,67 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestEVMNonceRepairController(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	chainID := testutils.FixtureChainID.String()
+
+	t.Run("lists no plan for keys with nothing to repair", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/evm/nonce_repair?evmChainID=%s&address=%s", chainID, from.Hex()))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var plans []presenters.EVMNonceRepairPlanResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &plans))
+		assert.Empty(t, plans)
+	})
+
+	t.Run("rejects an unknown chain", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/evm/nonce_repair?evmChainID=1234")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("rejects a key which is not enabled", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/evm/nonce_repair?evmChainID=%s&address=%s", chainID, testutils.NewAddress().Hex()))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("rejects a repair without address", func(t *testing.T) {
+		body := fmt.Sprintf(`{"evmChainID": "%s"}`, chainID)
+		resp, cleanup := client.Post("/v2/evm/nonce_repair", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("repairs nothing for keys with nothing to repair", func(t *testing.T) {
+		body := fmt.Sprintf(`{"evmChainID": "%s", "address": "%s"}`, chainID, from.Hex())
+		resp, cleanup := client.Post("/v2/evm/nonce_repair", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var plan presenters.EVMNonceRepairPlanResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &plan))
+		assert.Equal(t, from, plan.Address)
+		assert.Empty(t, plan.Steps)
+	})
+}
@@ -0,0 +1,156 @@
+package web
+
+import (
+	"database/sql"
+	"errors"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// EVMTxPoliciesController manages the policies restricting the transactions sent by the
+// keys of the node, and lists the transactions that violated them.
+type EVMTxPoliciesController struct {
+	App chainlink.Application
+}
+
+// UpsertEVMTxPolicyRequest is a request to create or replace the policy of a key on a chain.
+type UpsertEVMTxPolicyRequest struct {
+	EVMChainID          *ubig.Big        `json:"evmChainID"`
+	Address             common.Address   `json:"address"`
+	AllowedDestinations []common.Address `json:"allowedDestinations"`
+	AllowedSelectors    []hexutil.Bytes  `json:"allowedSelectors"`
+	MaxValue            *ubig.Big        `json:"maxValue"`
+	DailySpendLimit     *ubig.Big        `json:"dailySpendLimit"`
+}
+
+func (pc *EVMTxPoliciesController) orm() txmgr.TxPolicyORM {
+	return txmgr.NewTxPolicyORM(pc.App.GetDB(), pc.App.GetLogger())
+}
+
+// Index lists the policies of every chain, or of the chain given as a query parameter.
+// Example:
+//
+//	"<application>/v2/evm/tx_policies?evmChainID=1"
+func (pc *EVMTxPoliciesController) Index(c *gin.Context) {
+	chainID, err := parseOptionalChainID(c.Query("evmChainID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ps, err := pc.orm().GetPolicies(c.Request.Context(), chainID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEVMTxPolicyResources(ps), "evmTxPolicies")
+}
+
+// Upsert creates or replaces the policy of a key on a chain.
+// Example:
+//
+//	"<application>/v2/evm/tx_policies"
+func (pc *EVMTxPoliciesController) Upsert(c *gin.Context) {
+	request := UpsertEVMTxPolicyRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.EVMChainID == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, ErrEmptyChainID)
+		return
+	}
+	if _, err := getChain(pc.App.GetRelayers().LegacyEVMChains(), request.EVMChainID.String()); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Address == (common.Address{}) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'address'"))
+		return
+	}
+
+	p, err := pc.orm().UpsertPolicy(c.Request.Context(), txmgr.TxPolicy{
+		EVMChainID:          *request.EVMChainID,
+		Address:             request.Address,
+		AllowedDestinations: request.AllowedDestinations,
+		AllowedSelectors:    request.AllowedSelectors,
+		MaxValue:            request.MaxValue,
+		DailySpendLimit:     request.DailySpendLimit,
+	})
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEVMTxPolicyResource(p), "evmTxPolicies")
+}
+
+// Delete removes the policy of a key on a chain, given as query parameters.
+// Example:
+//
+//	"<application>/v2/evm/tx_policies?evmChainID=1&address=0x..."
+func (pc *EVMTxPoliciesController) Delete(c *gin.Context) {
+	chainID, err := parseOptionalChainID(c.Query("evmChainID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	address := c.Query("address")
+	if chainID == nil || !common.IsHexAddress(address) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'evmChainID' or 'address' parameter"))
+		return
+	}
+
+	err = pc.orm().DeletePolicy(c.Request.Context(), chainID, common.HexToAddress(address))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("tx policy not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "evmTxPolicies", http.StatusNoContent)
+}
+
+// Violations returns the paginated transactions that were fatally errored for violating a
+// policy, of every chain or of the chain given as a query parameter.
+// Example:
+//
+//	"<application>/v2/evm/tx_policies/violations?evmChainID=1"
+func (pc *EVMTxPoliciesController) Violations(c *gin.Context, size, page, offset int) {
+	chainID, err := parseOptionalChainID(c.Query("evmChainID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	txs, count, err := pc.orm().FindViolations(c.Request.Context(), chainID, offset, size)
+	violations := make([]presenters.EVMTxPolicyViolationResource, 0, len(txs))
+	for _, tx := range txs {
+		violations = append(violations, presenters.NewEVMTxPolicyViolationResource(tx))
+	}
+	paginatedResponse(c, "evmTxPolicyViolations", size, page, violations, count, err)
+}
+
+func parseOptionalChainID(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	chainID, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, ErrInvalidChainID
+	}
+	return chainID, nil
+}
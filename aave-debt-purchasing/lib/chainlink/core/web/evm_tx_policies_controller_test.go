@@ -0,0 +1,103 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestEVMTxPoliciesController(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	chainID := testutils.FixtureChainID.String()
+	destination := testutils.NewAddress()
+
+	t.Run("sets a policy", func(t *testing.T) {
+		body := fmt.Sprintf(`{"evmChainID": "%s", "address": "%s", "allowedDestinations": ["%s"], "allowedSelectors": ["0x01020304"], "maxValue": "100"}`, chainID, from.Hex(), destination.Hex())
+		resp, cleanup := client.Put("/v2/evm/tx_policies", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var policy presenters.EVMTxPolicyResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &policy))
+		assert.Equal(t, chainID+"/"+from.Hex(), policy.ID)
+		assert.Len(t, policy.AllowedDestinations, 1)
+		assert.Equal(t, "100", policy.MaxValue.String())
+		assert.Nil(t, policy.DailySpendLimit)
+	})
+
+	t.Run("rejects an unknown chain", func(t *testing.T) {
+		body := fmt.Sprintf(`{"evmChainID": "1234", "address": "%s"}`, from.Hex())
+		resp, cleanup := client.Put("/v2/evm/tx_policies", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("rejects a malformed selector", func(t *testing.T) {
+		body := fmt.Sprintf(`{"evmChainID": "%s", "address": "%s", "allowedSelectors": ["0x01"]}`, chainID, from.Hex())
+		resp, cleanup := client.Put("/v2/evm/tx_policies", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+	})
+
+	t.Run("lists policies", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/evm/tx_policies?evmChainID=" + chainID)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var policies []presenters.EVMTxPolicyResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &policies))
+		require.Len(t, policies, 1)
+		assert.Equal(t, from, policies[0].Address)
+	})
+
+	t.Run("lists violations", func(t *testing.T) {
+		txStore := cltest.NewTestTxStore(t, app.GetDB())
+		etx := cltest.NewEthTx(from)
+		etx.ChainID = testutils.FixtureChainID
+		etx.State = txmgrcommon.TxFatalError
+		etx.Error = null.StringFrom(txmgr.ErrTxPolicyViolation.Error() + ": value exceeds the max value")
+		require.NoError(t, txStore.InsertTx(ctx, &etx))
+
+		resp, cleanup := client.Get("/v2/evm/tx_policies/violations?size=10")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var links jsonapi.Links
+		var violations []presenters.EVMTxPolicyViolationResource
+		require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &violations, &links))
+		require.Len(t, violations, 1)
+		assert.Equal(t, from, violations[0].From)
+		assert.Equal(t, etx.Error.String, violations[0].Error)
+	})
+
+	t.Run("deletes a policy", func(t *testing.T) {
+		path := fmt.Sprintf("/v2/evm/tx_policies?evmChainID=%s&address=%s", chainID, from.Hex())
+		resp, cleanup := client.Delete(path)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+		resp, cleanup = client.Delete(path)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
@@ -5,7 +5,6 @@ import (
 	"database/sql"
 	"encoding/json"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -27,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -117,7 +117,7 @@ func (jc *JobsController) Create(c *gin.Context) {
 	defer cancel()
 	err = jc.App.AddJobV2(ctx, &jb)
 	if err != nil {
-		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
+		if isJobKeyError(err) {
 			jsonAPIError(c, http.StatusBadRequest, err)
 			return
 		}
@@ -125,6 +125,12 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
+	// The spec history starts with the spec the job was created with. The job is already
+	// running at this point, so failing to record it is not fatal.
+	if _, err = jc.App.JobORM().InsertSpecVersion(ctx, jb.ID, request.TOML); err != nil {
+		jc.App.GetLogger().Errorw("Failed to record job spec version", "jobID", jb.ID, "err", err)
+	}
+
 	jbj, err := json.Marshal(jb)
 	if err == nil {
 		jc.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
@@ -161,12 +167,61 @@ func (jc *JobsController) Delete(c *gin.Context) {
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
 
+// Pause stops the services of a job without deleting it. Paused jobs are not started on boot.
+// Example:
+// "POST <application>/jobs/:ID/pause"
+func (jc *JobsController) Pause(c *gin.Context) {
+	jc.setPaused(c, true)
+}
+
+// Resume starts the services of a paused job again.
+// Example:
+// "POST <application>/jobs/:ID/resume"
+func (jc *JobsController) Resume(c *gin.Context) {
+	jc.setPaused(c, false)
+}
+
+func (jc *JobsController) setPaused(c *gin.Context, paused bool) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	var err error
+	event := audit.JobResumed
+	if paused {
+		err = jc.App.JobSpawner().PauseJob(ctx, nil, j.ID)
+		event = audit.JobPaused
+	} else {
+		err = jc.App.JobSpawner().ResumeJob(ctx, nil, j.ID)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jc.App.GetAuditLogger().Audit(event, map[string]interface{}{"id": j.ID})
+
+	jb, err := jc.App.JobORM().FindJob(ctx, j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewJobResource(jb), "jobs")
+}
+
 // UpdateJobRequest represents a request to update a job with new toml and start a job (V2).
 type UpdateJobRequest struct {
 	TOML string `json:"toml"`
 }
 
-// Update validates a new TOML for an existing job, stops and deletes existing job, saves and starts a new job.
+// Update validates a new TOML for an existing job and replaces the job with it, keeping its ID.
+// The old job keeps running if the new one cannot be saved or its services cannot be created.
 // Example:
 // "PUT <application>/jobs/:ID"
 func (jc *JobsController) Update(c *gin.Context) {
@@ -188,14 +243,88 @@ func (jc *JobsController) Update(c *gin.Context) {
 		return
 	}
 
+	jc.updateJob(c, jb, request.TOML)
+}
+
+// Versions lists the versions of the spec of a job, oldest first, each with a diff
+// against the previous version.
+// Example:
+// "GET <application>/jobs/:ID/versions"
+func (jc *JobsController) Versions(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	versions, err := jc.App.JobORM().FindSpecVersions(c.Request.Context(), j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(versions) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.New("no spec versions found for job"))
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSpecVersionResources(versions), "jobSpecVersions")
+}
+
+// RollbackJobRequest represents a request to roll a job back to a previous version of its spec.
+type RollbackJobRequest struct {
+	Version int32 `json:"version"`
+}
+
+// Rollback replaces a job with a previous version of its spec. The rollback is recorded
+// as a new version, so the history of the spec is never rewritten.
+// Example:
+// "POST <application>/jobs/:ID/rollback"
+func (jc *JobsController) Rollback(c *gin.Context) {
+	request := RollbackJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	version, err := jc.App.JobORM().FindSpecVersion(ctx, j.ID, request.Version)
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("version %d of job %d not found", request.Version, j.ID))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(ctx, version.Spec)
+	if err != nil {
+		jsonAPIError(c, status, errors.Wrapf(err, "version %d is no longer valid", request.Version))
+		return
+	}
+	jb.ID = j.ID
+
+	jc.updateJob(c, jb, version.Spec)
+}
+
+func (jc *JobsController) updateJob(c *gin.Context, jb job.Job, spec string) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
-	// If the provided job id is not matching any job, delete will fail with 404 leaving state unchanged.
-	err = jc.App.DeleteJob(ctx, jb.ID)
-	// Error can be either come from ORM or from the activeJobs map.
+	err := jc.App.UpdateJobV2(ctx, jb.ID, &jb, spec)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) || strings.Contains(err.Error(), "job not found") {
+		if isJobKeyError(err) || errors.Is(err, job.ErrJobPaused) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		// If the provided job id is not matching any job, the update fails leaving state unchanged.
+		if errors.Is(err, sql.ErrNoRows) {
 			jsonAPIError(c, http.StatusNotFound, errors.Wrap(err, "failed to update job"))
 			return
 		}
@@ -203,17 +332,73 @@ func (jc *JobsController) Update(c *gin.Context) {
 		return
 	}
 
-	err = jc.App.AddJobV2(ctx, &jb)
+	jbj, err := json.Marshal(jb)
+	if err == nil {
+		jc.App.GetAuditLogger().Audit(audit.JobUpdated, map[string]interface{}{"job": string(jbj)})
+	} else {
+		jc.App.GetLogger().Errorw("Could not send audit log for JobUpdate", "err", err)
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// isJobKeyError reports whether err is caused by a key missing for a job.
+func isJobKeyError(err error) bool {
+	return errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey)
+}
+
+// SimulateJobRequest represents a request to simulate a run of a job (V2) without saving it.
+// Tasks that call out of the node take their results from TaskResults, keyed by task ID, or
+// from the task runs of PipelineRunID if set. TaskResults take precedence over recorded results.
+type SimulateJobRequest struct {
+	TOML          string                    `json:"toml"`
+	Vars          map[string]interface{}    `json:"vars"`
+	TaskResults   pipeline.SimulatedResults `json:"taskResults"`
+	PipelineRunID *int64                    `json:"pipelineRunID"`
+}
+
+// Simulate validates a job TOML and runs its task graph with mocked external tasks.
+// Nothing is saved and no job is started.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	jb, status, err := jc.validateJobSpec(ctx, request.TOML)
 	if err != nil {
-		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
-			jsonAPIError(c, http.StatusBadRequest, err)
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	simulated := pipeline.SimulatedResults{}
+	if request.PipelineRunID != nil {
+		run, err := jc.App.PipelineORM().FindRun(ctx, *request.PipelineRunID)
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
 			return
 		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		simulated = pipeline.NewSimulatedResultsFromRun(run)
+	}
+	for id, result := range request.TaskResults {
+		simulated[id] = result
+	}
+
+	run, _, err := jc.App.SimulateJobV2(ctx, jb, request.Vars, simulated)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+	jsonAPIResponse(c, presenters.NewPipelineRunResource(*run, jc.App.GetLogger()), "pipelineRun")
 }
 
 func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string) (jb job.Job, statusCode int, err error) {
@@ -37,6 +37,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/tomlutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -545,6 +547,99 @@ func TestJobsController_FailToCreate_EmptyJsonAttribute(t *testing.T) {
 	require.Contains(t, string(b), "syntax is not supported. Please use \\\"{}\\\" instead")
 }
 
+func TestJobsController_Simulate(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+
+	tomlStr := `
+type            = "webhook"
+schemaVersion   = 1
+observationSource   = """
+    ds    [type=http method=GET url="http://example.invalid"]
+    parse [type=jsonparse path="data,result"]
+    mul   [type=multiply times=10]
+
+    ds -> parse -> mul
+"""
+`
+
+	t.Run("runs with canned results", func(t *testing.T) {
+		body, err := json.Marshal(web.SimulateJobRequest{
+			TOML: tomlStr,
+			TaskResults: pipeline.SimulatedResults{
+				"ds": {Value: `{"data": {"result": 42}}`},
+			},
+		})
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		run := presenters.PipelineRunResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &run))
+		assert.Len(t, run.TaskRuns, 3)
+		assert.Equal(t, []*string{nil}, run.Errors)
+		require.Len(t, run.Outputs, 1)
+		assert.Equal(t, "420", *run.Outputs[0])
+
+		// nothing is saved
+		jobs, count, err := app.JobORM().FindJobs(testutils.Context(t), 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+		assert.Zero(t, count)
+	})
+
+	t.Run("fails external tasks without a canned result", func(t *testing.T) {
+		body, err := json.Marshal(web.SimulateJobRequest{TOML: tomlStr})
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		run := presenters.PipelineRunResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &run))
+		require.Len(t, run.TaskRuns, 3)
+		for _, tr := range run.TaskRuns {
+			if tr.DotID == "ds" {
+				require.NotNil(t, tr.Error)
+				assert.Contains(t, *tr.Error, pipeline.ErrNoSimulatedResult.Error())
+			}
+		}
+	})
+
+	t.Run("simulates a saved job from its pipeline spec", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		jb, err := webhook.ValidatedWebhookSpec(ctx, tomlStr, app.GetExternalInitiatorManager())
+		require.NoError(t, err)
+		require.NoError(t, app.AddJobV2(ctx, &jb))
+		t.Cleanup(func() { assert.NoError(t, app.DeleteJob(testutils.Context(t), jb.ID)) })
+
+		saved, err := app.JobORM().FindJob(ctx, jb.ID)
+		require.NoError(t, err)
+		require.NotNil(t, saved.PipelineSpec)
+
+		run, _, err := app.SimulateJobV2(ctx, saved, nil, pipeline.SimulatedResults{
+			"ds": {Value: `{"data": {"result": 4.2}}`},
+		})
+		require.NoError(t, err)
+		outputs, err := run.StringOutputs()
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		assert.Equal(t, "42", *outputs[0])
+	})
+
+	t.Run("unknown pipeline run", func(t *testing.T) {
+		runID := int64(1234)
+		body, err := json.Marshal(web.SimulateJobRequest{TOML: tomlStr, PipelineRunID: &runID})
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
+
 func TestJobsController_Index_HappyPath(t *testing.T) {
 	_, client, ocrJobSpecFromFile, _, ereJobSpecFromFile, _ := setupJobSpecsControllerTestsWithJobs(t)
 
@@ -742,6 +837,174 @@ func TestJobsController_Update_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_Update_KeepsJobOnFailure(t *testing.T) {
+	ctx := testutils.Context(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.OCR.Enabled = ptr(true)
+		c.P2P.V2.Enabled = ptr(true)
+		c.P2P.V2.ListenAddresses = &[]string{fmt.Sprintf("127.0.0.1:%d", freeport.GetOne(t))}
+		c.P2P.PeerID = &cltest.DefaultP2PPeerID
+	})
+	app := cltest.NewApplicationWithConfigAndKey(t, cfg, cltest.DefaultP2PKey)
+
+	require.NoError(t, app.KeyStore.OCR().Add(ctx, cltest.DefaultOCRKey))
+	require.NoError(t, app.Start(ctx))
+
+	b1, b2 := setupBridges(t, app.GetDB())
+	client := app.NewHTTPClient(nil)
+
+	body, _ := json.Marshal(web.CreateJobRequest{
+		TOML: testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			DS1BridgeName:      b1,
+			DS2BridgeName:      b2,
+			Name:               "old OCR job",
+			TransmitterAddress: app.Keys[0].Address.Hex(),
+		}).Toml(),
+	})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	resource := presenters.JobResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, response, &resource))
+	jobID := mustInt32FromString(t, resource.ID)
+
+	// The new spec references a bridge that does not exist, so the job must not be replaced.
+	body, _ = json.Marshal(web.UpdateJobRequest{
+		TOML: testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			DS1BridgeName:      "missing-bridge",
+			DS2BridgeName:      b2,
+			Name:               "updated OCR job",
+			TransmitterAddress: app.Keys[0].Address.Hex(),
+		}).Toml(),
+	})
+	response, cleanup = client.Put("/v2/jobs/"+resource.ID, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	assert.NotEqual(t, http.StatusOK, response.StatusCode)
+
+	dbJb, err := app.JobORM().FindJob(ctx, jobID)
+	require.NoError(t, err)
+	assert.Equal(t, "old OCR job", dbJb.Name.String)
+	assert.Contains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	versions, err := app.JobORM().FindSpecVersions(ctx, jobID)
+	require.NoError(t, err)
+	assert.Len(t, versions, 1)
+}
+
+func TestJobsController_VersionsAndRollback(t *testing.T) {
+	ctx := testutils.Context(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.OCR.Enabled = ptr(true)
+		c.P2P.V2.Enabled = ptr(true)
+		c.P2P.V2.ListenAddresses = &[]string{fmt.Sprintf("127.0.0.1:%d", freeport.GetOne(t))}
+		c.P2P.PeerID = &cltest.DefaultP2PPeerID
+	})
+	app := cltest.NewApplicationWithConfigAndKey(t, cfg, cltest.DefaultP2PKey)
+
+	require.NoError(t, app.KeyStore.OCR().Add(ctx, cltest.DefaultOCRKey))
+	require.NoError(t, app.Start(ctx))
+
+	b1, b2 := setupBridges(t, app.GetDB())
+	client := app.NewHTTPClient(nil)
+
+	body, _ := json.Marshal(web.CreateJobRequest{
+		TOML: testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			DS1BridgeName:      b1,
+			DS2BridgeName:      b2,
+			Name:               "old OCR job",
+			TransmitterAddress: app.Keys[0].Address.Hex(),
+		}).Toml(),
+	})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	resource := presenters.JobResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, response, &resource))
+	jobID := mustInt32FromString(t, resource.ID)
+
+	body, _ = json.Marshal(web.UpdateJobRequest{
+		TOML: testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			DS1BridgeName:      b2,
+			DS2BridgeName:      b1,
+			Name:               "updated OCR job",
+			TransmitterAddress: app.Keys[0].Address.Hex(),
+		}).Toml(),
+	})
+	response, cleanup = client.Put("/v2/jobs/"+resource.ID, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	response, cleanup = client.Get("/v2/jobs/" + resource.ID + "/versions")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var versions []presenters.JobSpecVersionResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, response, &versions))
+	require.Len(t, versions, 2)
+	assert.Equal(t, int32(1), versions[0].Version)
+	assert.Empty(t, versions[0].Diff)
+	assert.Equal(t, int32(2), versions[1].Version)
+	assert.Contains(t, versions[1].Diff, `"updated OCR job"`)
+
+	body, _ = json.Marshal(web.RollbackJobRequest{Version: 3})
+	response, cleanup = client.Post("/v2/jobs/"+resource.ID+"/rollback", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	body, _ = json.Marshal(web.RollbackJobRequest{Version: 1})
+	response, cleanup = client.Post("/v2/jobs/"+resource.ID+"/rollback", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	dbJb, err := app.JobORM().FindJob(ctx, jobID)
+	require.NoError(t, err)
+	assert.Equal(t, "old OCR job", dbJb.Name.String)
+
+	specVersions, err := app.JobORM().FindSpecVersions(ctx, jobID)
+	require.NoError(t, err)
+	require.Len(t, specVersions, 3)
+	assert.Equal(t, specVersions[0].Spec, specVersions[2].Spec)
+}
+
+func TestJobsController_PauseResume(t *testing.T) {
+	ctx := testutils.Context(t)
+	app, client := setupJobsControllerTests(t)
+	b1, b2 := setupBridges(t, app.GetDB())
+	require.NoError(t, app.KeyStore.OCR().Add(ctx, cltest.DefaultOCRKey))
+
+	body, _ := json.Marshal(web.CreateJobRequest{
+		TOML: testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			DS1BridgeName:      b1,
+			DS2BridgeName:      b2,
+			TransmitterAddress: app.Keys[0].Address.Hex(),
+		}).Toml(),
+	})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	resource := presenters.JobResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, response, &resource))
+	jobID := mustInt32FromString(t, resource.ID)
+
+	response, cleanup = client.Post("/v2/jobs/"+resource.ID+"/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, response, &resource))
+	assert.NotNil(t, resource.PausedAt)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	response, cleanup = client.Post("/v2/jobs/"+resource.ID+"/resume", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	resource = presenters.JobResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, response, &resource))
+	assert.Nil(t, resource.PausedAt)
+	assert.Contains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	response, cleanup = client.Post("/v2/jobs/99999/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func runOCRJobSpecAssertions(t *testing.T, ocrJobSpecFromFileDB job.Job, ocrJobSpecFromServer presenters.JobResource) {
 	ocrJobSpecFromFile := ocrJobSpecFromFileDB.OCROracleSpec
 	assert.Equal(t, ocrJobSpecFromFile.ContractAddress, ocrJobSpecFromServer.OffChainReportingSpec.ContractAddress)
@@ -0,0 +1,81 @@
+package web
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// KeystoreBackupController backs up and restores every key of the keystore at once.
+type KeystoreBackupController struct {
+	App chainlink.Application
+}
+
+// Backup returns a bundle of every key and EVM key state, encrypted under the given password.
+// Example:
+//
+//	"<application>/v2/keys/backup?newpassword=..."
+func (kbc *KeystoreBackupController) Backup(c *gin.Context) {
+	defer kbc.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing Backup request body")
+
+	newPassword := c.Query("newpassword")
+	if newPassword == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'newpassword' parameter"))
+		return
+	}
+
+	bytes, err := kbc.App.GetKeyStore().Backup(c.Request.Context(), newPassword)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	kbc.App.GetAuditLogger().Audit(audit.KeystoreBackedUp, map[string]interface{}{})
+
+	c.Data(http.StatusOK, MediaType, bytes)
+}
+
+// Restore adds the missing keys and EVM key states of a backup bundle to the keystore, and
+// reports those which conflict with existing ones. Nothing is written if dryRun is set.
+// Example:
+//
+//	"<application>/v2/keys/restore?oldpassword=...&dryRun=true"
+func (kbc *KeystoreBackupController) Restore(c *gin.Context) {
+	defer kbc.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing Restore request body")
+
+	bytes, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	dryRun := false
+	if s := c.Query("dryRun"); s != "" {
+		if dryRun, err = strconv.ParseBool(s); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid 'dryRun' parameter"))
+			return
+		}
+	}
+
+	report, err := kbc.App.GetKeyStore().Restore(c.Request.Context(), bytes, c.Query("oldpassword"), dryRun)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if !dryRun {
+		kbc.App.GetAuditLogger().Audit(audit.KeystoreRestored, map[string]interface{}{
+			"keys":      len(report.Keys),
+			"keyStates": len(report.KeyStates),
+			"conflicts": len(report.Conflicts),
+		})
+	}
+
+	jsonAPIResponse(c, presenters.NewKeystoreRestoreResource(report), "keystoreRestores")
+}
@@ -0,0 +1,55 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/balance"
+)
+
+// EVMBalancePolicyResource represents the balance policy of a key on an EVM chain. Its ID
+// is made of the chain ID and the address of the key.
+type EVMBalancePolicyResource struct {
+	JAID
+	EVMChainID      big.Big         `json:"evmChainID"`
+	Address         common.Address  `json:"address"`
+	LowBalance      big.Big         `json:"lowBalance"`
+	CriticalBalance big.Big         `json:"criticalBalance"`
+	FundingAddress  *common.Address `json:"fundingAddress"`
+	TopUpAmount     *big.Big        `json:"topUpAmount"`
+	DailyTopUpLimit *big.Big        `json:"dailyTopUpLimit"`
+	CreatedAt       time.Time       `json:"createdAt"`
+	UpdatedAt       time.Time       `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMBalancePolicyResource) GetName() string {
+	return "evmBalancePolicies"
+}
+
+// NewEVMBalancePolicyResource constructs a new EVMBalancePolicyResource.
+func NewEVMBalancePolicyResource(p balance.Policy) EVMBalancePolicyResource {
+	return EVMBalancePolicyResource{
+		JAID:            NewJAID(p.EVMChainID.String() + "/" + p.Address.Hex()),
+		EVMChainID:      p.EVMChainID,
+		Address:         p.Address,
+		LowBalance:      p.LowBalance,
+		CriticalBalance: p.CriticalBalance,
+		FundingAddress:  p.FundingAddress,
+		TopUpAmount:     p.TopUpAmount,
+		DailyTopUpLimit: p.DailyTopUpLimit,
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+	}
+}
+
+// NewEVMBalancePolicyResources constructs a list of EVMBalancePolicyResource.
+func NewEVMBalancePolicyResources(ps []balance.Policy) []EVMBalancePolicyResource {
+	rs := make([]EVMBalancePolicyResource, 0, len(ps))
+	for _, p := range ps {
+		rs = append(rs, NewEVMBalancePolicyResource(p))
+	}
+	return rs
+}
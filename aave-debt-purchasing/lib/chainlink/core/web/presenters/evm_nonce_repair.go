@@ -0,0 +1,62 @@
+package presenters
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+// EVMNonceRepairPlanResource represents the repair plan of the nonces of a key on an EVM chain.
+// Its ID is made of the chain ID and the address of the key.
+type EVMNonceRepairPlanResource struct {
+	JAID
+	EVMChainID   big.Big              `json:"evmChainID"`
+	Address      common.Address       `json:"address"`
+	OnChainNonce int64                `json:"onChainNonce"`
+	GasPrice     string               `json:"gasPrice"`
+	Steps        []EVMNonceRepairStep `json:"steps"`
+}
+
+// EVMNonceRepairStep is the repair of a single nonce of a plan.
+type EVMNonceRepairStep struct {
+	Nonce  int64  `json:"nonce"`
+	Action string `json:"action"`
+	TxID   int64  `json:"txID,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMNonceRepairPlanResource) GetName() string {
+	return "evmNonceRepairPlans"
+}
+
+// NewEVMNonceRepairPlanResource constructs a new EVMNonceRepairPlanResource.
+func NewEVMNonceRepairPlanResource(chainID big.Big, p txmgr.NonceRepairPlan) EVMNonceRepairPlanResource {
+	r := EVMNonceRepairPlanResource{
+		JAID:         NewJAID(chainID.String() + "/" + p.Address.Hex()),
+		EVMChainID:   chainID,
+		Address:      p.Address,
+		OnChainNonce: p.OnChainNonce.Int64(),
+		Steps:        make([]EVMNonceRepairStep, 0, len(p.Steps)),
+	}
+	if p.GasPrice != nil {
+		r.GasPrice = p.GasPrice.ToInt().String()
+	}
+	for _, step := range p.Steps {
+		r.Steps = append(r.Steps, EVMNonceRepairStep{
+			Nonce:  step.Nonce.Int64(),
+			Action: string(step.Action),
+			TxID:   step.TxID,
+		})
+	}
+	return r
+}
+
+// NewEVMNonceRepairPlanResources constructs a list of EVMNonceRepairPlanResource.
+func NewEVMNonceRepairPlanResources(chainID big.Big, ps []txmgr.NonceRepairPlan) []EVMNonceRepairPlanResource {
+	rs := make([]EVMNonceRepairPlanResource, 0, len(ps))
+	for _, p := range ps {
+		rs = append(rs, NewEVMNonceRepairPlanResource(chainID, p))
+	}
+	return rs
+}
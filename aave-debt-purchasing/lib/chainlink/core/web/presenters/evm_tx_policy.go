@@ -0,0 +1,86 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+// EVMTxPolicyResource represents the transaction policy of a key on an EVM chain. Its ID
+// is made of the chain ID and the address of the key.
+type EVMTxPolicyResource struct {
+	JAID
+	EVMChainID          big.Big          `json:"evmChainID"`
+	Address             common.Address   `json:"address"`
+	AllowedDestinations []common.Address `json:"allowedDestinations"`
+	AllowedSelectors    []hexutil.Bytes  `json:"allowedSelectors"`
+	MaxValue            *big.Big         `json:"maxValue"`
+	DailySpendLimit     *big.Big         `json:"dailySpendLimit"`
+	CreatedAt           time.Time        `json:"createdAt"`
+	UpdatedAt           time.Time        `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMTxPolicyResource) GetName() string {
+	return "evmTxPolicies"
+}
+
+// NewEVMTxPolicyResource constructs a new EVMTxPolicyResource.
+func NewEVMTxPolicyResource(p txmgr.TxPolicy) EVMTxPolicyResource {
+	return EVMTxPolicyResource{
+		JAID:                NewJAID(p.EVMChainID.String() + "/" + p.Address.Hex()),
+		EVMChainID:          p.EVMChainID,
+		Address:             p.Address,
+		AllowedDestinations: p.AllowedDestinations,
+		AllowedSelectors:    p.AllowedSelectors,
+		MaxValue:            p.MaxValue,
+		DailySpendLimit:     p.DailySpendLimit,
+		CreatedAt:           p.CreatedAt,
+		UpdatedAt:           p.UpdatedAt,
+	}
+}
+
+// NewEVMTxPolicyResources constructs a list of EVMTxPolicyResource.
+func NewEVMTxPolicyResources(ps []txmgr.TxPolicy) []EVMTxPolicyResource {
+	rs := make([]EVMTxPolicyResource, 0, len(ps))
+	for _, p := range ps {
+		rs = append(rs, NewEVMTxPolicyResource(p))
+	}
+	return rs
+}
+
+// EVMTxPolicyViolationResource represents a transaction that was fatally errored for
+// violating the transaction policy of its key.
+type EVMTxPolicyViolationResource struct {
+	JAID
+	EVMChainID *big.Big       `json:"evmChainID"`
+	From       common.Address `json:"from"`
+	To         common.Address `json:"to"`
+	Data       hexutil.Bytes  `json:"data"`
+	Value      string         `json:"value"`
+	Error      string         `json:"error"`
+	CreatedAt  time.Time      `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMTxPolicyViolationResource) GetName() string {
+	return "evmTxPolicyViolations"
+}
+
+// NewEVMTxPolicyViolationResource constructs a new EVMTxPolicyViolationResource.
+func NewEVMTxPolicyViolationResource(tx txmgr.Tx) EVMTxPolicyViolationResource {
+	return EVMTxPolicyViolationResource{
+		JAID:       NewJAIDInt64(tx.ID),
+		EVMChainID: big.New(tx.ChainID),
+		From:       tx.FromAddress,
+		To:         tx.ToAddress,
+		Data:       tx.EncodedPayload,
+		Value:      tx.Value.String(),
+		Error:      tx.Error.String,
+		CreatedAt:  tx.CreatedAt,
+	}
+}
@@ -1,10 +1,12 @@
 package presenters
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"github.com/pmezard/go-difflib/difflib"
 	"gopkg.in/guregu/null.v4"
 
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
@@ -539,6 +541,7 @@ type JobResource struct {
 	CCIPSpec                 *CCIPSpec                 `json:"ccipSpec"`
 	PipelineSpec             PipelineSpec              `json:"pipelineSpec"`
 	Errors                   []JobError                `json:"errors"`
+	PausedAt                 *time.Time                `json:"pausedAt,omitempty"`
 }
 
 // NewJobResource initializes a new JSONAPI job resource
@@ -554,6 +557,7 @@ func NewJobResource(j job.Job) *JobResource {
 		MaxTaskDuration:   j.MaxTaskDuration,
 		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:     j.ExternalJobID,
+		PausedAt:          j.PausedAt.Ptr(),
 	}
 
 	switch j.Type {
@@ -606,3 +610,46 @@ func NewJobResource(j job.Job) *JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// JobSpecVersionResource represents a version of the TOML spec of a job
+type JobSpecVersionResource struct {
+	JAID
+	JobID     int32     `json:"jobID"`
+	Version   int32     `json:"version"`
+	Spec      string    `json:"spec"`
+	Diff      string    `json:"diff"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecVersionResource) GetName() string {
+	return "jobSpecVersions"
+}
+
+// NewJobSpecVersionResources initializes the resources of the versions of a job spec,
+// oldest first. The diff of each version is a unified diff against the previous one.
+func NewJobSpecVersionResources(versions []job.SpecVersion) []JobSpecVersionResource {
+	resources := make([]JobSpecVersionResource, 0, len(versions))
+	var previous job.SpecVersion
+	for i, v := range versions {
+		r := JobSpecVersionResource{
+			JAID:      NewJAIDInt64(v.ID),
+			JobID:     v.JobID,
+			Version:   v.Version,
+			Spec:      v.Spec,
+			CreatedAt: v.CreatedAt,
+		}
+		if i > 0 {
+			r.Diff, _ = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+				A:        difflib.SplitLines(previous.Spec),
+				B:        difflib.SplitLines(v.Spec),
+				FromFile: fmt.Sprintf("version %d", previous.Version),
+				ToFile:   fmt.Sprintf("version %d", v.Version),
+				Context:  3,
+			})
+		}
+		resources = append(resources, r)
+		previous = v
+	}
+	return resources
+}
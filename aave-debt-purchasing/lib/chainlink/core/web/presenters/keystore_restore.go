@@ -0,0 +1,30 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+// KeystoreRestoreResource represents the outcome of restoring a keystore backup bundle.
+type KeystoreRestoreResource struct {
+	JAID
+	DryRun    bool                       `json:"dryRun"`
+	Keys      []keystore.RestoredKey     `json:"keys"`
+	KeyStates []keystore.BackupKeyState  `json:"keyStates"`
+	Conflicts []keystore.RestoreConflict `json:"conflicts"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeystoreRestoreResource) GetName() string {
+	return "keystoreRestores"
+}
+
+// NewKeystoreRestoreResource constructs a new KeystoreRestoreResource.
+func NewKeystoreRestoreResource(report keystore.RestoreReport) *KeystoreRestoreResource {
+	return &KeystoreRestoreResource{
+		JAID:      NewJAID("keystore"),
+		DryRun:    report.DryRun,
+		Keys:      report.Keys,
+		KeyStates: report.KeyStates,
+		Conflicts: report.Conflicts,
+	}
+}
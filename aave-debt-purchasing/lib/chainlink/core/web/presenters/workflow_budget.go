@@ -0,0 +1,50 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/budgets"
+)
+
+// WorkflowBudgetResource represents a spend budget of a workflow owner, or of one of its
+// workflows. Its ID is made of the owner, workflow ID and spend unit.
+type WorkflowBudgetResource struct {
+	JAID
+	Owner      string    `json:"owner"`
+	WorkflowID string    `json:"workflowId"`
+	SpendUnit  string    `json:"spendUnit"`
+	Limit      string    `json:"limit"`
+	Spent      string    `json:"spent"`
+	Exceeded   bool      `json:"exceeded"`
+	ResetAt    time.Time `json:"resetAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowBudgetResource) GetName() string {
+	return "workflowBudgets"
+}
+
+// NewWorkflowBudgetResource constructs a new WorkflowBudgetResource.
+func NewWorkflowBudgetResource(b budgets.Budget) WorkflowBudgetResource {
+	return WorkflowBudgetResource{
+		JAID:       NewJAID(b.Owner + "/" + b.WorkflowID + "/" + b.SpendUnit),
+		Owner:      b.Owner,
+		WorkflowID: b.WorkflowID,
+		SpendUnit:  b.SpendUnit,
+		Limit:      b.Limit.String(),
+		Spent:      b.Spent.String(),
+		Exceeded:   b.Exceeded(),
+		ResetAt:    b.ResetAt,
+		UpdatedAt:  b.UpdatedAt,
+	}
+}
+
+// NewWorkflowBudgetResources constructs a list of WorkflowBudgetResource.
+func NewWorkflowBudgetResources(bs []budgets.Budget) []WorkflowBudgetResource {
+	rs := make([]WorkflowBudgetResource, 0, len(bs))
+	for _, b := range bs {
+		rs = append(rs, NewWorkflowBudgetResource(b))
+	}
+	return rs
+}
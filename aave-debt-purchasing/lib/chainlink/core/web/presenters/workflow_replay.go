@@ -0,0 +1,100 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+)
+
+// WorkflowReplayResource represents the outcome of replaying a workflow execution, or the step
+// a stepped replay is paused at. Its ID is the ID of the replayed execution.
+type WorkflowReplayResource struct {
+	JAID
+	ReplayExecutionID string                       `json:"replayExecutionId"`
+	RecordedStatus    string                       `json:"recordedStatus"`
+	Status            string                       `json:"status"`
+	Steps             []WorkflowReplayStepResource `json:"steps"`
+	// SessionID and PausedAt are only set while a stepped replay is paused.
+	SessionID string                      `json:"sessionId,omitempty"`
+	PausedAt  *WorkflowReplayStepResource `json:"pausedAt,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowReplayResource) GetName() string {
+	return "workflowReplay"
+}
+
+// WorkflowReplayStepResource represents a single step invoked during a replay.
+type WorkflowReplayStepResource struct {
+	Ref            string  `json:"ref"`
+	CapabilityID   string  `json:"capabilityId"`
+	Recorded       bool    `json:"recorded"`
+	InputsMatch    bool    `json:"inputsMatch"`
+	Status         string  `json:"status"`
+	Inputs         any     `json:"inputs"`
+	RecordedInputs any     `json:"recordedInputs"`
+	Outputs        any     `json:"outputs"`
+	Error          *string `json:"error"`
+}
+
+// NewWorkflowReplayResource constructs a new WorkflowReplayResource.
+func NewWorkflowReplayResource(result workflows.ReplayResult) WorkflowReplayResource {
+	steps := make([]WorkflowReplayStepResource, 0, len(result.Steps))
+	for _, s := range result.Steps {
+		steps = append(steps, newWorkflowReplayStepResource(s))
+	}
+
+	return WorkflowReplayResource{
+		JAID:              NewJAID(result.ExecutionID),
+		ReplayExecutionID: result.ReplayExecutionID,
+		RecordedStatus:    result.RecordedStatus,
+		Status:            result.Status,
+		Steps:             steps,
+	}
+}
+
+// NewPausedWorkflowReplayResource constructs a new WorkflowReplayResource for a stepped replay paused at step.
+func NewPausedWorkflowReplayResource(executionID string, sessionID string, step workflows.ReplayedStep) WorkflowReplayResource {
+	pausedAt := newWorkflowReplayStepResource(step)
+	return WorkflowReplayResource{
+		JAID:      NewJAID(executionID),
+		SessionID: sessionID,
+		PausedAt:  &pausedAt,
+	}
+}
+
+func newWorkflowReplayStepResource(s workflows.ReplayedStep) WorkflowReplayStepResource {
+	step := WorkflowReplayStepResource{
+		Ref:            s.Ref,
+		CapabilityID:   s.CapabilityID,
+		Recorded:       s.Recorded,
+		InputsMatch:    s.InputsMatch,
+		Status:         s.Status,
+		Inputs:         unwrapMap(s.Inputs),
+		RecordedInputs: unwrapMap(s.RecordedInputs),
+		Outputs:        unwrapValue(s.Outputs.Value),
+	}
+	if s.Outputs.Err != nil {
+		msg := s.Outputs.Err.Error()
+		step.Error = &msg
+	}
+	return step
+}
+
+func unwrapMap(m *values.Map) any {
+	if m == nil {
+		return nil
+	}
+	return unwrapValue(m)
+}
+
+func unwrapValue(v values.Value) any {
+	if v == nil {
+		return nil
+	}
+
+	unwrapped, err := v.Unwrap()
+	if err != nil {
+		return nil
+	}
+	return unwrapped
+}
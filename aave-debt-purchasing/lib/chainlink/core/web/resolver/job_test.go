@@ -19,6 +19,7 @@ import (
 	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
@@ -563,3 +564,153 @@ func TestResolver_DeleteJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_PauseJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation PauseJob($id: ID!) {
+			pauseJob(id: $id) {
+				... on PauseJobSuccess {
+					job {
+						id
+						name
+						pausedAt
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "pauseJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				spawner := jobmocks.NewSpawner(t)
+				spawner.On("PauseJob", mock.Anything, nil, id).Return(nil)
+				f.App.On("JobSpawner").Return(spawner)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
+					ID:       id,
+					Name:     null.StringFrom("test-job"),
+					PausedAt: null.TimeFrom(f.Timestamp()),
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"job": {
+							"id": "123",
+							"name": "test-job",
+							"pausedAt": "2021-01-01T00:00:00Z"
+						}
+					}
+				}
+			`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				spawner := jobmocks.NewSpawner(t)
+				spawner.On("PauseJob", mock.Anything, nil, id).Return(errors.Wrap(sql.ErrNoRows, "failed to pause job 123"))
+				f.App.On("JobSpawner").Return(spawner)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_ResumeJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation ResumeJob($id: ID!) {
+			resumeJob(id: $id) {
+				... on ResumeJobSuccess {
+					job {
+						id
+						pausedAt
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "resumeJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				spawner := jobmocks.NewSpawner(t)
+				spawner.On("ResumeJob", mock.Anything, nil, id).Return(nil)
+				f.App.On("JobSpawner").Return(spawner)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{ID: id}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"job": {
+							"id": "123",
+							"pausedAt": null
+						}
+					}
+				}
+			`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				spawner := jobmocks.NewSpawner(t)
+				spawner.On("ResumeJob", mock.Anything, nil, id).Return(sql.ErrNoRows)
+				f.App.On("JobSpawner").Return(spawner)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -1171,6 +1171,66 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	return NewDeleteJobPayload(r.App, &j, nil), nil
 }
 
+func (r *Resolver) PauseJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*PauseJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.App.JobSpawner().PauseJob(ctx, nil, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewPauseJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+	r.App.GetAuditLogger().Audit(audit.JobPaused, map[string]interface{}{"id": args.ID})
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPauseJobPayload(r.App, &j, nil), nil
+}
+
+func (r *Resolver) ResumeJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*ResumeJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.App.JobSpawner().ResumeJob(ctx, nil, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewResumeJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+	r.App.GetAuditLogger().Audit(audit.JobResumed, map[string]interface{}{"id": args.ID})
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResumeJobPayload(r.App, &j, nil), nil
+}
+
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
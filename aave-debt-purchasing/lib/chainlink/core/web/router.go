@@ -289,11 +289,41 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
+		tpc := EVMTxPoliciesController{app}
+		authv2.GET("/evm/tx_policies", tpc.Index)
+		authv2.PUT("/evm/tx_policies", auth.RequiresAdminRole(tpc.Upsert))
+		authv2.DELETE("/evm/tx_policies", auth.RequiresAdminRole(tpc.Delete))
+		authv2.GET("/evm/tx_policies/violations", paginatedRequest(tpc.Violations))
+
+		bpc := EVMBalancePoliciesController{app}
+		authv2.GET("/evm/balance_policies", bpc.Index)
+		authv2.PUT("/evm/balance_policies", auth.RequiresAdminRole(bpc.Upsert))
+		authv2.DELETE("/evm/balance_policies", auth.RequiresAdminRole(bpc.Delete))
+
+		nrc := EVMNonceRepairController{app}
+		authv2.GET("/evm/nonce_repair", nrc.Plan)
+		authv2.POST("/evm/nonce_repair", auth.RequiresAdminRole(nrc.Repair))
+
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
 		lcaC := LCAController{app}
 		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
 
+		wec := NewWorkflowExecutionsController(app)
+		authv2.POST("/workflows/executions/:executionID/replay", auth.RequiresRunRole(wec.Replay))
+		authv2.POST("/workflows/replays/:sessionID/next", auth.RequiresRunRole(wec.ReplayNext))
+		authv2.DELETE("/workflows/replays/:sessionID", auth.RequiresRunRole(wec.ReplayClose))
+
+		wbc := WorkflowBudgetsController{app}
+		authv2.GET("/workflows/budgets", wbc.Index)
+		authv2.PUT("/workflows/budgets", auth.RequiresEditRole(wbc.Set))
+		authv2.DELETE("/workflows/budgets", auth.RequiresEditRole(wbc.Delete))
+		authv2.POST("/workflows/budgets/reset", auth.RequiresEditRole(wbc.Reset))
+
+		kbc := KeystoreBackupController{app}
+		authv2.POST("/keys/backup", auth.RequiresAdminRole(kbc.Backup))
+		authv2.POST("/keys/restore", auth.RequiresAdminRole(kbc.Restore))
+
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
 		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
@@ -371,7 +401,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresRunRole(jc.Simulate))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
+		authv2.GET("/jobs/:ID/versions", jc.Versions)
+		authv2.POST("/jobs/:ID/rollback", auth.RequiresEditRole(jc.Rollback))
+		authv2.POST("/jobs/:ID/pause", auth.RequiresEditRole(jc.Pause))
+		authv2.POST("/jobs/:ID/resume", auth.RequiresEditRole(jc.Resume))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
 		// PipelineRunsController
@@ -0,0 +1,139 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/budgets"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowBudgetsController manages the spend budgets of workflows and their owners.
+type WorkflowBudgetsController struct {
+	App chainlink.Application
+}
+
+// SetWorkflowBudgetRequest is a request to set the spend limit of an owner, or of one of
+// its workflows if WorkflowID is not empty.
+type SetWorkflowBudgetRequest struct {
+	Owner      string          `json:"owner"`
+	WorkflowID string          `json:"workflowId"`
+	SpendUnit  string          `json:"spendUnit"`
+	Limit      decimal.Decimal `json:"limit"`
+}
+
+// ResetWorkflowBudgetsRequest is a request to reset the amount spent of the budgets of an
+// owner, or of one of its workflows if WorkflowID is not empty.
+type ResetWorkflowBudgetsRequest struct {
+	Owner      string `json:"owner"`
+	WorkflowID string `json:"workflowId"`
+}
+
+func (wc *WorkflowBudgetsController) orm() budgets.ORM {
+	return budgets.NewORM(wc.App.GetDB(), wc.App.GetLogger())
+}
+
+// Index lists the budgets of every owner, or of the owner given as a query parameter.
+// Example:
+//
+//	"<application>/v2/workflows/budgets?owner=0x..."
+func (wc *WorkflowBudgetsController) Index(c *gin.Context) {
+	bs, err := wc.orm().GetBudgets(c.Request.Context(), c.Query("owner"))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowBudgetResources(bs), "workflowBudgets")
+}
+
+// Set creates or updates a budget. The amount spent is kept when the limit of an existing
+// budget is changed.
+// Example:
+//
+//	"<application>/v2/workflows/budgets"
+func (wc *WorkflowBudgetsController) Set(c *gin.Context) {
+	request := SetWorkflowBudgetRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Owner == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'owner'"))
+		return
+	}
+
+	b, err := wc.orm().SetBudget(c.Request.Context(), request.Owner, request.WorkflowID, request.SpendUnit, request.Limit)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowBudgetResource(b), "workflowBudgets")
+}
+
+// Reset sets the amount spent of the budgets of an owner or workflow back to zero, and
+// returns them.
+// Example:
+//
+//	"<application>/v2/workflows/budgets/reset"
+func (wc *WorkflowBudgetsController) Reset(c *gin.Context) {
+	request := ResetWorkflowBudgetsRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Owner == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'owner'"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	orm := wc.orm()
+	n, err := orm.ResetBudgets(ctx, request.Owner, request.WorkflowID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if n == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow budget not found"))
+		return
+	}
+
+	all, err := orm.GetBudgets(ctx, request.Owner)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	var reset []budgets.Budget
+	for _, b := range all {
+		if b.WorkflowID == request.WorkflowID {
+			reset = append(reset, b)
+		}
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowBudgetResources(reset), "workflowBudgets")
+}
+
+// Delete removes the budget of an owner or workflow in a spend unit, given as query parameters.
+// Example:
+//
+//	"<application>/v2/workflows/budgets?owner=0x...&workflowId=...&spendUnit=..."
+func (wc *WorkflowBudgetsController) Delete(c *gin.Context) {
+	owner, spendUnit := c.Query("owner"), c.Query("spendUnit")
+	if owner == "" || spendUnit == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'owner' or 'spendUnit' parameter"))
+		return
+	}
+
+	if err := wc.orm().DeleteBudget(c.Request.Context(), owner, c.Query("workflowId"), spendUnit); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "workflowBudgets", http.StatusNoContent)
+}
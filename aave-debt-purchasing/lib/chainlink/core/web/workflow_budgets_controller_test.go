@@ -0,0 +1,88 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/budgets"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowBudgetsController(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	t.Run("sets a budget", func(t *testing.T) {
+		body := `{"owner": "0xAbC", "workflowId": "workflow-1", "spendUnit": "COMPUTE_SECONDS", "limit": "5"}`
+		resp, cleanup := client.Put("/v2/workflows/budgets", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var budget presenters.WorkflowBudgetResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &budget))
+		assert.Equal(t, "abc/workflow-1/COMPUTE_SECONDS", budget.ID)
+		assert.Equal(t, "5", budget.Limit)
+		assert.Equal(t, "0", budget.Spent)
+		assert.False(t, budget.Exceeded)
+	})
+
+	t.Run("rejects a negative limit", func(t *testing.T) {
+		body := `{"owner": "0xabc", "spendUnit": "COMPUTE_SECONDS", "limit": "-1"}`
+		resp, cleanup := client.Put("/v2/workflows/budgets", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+	})
+
+	orm := budgets.NewORM(app.GetDB(), logger.TestLogger(t))
+	require.NoError(t, orm.AddSpend(ctx, "abc", "workflow-1", map[string]decimal.Decimal{"COMPUTE_SECONDS": decimal.NewFromInt(5)}))
+
+	t.Run("lists exceeded budgets", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/budgets?owner=0xabc")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var bs []presenters.WorkflowBudgetResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &bs))
+		require.Len(t, bs, 1)
+		assert.Equal(t, "5", bs[0].Spent)
+		assert.True(t, bs[0].Exceeded)
+	})
+
+	t.Run("resets a budget", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/workflows/budgets/reset", bytes.NewBufferString(`{"owner": "0xabc", "workflowId": "workflow-1"}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var bs []presenters.WorkflowBudgetResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &bs))
+		require.Len(t, bs, 1)
+		assert.Equal(t, "0", bs[0].Spent)
+		require.NoError(t, orm.Check(ctx, "abc", "workflow-1"))
+	})
+
+	t.Run("reset of unknown budget", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/workflows/budgets/reset", bytes.NewBufferString(`{"owner": "0xdef"}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("deletes a budget", func(t *testing.T) {
+		resp, cleanup := client.Delete("/v2/workflows/budgets?owner=0xabc&workflowId=workflow-1&spendUnit=COMPUTE_SECONDS")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+		bs, err := orm.GetBudgets(ctx, "abc")
+		require.NoError(t, err)
+		assert.Empty(t, bs)
+	})
+}
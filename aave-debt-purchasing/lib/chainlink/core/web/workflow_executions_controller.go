@@ -0,0 +1,201 @@
+package web
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/artifacts"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+const (
+	// workflowReplayTimeout bounds how long a replay may run before the request is aborted.
+	workflowReplayTimeout = 2 * time.Minute
+	// workflowReplaySessionTimeout bounds how long a stepped replay may stay open.
+	workflowReplaySessionTimeout = 30 * time.Minute
+	// maxWorkflowReplaySessions bounds how many stepped replays may be open at once.
+	maxWorkflowReplaySessions = 10
+)
+
+// WorkflowExecutionsController manages stored workflow executions.
+type WorkflowExecutionsController struct {
+	App chainlink.Application
+
+	// replays are the stepped replays in progress, by session ID
+	replays   map[string]*workflowReplaySession
+	replaysMu sync.Mutex
+}
+
+type workflowReplaySession struct {
+	executionID string
+	replay      *workflows.SteppedReplay
+}
+
+func NewWorkflowExecutionsController(app chainlink.Application) *WorkflowExecutionsController {
+	return &WorkflowExecutionsController{
+		App:     app,
+		replays: make(map[string]*workflowReplaySession),
+	}
+}
+
+// WorkflowReplayRequest is the body of a replay request.
+type WorkflowReplayRequest struct {
+	// Step pauses the replay before each step, to be resumed with ReplayNext.
+	Step bool `json:"step"`
+}
+
+// Replay re-runs a stored workflow execution against the workflow engine, with every
+// capability stubbed out to return the outcome recorded for the original execution.
+// A stepped replay responds with the first step it is paused at, and its session ID.
+// Example:
+//
+//	"<application>/v2/workflows/executions/:executionID/replay"
+func (wc *WorkflowExecutionsController) Replay(c *gin.Context) {
+	executionID := c.Param("executionID")
+	if executionID == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'executionID' parameter"))
+		return
+	}
+
+	var request WorkflowReplayRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), workflowReplayTimeout)
+	defer cancel()
+
+	lggr := wc.App.GetLogger()
+	ds := wc.App.GetDB()
+
+	execution, err := store.NewDBStore(ds, lggr, clockwork.NewRealClock()).Get(ctx, executionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow execution not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	spec, err := artifacts.NewWorkflowRegistryDS(ds, lggr).GetWorkflowSpecByID(ctx, execution.WorkflowID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow spec not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	cfg, err := workflows.NewReplayConfig(ctx, lggr, spec, execution)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if !request.Step {
+		result, err := workflows.Replay(ctx, cfg)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		jsonAPIResponse(c, presenters.NewWorkflowReplayResource(*result), "workflowReplay")
+		return
+	}
+
+	wc.replaysMu.Lock()
+	if len(wc.replays) >= maxWorkflowReplaySessions {
+		wc.replaysMu.Unlock()
+		jsonAPIError(c, http.StatusTooManyRequests, fmt.Errorf("too many workflow replays in progress, at most %d are allowed", maxWorkflowReplaySessions))
+		return
+	}
+	// The replay outlives the request, until it is finished or closed.
+	sessionCtx, sessionCancel := context.WithTimeout(context.Background(), workflowReplaySessionTimeout)
+	replay := workflows.StartSteppedReplay(sessionCtx, cfg)
+	sessionID := uuid.New().String()
+	session := &workflowReplaySession{executionID: executionID, replay: replay}
+	wc.replays[sessionID] = session
+	wc.replaysMu.Unlock()
+	go func() {
+		<-replay.Done()
+		sessionCancel()
+		wc.replaysMu.Lock()
+		delete(wc.replays, sessionID)
+		wc.replaysMu.Unlock()
+	}()
+
+	wc.next(c, sessionID, session)
+}
+
+// ReplayNext resumes a stepped replay, and responds with the next step it is paused at, or
+// its result once it is finished.
+// Example:
+//
+//	"<application>/v2/workflows/replays/:sessionID/next"
+func (wc *WorkflowExecutionsController) ReplayNext(c *gin.Context) {
+	sessionID := c.Param("sessionID")
+	session, ok := wc.session(sessionID)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow replay not found"))
+		return
+	}
+
+	wc.next(c, sessionID, session)
+}
+
+// ReplayClose stops a stepped replay.
+// Example:
+//
+//	"<application>/v2/workflows/replays/:sessionID"
+func (wc *WorkflowExecutionsController) ReplayClose(c *gin.Context) {
+	session, ok := wc.session(c.Param("sessionID"))
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow replay not found"))
+		return
+	}
+
+	session.replay.Close()
+	jsonAPIResponseWithStatus(c, nil, "workflowReplay", http.StatusNoContent)
+}
+
+func (wc *WorkflowExecutionsController) session(sessionID string) (*workflowReplaySession, bool) {
+	wc.replaysMu.Lock()
+	defer wc.replaysMu.Unlock()
+	session, ok := wc.replays[sessionID]
+	return session, ok
+}
+
+func (wc *WorkflowExecutionsController) next(c *gin.Context, sessionID string, session *workflowReplaySession) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), workflowReplayTimeout)
+	defer cancel()
+
+	step, result, err := session.replay.Next(ctx)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if step != nil {
+		jsonAPIResponse(c, presenters.NewPausedWorkflowReplayResource(session.executionID, sessionID, *step), "workflowReplay")
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowReplayResource(*result), "workflowReplay")
+}
@@ -0,0 +1,121 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/artifacts"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+const replayWorkflowID = "15c631d295ef5e32deb99a10ee6804bc4af1385568f9b3363f6552ac6dbb2cef"
+
+const replayWorkflowSpec = `
+triggers:
+  - id: "cron-trigger@1.0.0"
+    config:
+      schedule: "* * * * *"
+
+targets:
+  - id: "write_ethereum-testnet-sepolia@1.0.0"
+    inputs:
+      report: "$(trigger.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+`
+
+func TestWorkflowExecutionsController_Replay(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+	lggr := logger.TestLogger(t)
+
+	_, err := artifacts.NewWorkflowRegistryDS(app.GetDB(), lggr).UpsertWorkflowSpec(ctx, &job.WorkflowSpec{
+		Workflow:      replayWorkflowSpec,
+		WorkflowID:    replayWorkflowID,
+		WorkflowOwner: "owner",
+		WorkflowName:  "replay",
+		SpecType:      job.YamlSpec,
+		Status:        job.WorkflowSpecStatusActive,
+	})
+	require.NoError(t, err)
+
+	report, err := values.NewMap(map[string]any{"report": "0xabcd"})
+	require.NoError(t, err)
+	inputs, err := values.NewMap(map[string]any{"report": "0xabcd"})
+	require.NoError(t, err)
+
+	const targetRef = "write_ethereum-testnet-sepolia@1.0.0"
+	executionStore := store.NewDBStore(app.GetDB(), lggr, clockwork.NewRealClock())
+	_, err = executionStore.Add(ctx, map[string]*store.WorkflowExecutionStep{
+		"trigger": {Ref: "trigger", ExecutionID: "execution-id", Status: store.StatusCompleted, Outputs: store.StepOutput{Value: report}},
+		targetRef: {Ref: targetRef, ExecutionID: "execution-id", Status: store.StatusCompleted, Inputs: inputs, Outputs: store.StepOutput{Value: values.EmptyMap()}},
+	}, "execution-id", replayWorkflowID, store.StatusStarted)
+	require.NoError(t, err)
+	_, err = executionStore.FinishExecution(ctx, "execution-id", store.StatusCompleted)
+	require.NoError(t, err)
+
+	t.Run("replays the execution", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/workflows/executions/execution-id/replay", bytes.NewBufferString("{}"))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var result presenters.WorkflowReplayResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &result))
+		assert.Equal(t, "execution-id", result.ID)
+		assert.Equal(t, store.StatusCompleted, result.RecordedStatus)
+		assert.Equal(t, store.StatusCompleted, result.Status)
+		require.Len(t, result.Steps, 1)
+		assert.Equal(t, targetRef, result.Steps[0].Ref)
+		assert.True(t, result.Steps[0].Recorded)
+		assert.True(t, result.Steps[0].InputsMatch)
+	})
+
+	t.Run("steps through the execution", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/workflows/executions/execution-id/replay", bytes.NewBufferString(`{"step": true}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var paused presenters.WorkflowReplayResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &paused))
+		assert.Equal(t, "execution-id", paused.ID)
+		require.NotEmpty(t, paused.SessionID)
+		require.NotNil(t, paused.PausedAt)
+		assert.Equal(t, targetRef, paused.PausedAt.Ref)
+
+		resp, cleanup = client.Post("/v2/workflows/replays/"+paused.SessionID+"/next", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var result presenters.WorkflowReplayResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &result))
+		assert.Nil(t, result.PausedAt)
+		assert.Equal(t, store.StatusCompleted, result.Status)
+		require.Len(t, result.Steps, 1)
+		assert.Equal(t, targetRef, result.Steps[0].Ref)
+	})
+
+	t.Run("unknown replay session", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/workflows/replays/unknown/next", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("unknown execution", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/workflows/executions/unknown/replay", bytes.NewBufferString("{}"))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}